@@ -0,0 +1,243 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppClipsService handles communication with App Clip-related methods of the App Store Connect API
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/app_clips
+type AppClipsService service
+
+// AppClipAction defines model for AppClipAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipaction
+type AppClipAction string
+
+const (
+	// AppClipActionOpen is an App Clip action for Open.
+	AppClipActionOpen AppClipAction = "OPEN"
+	// AppClipActionPlay is an App Clip action for Play.
+	AppClipActionPlay AppClipAction = "PLAY"
+	// AppClipActionView is an App Clip action for View.
+	AppClipActionView AppClipAction = "VIEW"
+)
+
+// AppClip defines model for AppClip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclip
+type AppClip struct {
+	Attributes    *AppClipAttributes    `json:"attributes,omitempty"`
+	ID            string                `json:"id"`
+	Links         ResourceLinks         `json:"links"`
+	Relationships *AppClipRelationships `json:"relationships,omitempty"`
+	Type          string                `json:"type"`
+}
+
+// AppClipAttributes defines model for AppClip.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclip/attributes
+type AppClipAttributes struct {
+	BundleID *string `json:"bundleId,omitempty"`
+}
+
+// AppClipRelationships defines model for AppClip.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclip/relationships
+type AppClipRelationships struct {
+	App                       *Relationship      `json:"app,omitempty"`
+	AppClipDefaultExperiences *PagedRelationship `json:"appClipDefaultExperiences,omitempty"`
+}
+
+// AppClipResponse defines model for AppClipResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipresponse
+type AppClipResponse struct {
+	Data     AppClip                   `json:"data"`
+	Included []AppClipResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks             `json:"links"`
+}
+
+// AppClipsResponse defines model for AppClipsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipsresponse
+type AppClipsResponse struct {
+	Data     []AppClip                 `json:"data"`
+	Included []AppClipResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks        `json:"links"`
+	Meta     *PagingInformation        `json:"meta,omitempty"`
+}
+
+// AppClipResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipResponse or AppClipsResponse.
+type AppClipResponseIncluded included
+
+// AppClipDomainStatus defines model for AppClipDomainStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdomainstatus
+type AppClipDomainStatus struct {
+	Attributes *AppClipDomainStatusAttributes `json:"attributes,omitempty"`
+	ID         string                         `json:"id"`
+	Links      ResourceLinks                  `json:"links"`
+	Type       string                         `json:"type"`
+}
+
+// AppClipDomainStatusAttributes defines model for AppClipDomainStatus.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdomainstatus/attributes
+type AppClipDomainStatusAttributes struct {
+	Domains         []AppClipDomain `json:"domains,omitempty"`
+	LastUpdatedDate *DateTime       `json:"lastUpdatedDate,omitempty"`
+}
+
+// AppClipDomain defines model for AppClipDomainStatus.Attributes.Domains
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdomainstatus/attributes/domains
+type AppClipDomain struct {
+	Domain          *string   `json:"domain,omitempty"`
+	ErrorCode       *string   `json:"errorCode,omitempty"`
+	IsValid         *bool     `json:"isValid,omitempty"`
+	LastUpdatedDate *DateTime `json:"lastUpdatedDate,omitempty"`
+}
+
+// AppClipDomainStatusResponse defines model for AppClipDomainStatusResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdomainstatusresponse
+type AppClipDomainStatusResponse struct {
+	Data  AppClipDomainStatus `json:"data"`
+	Links DocumentLinks       `json:"links"`
+}
+
+// ListAppClipsForAppQuery are query options for ListAppClipsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_clips_for_an_app
+type ListAppClipsForAppQuery struct {
+	FieldsAppClips []string `url:"fields[appClips],omitempty"`
+	FieldsApps     []string `url:"fields[apps],omitempty"`
+	FilterBundleID []string `url:"filter[bundleId],omitempty"`
+	Include        []string `url:"include,omitempty"`
+	Limit          int      `url:"limit,omitempty"`
+	Cursor         string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipQuery are query options for GetAppClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_information
+type GetAppClipQuery struct {
+	FieldsAppClipDefaultExperiences []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	FieldsAppClips                  []string `url:"fields[appClips],omitempty"`
+	FieldsApps                      []string `url:"fields[apps],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+	LimitAppClipDefaultExperiences  int      `url:"limit[appClipDefaultExperiences],omitempty"`
+}
+
+// GetAppClipDomainStatusQuery are query options for GetAppClipDomainCacheStatusForBuildBundle and
+// GetAppClipDomainDebugStatusForBuildBundle
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_cache_debug_status_for_an_app_clip
+type GetAppClipDomainStatusQuery struct {
+	FieldsAppClipDomainStatuses []string `url:"fields[appClipDomainStatuses],omitempty"`
+}
+
+// ListAppClipsForApp lists the App Clips of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_clips_for_an_app
+func (s *AppClipsService) ListAppClipsForApp(ctx context.Context, id string, params *ListAppClipsForAppQuery) (*AppClipsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appClips", id)
+	res := new(AppClipsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClip gets information about a specific App Clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_information
+func (s *AppClipsService) GetAppClip(ctx context.Context, id string, params *GetAppClipQuery) (*AppClipResponse, *Response, error) {
+	url := fmt.Sprintf("appClips/%s", id)
+	res := new(AppClipResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipDomainCacheStatusForBuildBundle gets the status of the associated domains of an App Clip build bundle
+// as cached by Apple's content delivery network.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_cache_debug_status_for_an_app_clip
+func (s *AppClipsService) GetAppClipDomainCacheStatusForBuildBundle(ctx context.Context, id string, params *GetAppClipDomainStatusQuery) (*AppClipDomainStatusResponse, *Response, error) {
+	url := fmt.Sprintf("buildBundles/%s/appClipDomainCacheStatus", id)
+	res := new(AppClipDomainStatusResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipDomainDebugStatusForBuildBundle gets the status of the associated domains of an App Clip build bundle
+// as seen by Apple's servers directly, bypassing the cache.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_debug_status_for_an_app_clip_domain
+func (s *AppClipsService) GetAppClipDomainDebugStatusForBuildBundle(ctx context.Context, id string, params *GetAppClipDomainStatusQuery) (*AppClipDomainStatusResponse, *Response, error) {
+	url := fmt.Sprintf("buildBundles/%s/appClipDomainDebugStatus", id)
+	res := new(AppClipDomainStatusResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// InvalidDomains returns the domains of the status that failed validation.
+func (s AppClipDomainStatus) InvalidDomains() []AppClipDomain {
+	domains := make([]AppClipDomain, 0)
+
+	if s.Attributes == nil {
+		return domains
+	}
+
+	for _, domain := range s.Attributes.Domains {
+		if domain.IsValid == nil || !*domain.IsValid {
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipResponseIncluded.
+func (i *AppClipResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppClipResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppClipDefaultExperience returns the AppClipDefaultExperience stored within, if one is present.
+func (i *AppClipResponseIncluded) AppClipDefaultExperience() *AppClipDefaultExperience {
+	return extractIncludedAppClipDefaultExperience(i.inner)
+}
@@ -0,0 +1,664 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppClipAdvancedExperienceStatus defines model for AppClipAdvancedExperience.Attributes.Status
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperienceStatus string
+
+const (
+	// AppClipAdvancedExperienceStatusAppTransferInProgress is an advanced experience status for AppTransferInProgress.
+	AppClipAdvancedExperienceStatusAppTransferInProgress AppClipAdvancedExperienceStatus = "APP_TRANSFER_IN_PROGRESS"
+	// AppClipAdvancedExperienceStatusDeactivated is an advanced experience status for Deactivated.
+	AppClipAdvancedExperienceStatusDeactivated AppClipAdvancedExperienceStatus = "DEACTIVATED"
+	// AppClipAdvancedExperienceStatusReceived is an advanced experience status for Received.
+	AppClipAdvancedExperienceStatusReceived AppClipAdvancedExperienceStatus = "RECEIVED"
+)
+
+// AppClipAdvancedExperiencePlaceStatus defines model for AppClipAdvancedExperience.Attributes.PlaceStatus
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperiencePlaceStatus string
+
+const (
+	// AppClipAdvancedExperiencePlaceStatusMatched is a place status for Matched.
+	AppClipAdvancedExperiencePlaceStatusMatched AppClipAdvancedExperiencePlaceStatus = "MATCHED"
+	// AppClipAdvancedExperiencePlaceStatusNoMatch is a place status for NoMatch.
+	AppClipAdvancedExperiencePlaceStatusNoMatch AppClipAdvancedExperiencePlaceStatus = "NO_MATCH"
+	// AppClipAdvancedExperiencePlaceStatusPending is a place status for Pending.
+	AppClipAdvancedExperiencePlaceStatusPending AppClipAdvancedExperiencePlaceStatus = "PENDING"
+)
+
+// AppClipAdvancedExperienceBusinessCategory defines model for AppClipAdvancedExperience.Attributes.BusinessCategory
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperienceBusinessCategory string
+
+const (
+	// AppClipAdvancedExperienceBusinessCategoryAutomotive is a business category for Automotive.
+	AppClipAdvancedExperienceBusinessCategoryAutomotive AppClipAdvancedExperienceBusinessCategory = "AUTOMOTIVE"
+	// AppClipAdvancedExperienceBusinessCategoryBeauty is a business category for Beauty.
+	AppClipAdvancedExperienceBusinessCategoryBeauty AppClipAdvancedExperienceBusinessCategory = "BEAUTY"
+	// AppClipAdvancedExperienceBusinessCategoryBikes is a business category for Bikes.
+	AppClipAdvancedExperienceBusinessCategoryBikes AppClipAdvancedExperienceBusinessCategory = "BIKES"
+	// AppClipAdvancedExperienceBusinessCategoryBooks is a business category for Books.
+	AppClipAdvancedExperienceBusinessCategoryBooks AppClipAdvancedExperienceBusinessCategory = "BOOKS"
+	// AppClipAdvancedExperienceBusinessCategoryCasino is a business category for Casino.
+	AppClipAdvancedExperienceBusinessCategoryCasino AppClipAdvancedExperienceBusinessCategory = "CASINO"
+	// AppClipAdvancedExperienceBusinessCategoryEducation is a business category for Education.
+	AppClipAdvancedExperienceBusinessCategoryEducation AppClipAdvancedExperienceBusinessCategory = "EDUCATION"
+	// AppClipAdvancedExperienceBusinessCategoryEducationJapan is a business category for EducationJapan.
+	AppClipAdvancedExperienceBusinessCategoryEducationJapan AppClipAdvancedExperienceBusinessCategory = "EDUCATION_JAPAN"
+	// AppClipAdvancedExperienceBusinessCategoryEntertainment is a business category for Entertainment.
+	AppClipAdvancedExperienceBusinessCategoryEntertainment AppClipAdvancedExperienceBusinessCategory = "ENTERTAINMENT"
+	// AppClipAdvancedExperienceBusinessCategoryEVCharger is a business category for EVCharger.
+	AppClipAdvancedExperienceBusinessCategoryEVCharger AppClipAdvancedExperienceBusinessCategory = "EV_CHARGER"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialCNY is a business category for FinancialCNY.
+	AppClipAdvancedExperienceBusinessCategoryFinancialCNY AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_CNY"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialEUR is a business category for FinancialEUR.
+	AppClipAdvancedExperienceBusinessCategoryFinancialEUR AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_EUR"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialGBP is a business category for FinancialGBP.
+	AppClipAdvancedExperienceBusinessCategoryFinancialGBP AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_GBP"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialJPY is a business category for FinancialJPY.
+	AppClipAdvancedExperienceBusinessCategoryFinancialJPY AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_JPY"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialUSD is a business category for FinancialUSD.
+	AppClipAdvancedExperienceBusinessCategoryFinancialUSD AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_USD"
+	// AppClipAdvancedExperienceBusinessCategoryFitness is a business category for Fitness.
+	AppClipAdvancedExperienceBusinessCategoryFitness AppClipAdvancedExperienceBusinessCategory = "FITNESS"
+	// AppClipAdvancedExperienceBusinessCategoryFoodAndDrink is a business category for FoodAndDrink.
+	AppClipAdvancedExperienceBusinessCategoryFoodAndDrink AppClipAdvancedExperienceBusinessCategory = "FOOD_AND_DRINK"
+	// AppClipAdvancedExperienceBusinessCategoryGas is a business category for Gas.
+	AppClipAdvancedExperienceBusinessCategoryGas AppClipAdvancedExperienceBusinessCategory = "GAS"
+	// AppClipAdvancedExperienceBusinessCategoryGrocery is a business category for Grocery.
+	AppClipAdvancedExperienceBusinessCategoryGrocery AppClipAdvancedExperienceBusinessCategory = "GROCERY"
+	// AppClipAdvancedExperienceBusinessCategoryHealthAndMedicine is a business category for HealthAndMedicine.
+	AppClipAdvancedExperienceBusinessCategoryHealthAndMedicine AppClipAdvancedExperienceBusinessCategory = "HEALTH_AND_MEDICINE"
+	// AppClipAdvancedExperienceBusinessCategoryHotelAndTravel is a business category for HotelAndTravel.
+	AppClipAdvancedExperienceBusinessCategoryHotelAndTravel AppClipAdvancedExperienceBusinessCategory = "HOTEL_AND_TRAVEL"
+	// AppClipAdvancedExperienceBusinessCategoryMusic is a business category for Music.
+	AppClipAdvancedExperienceBusinessCategoryMusic AppClipAdvancedExperienceBusinessCategory = "MUSIC"
+	// AppClipAdvancedExperienceBusinessCategoryParking is a business category for Parking.
+	AppClipAdvancedExperienceBusinessCategoryParking AppClipAdvancedExperienceBusinessCategory = "PARKING"
+	// AppClipAdvancedExperienceBusinessCategoryPetServices is a business category for PetServices.
+	AppClipAdvancedExperienceBusinessCategoryPetServices AppClipAdvancedExperienceBusinessCategory = "PET_SERVICES"
+	// AppClipAdvancedExperienceBusinessCategoryProfessionalServices is a business category for ProfessionalServices.
+	AppClipAdvancedExperienceBusinessCategoryProfessionalServices AppClipAdvancedExperienceBusinessCategory = "PROFESSIONAL_SERVICES"
+	// AppClipAdvancedExperienceBusinessCategoryShopping is a business category for Shopping.
+	AppClipAdvancedExperienceBusinessCategoryShopping AppClipAdvancedExperienceBusinessCategory = "SHOPPING"
+	// AppClipAdvancedExperienceBusinessCategoryTicketing is a business category for Ticketing.
+	AppClipAdvancedExperienceBusinessCategoryTicketing AppClipAdvancedExperienceBusinessCategory = "TICKETING"
+	// AppClipAdvancedExperienceBusinessCategoryTransit is a business category for Transit.
+	AppClipAdvancedExperienceBusinessCategoryTransit AppClipAdvancedExperienceBusinessCategory = "TRANSIT"
+)
+
+// AppClipAdvancedExperienceMapAction defines model for AppClipAdvancedExperience.Attributes.Place.MapAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place
+type AppClipAdvancedExperienceMapAction string
+
+const (
+	// AppClipAdvancedExperienceMapActionBuyTickets is a map action for BuyTickets.
+	AppClipAdvancedExperienceMapActionBuyTickets AppClipAdvancedExperienceMapAction = "BUY_TICKETS"
+	// AppClipAdvancedExperienceMapActionHotelBookRoom is a map action for HotelBookRoom.
+	AppClipAdvancedExperienceMapActionHotelBookRoom AppClipAdvancedExperienceMapAction = "HOTEL_BOOK_ROOM"
+	// AppClipAdvancedExperienceMapActionParkingReserveParking is a map action for ParkingReserveParking.
+	AppClipAdvancedExperienceMapActionParkingReserveParking AppClipAdvancedExperienceMapAction = "PARKING_RESERVE_PARKING"
+	// AppClipAdvancedExperienceMapActionRestaurantJoinWaitlist is a map action for RestaurantJoinWaitlist.
+	AppClipAdvancedExperienceMapActionRestaurantJoinWaitlist AppClipAdvancedExperienceMapAction = "RESTAURANT_JOIN_WAITLIST"
+	// AppClipAdvancedExperienceMapActionRestaurantOrderDelivery is a map action for RestaurantOrderDelivery.
+	AppClipAdvancedExperienceMapActionRestaurantOrderDelivery AppClipAdvancedExperienceMapAction = "RESTAURANT_ORDER_DELIVERY"
+	// AppClipAdvancedExperienceMapActionRestaurantOrderFood is a map action for RestaurantOrderFood.
+	AppClipAdvancedExperienceMapActionRestaurantOrderFood AppClipAdvancedExperienceMapAction = "RESTAURANT_ORDER_FOOD"
+	// AppClipAdvancedExperienceMapActionRestaurantOrderTakeout is a map action for RestaurantOrderTakeout.
+	AppClipAdvancedExperienceMapActionRestaurantOrderTakeout AppClipAdvancedExperienceMapAction = "RESTAURANT_ORDER_TAKEOUT"
+	// AppClipAdvancedExperienceMapActionRestaurantReservation is a map action for RestaurantReservation.
+	AppClipAdvancedExperienceMapActionRestaurantReservation AppClipAdvancedExperienceMapAction = "RESTAURANT_RESERVATION"
+	// AppClipAdvancedExperienceMapActionRestaurantViewMenu is a map action for RestaurantViewMenu.
+	AppClipAdvancedExperienceMapActionRestaurantViewMenu AppClipAdvancedExperienceMapAction = "RESTAURANT_VIEW_MENU"
+	// AppClipAdvancedExperienceMapActionScheduleAppointment is a map action for ScheduleAppointment.
+	AppClipAdvancedExperienceMapActionScheduleAppointment AppClipAdvancedExperienceMapAction = "SCHEDULE_APPOINTMENT"
+	// AppClipAdvancedExperienceMapActionTheaterNowPlaying is a map action for TheaterNowPlaying.
+	AppClipAdvancedExperienceMapActionTheaterNowPlaying AppClipAdvancedExperienceMapAction = "THEATER_NOW_PLAYING"
+	// AppClipAdvancedExperienceMapActionViewAvailability is a map action for ViewAvailability.
+	AppClipAdvancedExperienceMapActionViewAvailability AppClipAdvancedExperienceMapAction = "VIEW_AVAILABILITY"
+	// AppClipAdvancedExperienceMapActionViewPricing is a map action for ViewPricing.
+	AppClipAdvancedExperienceMapActionViewPricing AppClipAdvancedExperienceMapAction = "VIEW_PRICING"
+)
+
+// AppClipAdvancedExperience defines model for AppClipAdvancedExperience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience
+type AppClipAdvancedExperience struct {
+	Attributes    *AppClipAdvancedExperienceAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *AppClipAdvancedExperienceRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// AppClipAdvancedExperienceAttributes defines model for AppClipAdvancedExperience.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperienceAttributes struct {
+	Action           *AppClipAction                             `json:"action,omitempty"`
+	BusinessCategory *AppClipAdvancedExperienceBusinessCategory `json:"businessCategory,omitempty"`
+	DefaultLanguage  *string                                    `json:"defaultLanguage,omitempty"`
+	IsPoweredBy      *bool                                      `json:"isPoweredBy,omitempty"`
+	Link             *string                                    `json:"link,omitempty"`
+	Place            *AppClipAdvancedExperiencePlace            `json:"place,omitempty"`
+	PlaceStatus      *AppClipAdvancedExperiencePlaceStatus      `json:"placeStatus,omitempty"`
+	Status           *AppClipAdvancedExperienceStatus           `json:"status,omitempty"`
+	Version          *int                                       `json:"version,omitempty"`
+}
+
+// AppClipAdvancedExperienceRelationships defines model for AppClipAdvancedExperience.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/relationships
+type AppClipAdvancedExperienceRelationships struct {
+	AppClip       *Relationship      `json:"appClip,omitempty"`
+	HeaderImage   *Relationship      `json:"headerImage,omitempty"`
+	Localizations *PagedRelationship `json:"localizations,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlace defines model for AppClipAdvancedExperience.Attributes.Place
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place
+type AppClipAdvancedExperiencePlace struct {
+	Categories   []string                                    `json:"categories,omitempty"`
+	DisplayPoint *AppClipAdvancedExperiencePlaceDisplayPoint `json:"displayPoint,omitempty"`
+	HomePage     *string                                     `json:"homePage,omitempty"`
+	MainAddress  *AppClipAdvancedExperiencePlaceMainAddress  `json:"mainAddress,omitempty"`
+	MapAction    *AppClipAdvancedExperienceMapAction         `json:"mapAction,omitempty"`
+	Names        []string                                    `json:"names,omitempty"`
+	PhoneNumber  *AppClipAdvancedExperiencePlacePhoneNumber  `json:"phoneNumber,omitempty"`
+	PlaceID      *string                                     `json:"placeId,omitempty"`
+	Relationship *string                                     `json:"relationship,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceDisplayPoint defines model for AppClipAdvancedExperience.Attributes.Place.DisplayPoint
+//
+// Source is either CALCULATED or MANUALLY_PLACED.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place/displaypoint
+type AppClipAdvancedExperiencePlaceDisplayPoint struct {
+	Coordinates *AppClipAdvancedExperiencePlaceCoordinates `json:"coordinates,omitempty"`
+	Source      *string                                    `json:"source,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceCoordinates defines model for AppClipAdvancedExperience.Attributes.Place.DisplayPoint.Coordinates
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place/displaypoint/coordinates
+type AppClipAdvancedExperiencePlaceCoordinates struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceMainAddress defines model for AppClipAdvancedExperience.Attributes.Place.MainAddress
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place/mainaddress
+type AppClipAdvancedExperiencePlaceMainAddress struct {
+	FullAddress       *string                                          `json:"fullAddress,omitempty"`
+	StructuredAddress *AppClipAdvancedExperiencePlaceStructuredAddress `json:"structuredAddress,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceStructuredAddress defines model for AppClipAdvancedExperience.Attributes.Place.MainAddress.StructuredAddress
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place/mainaddress/structuredaddress
+type AppClipAdvancedExperiencePlaceStructuredAddress struct {
+	CountryCode   *string  `json:"countryCode,omitempty"`
+	Floor         *string  `json:"floor,omitempty"`
+	Locality      *string  `json:"locality,omitempty"`
+	Neighborhood  *string  `json:"neighborhood,omitempty"`
+	PostalCode    *string  `json:"postalCode,omitempty"`
+	StateProvince *string  `json:"stateProvince,omitempty"`
+	StreetAddress []string `json:"streetAddress,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlacePhoneNumber defines model for AppClipAdvancedExperience.Attributes.Place.PhoneNumber
+//
+// Type is one of FAX, LANDLINE, MOBILE or TOLLFREE.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes/place/phonenumber
+type AppClipAdvancedExperiencePlacePhoneNumber struct {
+	Intent *string `json:"intent,omitempty"`
+	Number *string `json:"number,omitempty"`
+	Type   *string `json:"type,omitempty"`
+}
+
+// AppClipAdvancedExperienceLocalization defines model for AppClipAdvancedExperienceLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencelocalization
+type AppClipAdvancedExperienceLocalization struct {
+	Attributes *AppClipAdvancedExperienceLocalizationAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Links      ResourceLinks                                    `json:"links"`
+	Type       string                                           `json:"type"`
+}
+
+// AppClipAdvancedExperienceLocalizationAttributes defines model for AppClipAdvancedExperienceLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencelocalization/attributes
+type AppClipAdvancedExperienceLocalizationAttributes struct {
+	Language *string `json:"language,omitempty"`
+	Subtitle *string `json:"subtitle,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
+// NewAppClipAdvancedExperienceLocalization models the title and subtitle of an advanced App Clip experience
+// in one language, created together with the experience.
+type NewAppClipAdvancedExperienceLocalization struct {
+	Language string
+	Subtitle *string
+	Title    *string
+}
+
+type appClipAdvancedExperienceLocalizationInlineCreate struct {
+	Attributes AppClipAdvancedExperienceLocalizationAttributes `json:"attributes"`
+	ID         string                                          `json:"id"`
+	Type       string                                          `json:"type"`
+}
+
+func (l NewAppClipAdvancedExperienceLocalization) inlineCreate(index int) appClipAdvancedExperienceLocalizationInlineCreate {
+	language := l.Language
+
+	return appClipAdvancedExperienceLocalizationInlineCreate{
+		Attributes: AppClipAdvancedExperienceLocalizationAttributes{
+			Language: &language,
+			Subtitle: l.Subtitle,
+			Title:    l.Title,
+		},
+		ID:   fmt.Sprintf("${new-localization-%d}", index),
+		Type: "appClipAdvancedExperienceLocalizations",
+	}
+}
+
+func newAppClipAdvancedExperienceLocalizations(localizations []NewAppClipAdvancedExperienceLocalization) ([]appClipAdvancedExperienceLocalizationInlineCreate, *pagedRelationshipDeclaration) {
+	if len(localizations) == 0 {
+		return nil, nil
+	}
+
+	newLocalizations := make([]appClipAdvancedExperienceLocalizationInlineCreate, len(localizations))
+	localizationIDs := make([]string, len(localizations))
+
+	for i, localization := range localizations {
+		newLocalization := localization.inlineCreate(i)
+		newLocalizations[i] = newLocalization
+		localizationIDs[i] = newLocalization.ID
+	}
+
+	relationship := newPagedRelationshipDeclaration(localizationIDs, "appClipAdvancedExperienceLocalizations")
+
+	return newLocalizations, &relationship
+}
+
+// newAppClipAdvancedExperienceRequestBody only adds an included section to the request when localizations
+// are created along with the experience.
+func newAppClipAdvancedExperienceRequestBody(data interface{}, localizations []appClipAdvancedExperienceLocalizationInlineCreate) *requestBody {
+	if len(localizations) == 0 {
+		return newRequestBody(data)
+	}
+
+	return newRequestBodyWithIncluded(data, localizations)
+}
+
+// appClipAdvancedExperienceCreateRequest defines model for AppClipAdvancedExperienceCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencecreaterequest/data
+type appClipAdvancedExperienceCreateRequest struct {
+	Attributes    AppClipAdvancedExperienceCreateRequestAttributes    `json:"attributes"`
+	Relationships appClipAdvancedExperienceCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// AppClipAdvancedExperienceCreateRequestAttributes are attributes for AppClipAdvancedExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencecreaterequest/data/attributes
+type AppClipAdvancedExperienceCreateRequestAttributes struct {
+	Action           *AppClipAction                             `json:"action,omitempty"`
+	BusinessCategory *AppClipAdvancedExperienceBusinessCategory `json:"businessCategory,omitempty"`
+	DefaultLanguage  string                                     `json:"defaultLanguage"`
+	IsPoweredBy      bool                                       `json:"isPoweredBy"`
+	Link             string                                     `json:"link"`
+	Place            *AppClipAdvancedExperiencePlace            `json:"place,omitempty"`
+}
+
+// appClipAdvancedExperienceCreateRequestRelationships are relationships for AppClipAdvancedExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencecreaterequest/data/relationships
+type appClipAdvancedExperienceCreateRequestRelationships struct {
+	AppClip       relationshipDeclaration       `json:"appClip"`
+	HeaderImage   relationshipDeclaration       `json:"headerImage"`
+	Localizations *pagedRelationshipDeclaration `json:"localizations,omitempty"`
+}
+
+// appClipAdvancedExperienceUpdateRequest defines model for AppClipAdvancedExperienceUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceupdaterequest/data
+type appClipAdvancedExperienceUpdateRequest struct {
+	Attributes    *AppClipAdvancedExperienceUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                               `json:"id"`
+	Relationships *appClipAdvancedExperienceUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                               `json:"type"`
+}
+
+// AppClipAdvancedExperienceUpdateRequestAttributes are attributes for AppClipAdvancedExperienceUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceupdaterequest/data/attributes
+type AppClipAdvancedExperienceUpdateRequestAttributes struct {
+	Action           *AppClipAction                             `json:"action,omitempty"`
+	BusinessCategory *AppClipAdvancedExperienceBusinessCategory `json:"businessCategory,omitempty"`
+	DefaultLanguage  *string                                    `json:"defaultLanguage,omitempty"`
+	IsPoweredBy      *bool                                      `json:"isPoweredBy,omitempty"`
+	Place            *AppClipAdvancedExperiencePlace            `json:"place,omitempty"`
+	Removed          *bool                                      `json:"removed,omitempty"`
+}
+
+// appClipAdvancedExperienceUpdateRequestRelationships are relationships for AppClipAdvancedExperienceUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceupdaterequest/data/relationships
+type appClipAdvancedExperienceUpdateRequestRelationships struct {
+	HeaderImage   *relationshipDeclaration      `json:"headerImage,omitempty"`
+	Localizations *pagedRelationshipDeclaration `json:"localizations,omitempty"`
+}
+
+// AppClipAdvancedExperienceResponse defines model for AppClipAdvancedExperienceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceresponse
+type AppClipAdvancedExperienceResponse struct {
+	Data     AppClipAdvancedExperience                   `json:"data"`
+	Included []AppClipAdvancedExperienceResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                               `json:"links"`
+}
+
+// AppClipAdvancedExperiencesResponse defines model for AppClipAdvancedExperiencesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencesresponse
+type AppClipAdvancedExperiencesResponse struct {
+	Data     []AppClipAdvancedExperience                 `json:"data"`
+	Included []AppClipAdvancedExperienceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                          `json:"links"`
+	Meta     *PagingInformation                          `json:"meta,omitempty"`
+}
+
+// AppClipAdvancedExperienceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipAdvancedExperienceResponse or AppClipAdvancedExperiencesResponse.
+type AppClipAdvancedExperienceResponseIncluded included
+
+// AppClipAdvancedExperienceImage defines model for AppClipAdvancedExperienceImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimage
+type AppClipAdvancedExperienceImage struct {
+	Attributes *AppClipAdvancedExperienceImageAttributes `json:"attributes,omitempty"`
+	ID         string                                    `json:"id"`
+	Links      ResourceLinks                             `json:"links"`
+	Type       string                                    `json:"type"`
+}
+
+// AppClipAdvancedExperienceImageAttributes defines model for AppClipAdvancedExperienceImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimage/attributes
+type AppClipAdvancedExperienceImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// appClipAdvancedExperienceImageCreateRequest defines model for AppClipAdvancedExperienceImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimagecreaterequest/data
+type appClipAdvancedExperienceImageCreateRequest struct {
+	Attributes appClipAdvancedExperienceImageCreateRequestAttributes `json:"attributes"`
+	Type       string                                                `json:"type"`
+}
+
+// appClipAdvancedExperienceImageCreateRequestAttributes are attributes for AppClipAdvancedExperienceImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimagecreaterequest/data/attributes
+type appClipAdvancedExperienceImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// appClipAdvancedExperienceImageUpdateRequest defines model for AppClipAdvancedExperienceImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimageupdaterequest/data
+type appClipAdvancedExperienceImageUpdateRequest struct {
+	Attributes *appClipAdvancedExperienceImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                 `json:"id"`
+	Type       string                                                 `json:"type"`
+}
+
+// appClipAdvancedExperienceImageUpdateRequestAttributes are attributes for AppClipAdvancedExperienceImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimageupdaterequest/data/attributes
+type appClipAdvancedExperienceImageUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// AppClipAdvancedExperienceImageResponse defines model for AppClipAdvancedExperienceImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimageresponse
+type AppClipAdvancedExperienceImageResponse struct {
+	Data  AppClipAdvancedExperienceImage `json:"data"`
+	Links DocumentLinks                  `json:"links"`
+}
+
+// ListAppClipAdvancedExperiencesForAppClipQuery are query options for ListAppClipAdvancedExperiencesForAppClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_advanced_app_clip_experiences_for_an_app_clip
+type ListAppClipAdvancedExperiencesForAppClipQuery struct {
+	FieldsAppClipAdvancedExperienceImages        []string `url:"fields[appClipAdvancedExperienceImages],omitempty"`
+	FieldsAppClipAdvancedExperienceLocalizations []string `url:"fields[appClipAdvancedExperienceLocalizations],omitempty"`
+	FieldsAppClipAdvancedExperiences             []string `url:"fields[appClipAdvancedExperiences],omitempty"`
+	FieldsAppClips                               []string `url:"fields[appClips],omitempty"`
+	FilterAction                                 []string `url:"filter[action],omitempty"`
+	FilterPlaceStatus                            []string `url:"filter[placeStatus],omitempty"`
+	FilterStatus                                 []string `url:"filter[status],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	Limit                                        int      `url:"limit,omitempty"`
+	LimitLocalizations                           int      `url:"limit[localizations],omitempty"`
+	Cursor                                       string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipAdvancedExperienceQuery are query options for GetAppClipAdvancedExperience
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_advanced_app_clip_experience_information
+type GetAppClipAdvancedExperienceQuery struct {
+	FieldsAppClipAdvancedExperienceImages        []string `url:"fields[appClipAdvancedExperienceImages],omitempty"`
+	FieldsAppClipAdvancedExperienceLocalizations []string `url:"fields[appClipAdvancedExperienceLocalizations],omitempty"`
+	FieldsAppClipAdvancedExperiences             []string `url:"fields[appClipAdvancedExperiences],omitempty"`
+	FieldsAppClips                               []string `url:"fields[appClips],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	LimitLocalizations                           int      `url:"limit[localizations],omitempty"`
+}
+
+// GetAppClipAdvancedExperienceImageQuery are query options for GetAppClipAdvancedExperienceImage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_image_information_for_an_advanced_app_clip_experience
+type GetAppClipAdvancedExperienceImageQuery struct {
+	FieldsAppClipAdvancedExperienceImages []string `url:"fields[appClipAdvancedExperienceImages],omitempty"`
+}
+
+// ListAppClipAdvancedExperiencesForAppClip lists the advanced experiences of an App Clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_advanced_app_clip_experiences_for_an_app_clip
+func (s *AppClipsService) ListAppClipAdvancedExperiencesForAppClip(ctx context.Context, id string, params *ListAppClipAdvancedExperiencesForAppClipQuery) (*AppClipAdvancedExperiencesResponse, *Response, error) {
+	url := fmt.Sprintf("appClips/%s/appClipAdvancedExperiences", id)
+	res := new(AppClipAdvancedExperiencesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipAdvancedExperience gets information about an advanced App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_advanced_app_clip_experience_information
+func (s *AppClipsService) GetAppClipAdvancedExperience(ctx context.Context, id string, params *GetAppClipAdvancedExperienceQuery) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	url := fmt.Sprintf("appClipAdvancedExperiences/%s", id)
+	res := new(AppClipAdvancedExperienceResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppClipAdvancedExperience creates an advanced App Clip experience for a link, such as one tied to a
+// physical location, together with its localized titles and subtitles. The header image must have been
+// uploaded with CreateAppClipAdvancedExperienceImage first.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_advanced_app_clip_experience
+func (s *AppClipsService) CreateAppClipAdvancedExperience(ctx context.Context, attributes AppClipAdvancedExperienceCreateRequestAttributes, appClipID string, headerImageID string, localizations []NewAppClipAdvancedExperienceLocalization) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	newLocalizations, localizationsRelationship := newAppClipAdvancedExperienceLocalizations(localizations)
+	req := appClipAdvancedExperienceCreateRequest{
+		Attributes: attributes,
+		Relationships: appClipAdvancedExperienceCreateRequestRelationships{
+			AppClip:       *newRelationshipDeclaration(&appClipID, "appClips"),
+			HeaderImage:   *newRelationshipDeclaration(&headerImageID, "appClipAdvancedExperienceImages"),
+			Localizations: localizationsRelationship,
+		},
+		Type: "appClipAdvancedExperiences",
+	}
+	res := new(AppClipAdvancedExperienceResponse)
+	resp, err := s.client.post(ctx, "appClipAdvancedExperiences", newAppClipAdvancedExperienceRequestBody(req, newLocalizations), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipAdvancedExperience modifies an advanced App Clip experience. A non-nil headerImageID replaces
+// the header image, and non-empty localizations replace all of the experience's localizations.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_advanced_app_clip_experience
+func (s *AppClipsService) UpdateAppClipAdvancedExperience(ctx context.Context, id string, attributes *AppClipAdvancedExperienceUpdateRequestAttributes, headerImageID *string, localizations []NewAppClipAdvancedExperienceLocalization) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	newLocalizations, localizationsRelationship := newAppClipAdvancedExperienceLocalizations(localizations)
+	req := appClipAdvancedExperienceUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appClipAdvancedExperiences",
+	}
+
+	if headerImageID != nil || localizationsRelationship != nil {
+		req.Relationships = &appClipAdvancedExperienceUpdateRequestRelationships{
+			HeaderImage:   newRelationshipDeclaration(headerImageID, "appClipAdvancedExperienceImages"),
+			Localizations: localizationsRelationship,
+		}
+	}
+
+	url := fmt.Sprintf("appClipAdvancedExperiences/%s", id)
+	res := new(AppClipAdvancedExperienceResponse)
+	resp, err := s.client.patch(ctx, url, newAppClipAdvancedExperienceRequestBody(req, newLocalizations), res)
+
+	return res, resp, err
+}
+
+// RemoveAppClipAdvancedExperience removes an advanced App Clip experience. The API has no delete endpoint for
+// advanced experiences, so this marks the experience as removed.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_advanced_app_clip_experience
+func (s *AppClipsService) RemoveAppClipAdvancedExperience(ctx context.Context, id string) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	return s.UpdateAppClipAdvancedExperience(ctx, id, &AppClipAdvancedExperienceUpdateRequestAttributes{
+		Removed: Bool(true),
+	}, nil, nil)
+}
+
+// GetAppClipAdvancedExperienceImage gets information about an advanced App Clip experience image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_image_information_for_an_advanced_app_clip_experience
+func (s *AppClipsService) GetAppClipAdvancedExperienceImage(ctx context.Context, id string, params *GetAppClipAdvancedExperienceImageQuery) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	url := fmt.Sprintf("appClipAdvancedExperienceImages/%s", id)
+	res := new(AppClipAdvancedExperienceImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppClipAdvancedExperienceImage reserves a header image for an advanced App Clip experience. Upload
+// the file with the returned upload operations using Client.Upload, then commit it with CommitAppClipAdvancedExperienceImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_image_for_an_advanced_app_clip_experience
+func (s *AppClipsService) CreateAppClipAdvancedExperienceImage(ctx context.Context, fileName string, fileSize int64) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	req := appClipAdvancedExperienceImageCreateRequest{
+		Attributes: appClipAdvancedExperienceImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Type: "appClipAdvancedExperienceImages",
+	}
+	res := new(AppClipAdvancedExperienceImageResponse)
+	resp, err := s.client.post(ctx, "appClipAdvancedExperienceImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppClipAdvancedExperienceImage commits an advanced App Clip experience image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/commit_an_image_for_an_advanced_app_clip_experience
+func (s *AppClipsService) CommitAppClipAdvancedExperienceImage(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	req := appClipAdvancedExperienceImageUpdateRequest{
+		ID:   id,
+		Type: "appClipAdvancedExperienceImages",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &appClipAdvancedExperienceImageUpdateRequestAttributes{
+			SourceFileChecksum: sourceFileChecksum,
+			Uploaded:           uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appClipAdvancedExperienceImages/%s", id)
+	res := new(AppClipAdvancedExperienceImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipAdvancedExperienceResponseIncluded.
+func (i *AppClipAdvancedExperienceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppClip returns the AppClip stored within, if one is present.
+func (i *AppClipAdvancedExperienceResponseIncluded) AppClip() *AppClip {
+	return extractIncludedAppClip(i.inner)
+}
+
+// AppClipAdvancedExperienceImage returns the AppClipAdvancedExperienceImage stored within, if one is present.
+func (i *AppClipAdvancedExperienceResponseIncluded) AppClipAdvancedExperienceImage() *AppClipAdvancedExperienceImage {
+	return extractIncludedAppClipAdvancedExperienceImage(i.inner)
+}
+
+// AppClipAdvancedExperienceLocalization returns the AppClipAdvancedExperienceLocalization stored within, if one is present.
+func (i *AppClipAdvancedExperienceResponseIncluded) AppClipAdvancedExperienceLocalization() *AppClipAdvancedExperienceLocalization {
+	return extractIncludedAppClipAdvancedExperienceLocalization(i.inner)
+}
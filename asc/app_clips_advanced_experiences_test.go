@@ -0,0 +1,169 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppClipAdvancedExperiencesForAppClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperiencesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.ListAppClipAdvancedExperiencesForAppClip(ctx, "10", &ListAppClipAdvancedExperiencesForAppClipQuery{})
+	})
+}
+
+func TestGetAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipAdvancedExperience(ctx, "10", &GetAppClipAdvancedExperienceQuery{})
+	})
+}
+
+func TestCreateAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.CreateAppClipAdvancedExperience(ctx, AppClipAdvancedExperienceCreateRequestAttributes{DefaultLanguage: "EN", Link: "https://appclip.example.com/store/1"}, "10", "11", []NewAppClipAdvancedExperienceLocalization{{Language: "EN", Title: String("Store")}})
+	})
+}
+
+func TestUpdateAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.UpdateAppClipAdvancedExperience(ctx, "10", &AppClipAdvancedExperienceUpdateRequestAttributes{}, String("11"), nil)
+	})
+}
+
+func TestRemoveAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.RemoveAppClipAdvancedExperience(ctx, "10")
+	})
+}
+
+func TestGetAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipAdvancedExperienceImage(ctx, "10", &GetAppClipAdvancedExperienceImageQuery{})
+	})
+}
+
+func TestCreateAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.CreateAppClipAdvancedExperienceImage(ctx, "header.png", 20)
+	})
+}
+
+func TestCommitAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.CommitAppClipAdvancedExperienceImage(ctx, "10", Bool(true), String("10"))
+	})
+}
+
+func TestGetAppClipAdvancedExperienceIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appClips"},{"type":"appClipAdvancedExperienceImages"},{"type":"appClipAdvancedExperienceLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		experience, _, err := client.AppClips.GetAppClipAdvancedExperience(ctx, "10", &GetAppClipAdvancedExperienceQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, experience.Included)
+
+		assert.NotNil(t, experience.Included[0].AppClip())
+		assert.NotNil(t, experience.Included[1].AppClipAdvancedExperienceImage())
+		assert.NotNil(t, experience.Included[2].AppClipAdvancedExperienceLocalization())
+
+		assert.Nil(t, experience.Included[0].AppClipAdvancedExperienceImage())
+		assert.Nil(t, experience.Included[1].AppClipAdvancedExperienceLocalization())
+		assert.Nil(t, experience.Included[2].AppClip())
+	})
+}
+
+func TestCreateAppClipAdvancedExperienceIncludesLocalizations(t *testing.T) {
+	t.Parallel()
+
+	var body struct {
+		Data     appClipAdvancedExperienceCreateRequest              `json:"data"`
+		Included []appClipAdvancedExperienceLocalizationInlineCreate `json:"included"`
+	}
+
+	client, server := newRoutedServer(map[string]route{
+		"POST /v1/appClipAdvancedExperiences": respondWith(http.StatusOK, "{}"),
+	})
+	defer server.Close()
+
+	_, _, err := client.AppClips.CreateAppClipAdvancedExperience(context.Background(), AppClipAdvancedExperienceCreateRequestAttributes{
+		DefaultLanguage: "EN",
+		IsPoweredBy:     true,
+		Link:            "https://appclip.example.com/store/1",
+	}, "10", "11", []NewAppClipAdvancedExperienceLocalization{
+		{Language: "EN", Title: String("Store")},
+		{Language: "FR", Title: String("Magasin")},
+	})
+
+	assert.NoError(t, err)
+
+	requests := server.requests("POST /v1/appClipAdvancedExperiences")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.Equal(t, "11", body.Data.Relationships.HeaderImage.Data.ID)
+	assert.Equal(t, []RelationshipData{
+		{ID: "${new-localization-0}", Type: "appClipAdvancedExperienceLocalizations"},
+		{ID: "${new-localization-1}", Type: "appClipAdvancedExperienceLocalizations"},
+	}, body.Data.Relationships.Localizations.Data)
+	assert.Len(t, body.Included, 2)
+	assert.Equal(t, "FR", *body.Included[1].Attributes.Language)
+}
+
+func TestRemoveAppClipAdvancedExperienceOmitsIncluded(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"PATCH /v1/appClipAdvancedExperiences/10": respondWith(http.StatusOK, "{}"),
+	})
+	defer server.Close()
+
+	_, _, err := client.AppClips.RemoveAppClipAdvancedExperience(context.Background(), "10")
+
+	assert.NoError(t, err)
+
+	var body map[string]json.RawMessage
+
+	requests := server.requests("PATCH /v1/appClipAdvancedExperiences/10")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.NotContains(t, body, "included")
+	assert.JSONEq(t, `{"attributes":{"removed":true},"id":"10","type":"appClipAdvancedExperiences"}`, string(body["data"]))
+}
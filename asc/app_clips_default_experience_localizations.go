@@ -0,0 +1,229 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppClipDefaultExperienceLocalization defines model for AppClipDefaultExperienceLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalization
+type AppClipDefaultExperienceLocalization struct {
+	Attributes    *AppClipDefaultExperienceLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                             `json:"id"`
+	Links         ResourceLinks                                      `json:"links"`
+	Relationships *AppClipDefaultExperienceLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                             `json:"type"`
+}
+
+// AppClipDefaultExperienceLocalizationAttributes defines model for AppClipDefaultExperienceLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalization/attributes
+type AppClipDefaultExperienceLocalizationAttributes struct {
+	Locale   *string `json:"locale,omitempty"`
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalizationRelationships defines model for AppClipDefaultExperienceLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalization/relationships
+type AppClipDefaultExperienceLocalizationRelationships struct {
+	AppClipDefaultExperience *Relationship `json:"appClipDefaultExperience,omitempty"`
+	AppClipHeaderImage       *Relationship `json:"appClipHeaderImage,omitempty"`
+}
+
+// appClipDefaultExperienceLocalizationCreateRequest defines model for AppClipDefaultExperienceLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationcreaterequest/data
+type appClipDefaultExperienceLocalizationCreateRequest struct {
+	Attributes    appClipDefaultExperienceLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appClipDefaultExperienceLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                         `json:"type"`
+}
+
+// appClipDefaultExperienceLocalizationCreateRequestAttributes are attributes for AppClipDefaultExperienceLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationcreaterequest/data/attributes
+type appClipDefaultExperienceLocalizationCreateRequestAttributes struct {
+	Locale   string  `json:"locale"`
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// appClipDefaultExperienceLocalizationCreateRequestRelationships are relationships for AppClipDefaultExperienceLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationcreaterequest/data/relationships
+type appClipDefaultExperienceLocalizationCreateRequestRelationships struct {
+	AppClipDefaultExperience relationshipDeclaration `json:"appClipDefaultExperience"`
+}
+
+// appClipDefaultExperienceLocalizationUpdateRequest defines model for AppClipDefaultExperienceLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationupdaterequest/data
+type appClipDefaultExperienceLocalizationUpdateRequest struct {
+	Attributes *appClipDefaultExperienceLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                       `json:"id"`
+	Type       string                                                       `json:"type"`
+}
+
+// appClipDefaultExperienceLocalizationUpdateRequestAttributes are attributes for AppClipDefaultExperienceLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationupdaterequest/data/attributes
+type appClipDefaultExperienceLocalizationUpdateRequestAttributes struct {
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalizationResponse defines model for AppClipDefaultExperienceLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationresponse
+type AppClipDefaultExperienceLocalizationResponse struct {
+	Data     AppClipDefaultExperienceLocalization                   `json:"data"`
+	Included []AppClipDefaultExperienceLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                          `json:"links"`
+}
+
+// AppClipDefaultExperienceLocalizationsResponse defines model for AppClipDefaultExperienceLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationsresponse
+type AppClipDefaultExperienceLocalizationsResponse struct {
+	Data     []AppClipDefaultExperienceLocalization                 `json:"data"`
+	Included []AppClipDefaultExperienceLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                     `json:"links"`
+	Meta     *PagingInformation                                     `json:"meta,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipDefaultExperienceLocalizationResponse or AppClipDefaultExperienceLocalizationsResponse.
+type AppClipDefaultExperienceLocalizationResponseIncluded included
+
+// ListAppClipDefaultExperienceLocalizationsQuery are query options for ListLocalizationsForAppClipDefaultExperience
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_default_app_clip_experience
+type ListAppClipDefaultExperienceLocalizationsQuery struct {
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClipHeaderImages                   []string `url:"fields[appClipHeaderImages],omitempty"`
+	FilterLocale                                []string `url:"filter[locale],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipDefaultExperienceLocalizationQuery are query options for GetAppClipDefaultExperienceLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_localized_default_app_clip_experience_information
+type GetAppClipDefaultExperienceLocalizationQuery struct {
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClipHeaderImages                   []string `url:"fields[appClipHeaderImages],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+}
+
+// ListLocalizationsForAppClipDefaultExperience lists the localized subtitles and header images of a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_default_app_clip_experience
+func (s *AppClipsService) ListLocalizationsForAppClipDefaultExperience(ctx context.Context, id string, params *ListAppClipDefaultExperienceLocalizationsQuery) (*AppClipDefaultExperienceLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperiences/%s/appClipDefaultExperienceLocalizations", id)
+	res := new(AppClipDefaultExperienceLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipDefaultExperienceLocalization gets a specific localization of a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_localized_default_app_clip_experience_information
+func (s *AppClipsService) GetAppClipDefaultExperienceLocalization(ctx context.Context, id string, params *GetAppClipDefaultExperienceLocalizationQuery) (*AppClipDefaultExperienceLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperienceLocalizations/%s", id)
+	res := new(AppClipDefaultExperienceLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppClipDefaultExperienceLocalization adds a localized subtitle to a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_the_localized_metadata_for_a_default_app_clip_experience
+func (s *AppClipsService) CreateAppClipDefaultExperienceLocalization(ctx context.Context, locale string, subtitle *string, appClipDefaultExperienceID string) (*AppClipDefaultExperienceLocalizationResponse, *Response, error) {
+	req := appClipDefaultExperienceLocalizationCreateRequest{
+		Attributes: appClipDefaultExperienceLocalizationCreateRequestAttributes{
+			Locale:   locale,
+			Subtitle: subtitle,
+		},
+		Relationships: appClipDefaultExperienceLocalizationCreateRequestRelationships{
+			AppClipDefaultExperience: *newRelationshipDeclaration(&appClipDefaultExperienceID, "appClipDefaultExperiences"),
+		},
+		Type: "appClipDefaultExperienceLocalizations",
+	}
+	res := new(AppClipDefaultExperienceLocalizationResponse)
+	resp, err := s.client.post(ctx, "appClipDefaultExperienceLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipDefaultExperienceLocalization changes the subtitle of a default App Clip experience localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_the_localized_metadata_of_a_default_app_clip_experience
+func (s *AppClipsService) UpdateAppClipDefaultExperienceLocalization(ctx context.Context, id string, subtitle *string) (*AppClipDefaultExperienceLocalizationResponse, *Response, error) {
+	req := appClipDefaultExperienceLocalizationUpdateRequest{
+		ID:   id,
+		Type: "appClipDefaultExperienceLocalizations",
+	}
+
+	if subtitle != nil {
+		req.Attributes = &appClipDefaultExperienceLocalizationUpdateRequestAttributes{
+			Subtitle: subtitle,
+		}
+	}
+
+	url := fmt.Sprintf("appClipDefaultExperienceLocalizations/%s", id)
+	res := new(AppClipDefaultExperienceLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppClipDefaultExperienceLocalization deletes a localization of a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_the_localized_metadata_of_a_default_app_clip_experience
+func (s *AppClipsService) DeleteAppClipDefaultExperienceLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperienceLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipDefaultExperienceLocalizationResponseIncluded.
+func (i *AppClipDefaultExperienceLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppClipDefaultExperience returns the AppClipDefaultExperience stored within, if one is present.
+func (i *AppClipDefaultExperienceLocalizationResponseIncluded) AppClipDefaultExperience() *AppClipDefaultExperience {
+	return extractIncludedAppClipDefaultExperience(i.inner)
+}
+
+// AppClipHeaderImage returns the AppClipHeaderImage stored within, if one is present.
+func (i *AppClipDefaultExperienceLocalizationResponseIncluded) AppClipHeaderImage() *AppClipHeaderImage {
+	return extractIncludedAppClipHeaderImage(i.inner)
+}
@@ -0,0 +1,84 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.ListLocalizationsForAppClipDefaultExperience(ctx, "10", &ListAppClipDefaultExperienceLocalizationsQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipDefaultExperienceLocalization(ctx, "10", &GetAppClipDefaultExperienceLocalizationQuery{})
+	})
+}
+
+func TestCreateAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.CreateAppClipDefaultExperienceLocalization(ctx, "en-US", String("Order ahead"), "10")
+	})
+}
+
+func TestUpdateAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.UpdateAppClipDefaultExperienceLocalization(ctx, "10", String("Order ahead"))
+	})
+}
+
+func TestDeleteAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.AppClips.DeleteAppClipDefaultExperienceLocalization(ctx, "10")
+	})
+}
+
+func TestGetAppClipDefaultExperienceLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appClipDefaultExperiences"},{"type":"appClipHeaderImages"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.AppClips.GetAppClipDefaultExperienceLocalization(ctx, "10", &GetAppClipDefaultExperienceLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].AppClipDefaultExperience())
+		assert.NotNil(t, localization.Included[1].AppClipHeaderImage())
+
+		assert.Nil(t, localization.Included[0].AppClipHeaderImage())
+		assert.Nil(t, localization.Included[1].AppClipDefaultExperience())
+	})
+}
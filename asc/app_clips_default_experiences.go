@@ -0,0 +1,417 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppClipDefaultExperience defines model for AppClipDefaultExperience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperience
+type AppClipDefaultExperience struct {
+	Attributes    *AppClipDefaultExperienceAttributes    `json:"attributes,omitempty"`
+	ID            string                                 `json:"id"`
+	Links         ResourceLinks                          `json:"links"`
+	Relationships *AppClipDefaultExperienceRelationships `json:"relationships,omitempty"`
+	Type          string                                 `json:"type"`
+}
+
+// AppClipDefaultExperienceAttributes defines model for AppClipDefaultExperience.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperience/attributes
+type AppClipDefaultExperienceAttributes struct {
+	Action *AppClipAction `json:"action,omitempty"`
+}
+
+// AppClipDefaultExperienceRelationships defines model for AppClipDefaultExperience.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperience/relationships
+type AppClipDefaultExperienceRelationships struct {
+	AppClip                               *Relationship      `json:"appClip,omitempty"`
+	AppClipAppStoreReviewDetail           *Relationship      `json:"appClipAppStoreReviewDetail,omitempty"`
+	AppClipDefaultExperienceLocalizations *PagedRelationship `json:"appClipDefaultExperienceLocalizations,omitempty"`
+	ReleaseWithAppStoreVersion            *Relationship      `json:"releaseWithAppStoreVersion,omitempty"`
+}
+
+// appClipDefaultExperienceCreateRequest defines model for AppClipDefaultExperienceCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencecreaterequest/data
+type appClipDefaultExperienceCreateRequest struct {
+	Attributes    *appClipDefaultExperienceCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships appClipDefaultExperienceCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// appClipDefaultExperienceCreateRequestAttributes are attributes for AppClipDefaultExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencecreaterequest/data/attributes
+type appClipDefaultExperienceCreateRequestAttributes struct {
+	Action *AppClipAction `json:"action,omitempty"`
+}
+
+// appClipDefaultExperienceCreateRequestRelationships are relationships for AppClipDefaultExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencecreaterequest/data/relationships
+type appClipDefaultExperienceCreateRequestRelationships struct {
+	AppClip                          relationshipDeclaration  `json:"appClip"`
+	AppClipDefaultExperienceTemplate *relationshipDeclaration `json:"appClipDefaultExperienceTemplate,omitempty"`
+	ReleaseWithAppStoreVersion       *relationshipDeclaration `json:"releaseWithAppStoreVersion,omitempty"`
+}
+
+// appClipDefaultExperienceUpdateRequest defines model for AppClipDefaultExperienceUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceupdaterequest/data
+type appClipDefaultExperienceUpdateRequest struct {
+	Attributes    *appClipDefaultExperienceUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                              `json:"id"`
+	Relationships *appClipDefaultExperienceUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                              `json:"type"`
+}
+
+// appClipDefaultExperienceUpdateRequestAttributes are attributes for AppClipDefaultExperienceUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceupdaterequest/data/attributes
+type appClipDefaultExperienceUpdateRequestAttributes struct {
+	Action *AppClipAction `json:"action,omitempty"`
+}
+
+// appClipDefaultExperienceUpdateRequestRelationships are relationships for AppClipDefaultExperienceUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceupdaterequest/data/relationships
+type appClipDefaultExperienceUpdateRequestRelationships struct {
+	ReleaseWithAppStoreVersion *relationshipDeclaration `json:"releaseWithAppStoreVersion,omitempty"`
+}
+
+// AppClipDefaultExperienceResponse defines model for AppClipDefaultExperienceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceresponse
+type AppClipDefaultExperienceResponse struct {
+	Data     AppClipDefaultExperience                   `json:"data"`
+	Included []AppClipDefaultExperienceResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                              `json:"links"`
+}
+
+// AppClipDefaultExperiencesResponse defines model for AppClipDefaultExperiencesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencesresponse
+type AppClipDefaultExperiencesResponse struct {
+	Data     []AppClipDefaultExperience                 `json:"data"`
+	Included []AppClipDefaultExperienceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                         `json:"links"`
+	Meta     *PagingInformation                         `json:"meta,omitempty"`
+}
+
+// AppClipDefaultExperienceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipDefaultExperienceResponse or AppClipDefaultExperiencesResponse.
+type AppClipDefaultExperienceResponseIncluded included
+
+// AppClipAppStoreReviewDetail defines model for AppClipAppStoreReviewDetail.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetail
+type AppClipAppStoreReviewDetail struct {
+	Attributes    *AppClipAppStoreReviewDetailAttributes    `json:"attributes,omitempty"`
+	ID            string                                    `json:"id"`
+	Links         ResourceLinks                             `json:"links"`
+	Relationships *AppClipAppStoreReviewDetailRelationships `json:"relationships,omitempty"`
+	Type          string                                    `json:"type"`
+}
+
+// AppClipAppStoreReviewDetailAttributes defines model for AppClipAppStoreReviewDetail.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetail/attributes
+type AppClipAppStoreReviewDetailAttributes struct {
+	InvocationURLs []string `json:"invocationUrls,omitempty"`
+}
+
+// AppClipAppStoreReviewDetailRelationships defines model for AppClipAppStoreReviewDetail.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetail/relationships
+type AppClipAppStoreReviewDetailRelationships struct {
+	AppClipDefaultExperience *Relationship `json:"appClipDefaultExperience,omitempty"`
+}
+
+// appClipAppStoreReviewDetailCreateRequest defines model for AppClipAppStoreReviewDetailCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetailcreaterequest/data
+type appClipAppStoreReviewDetailCreateRequest struct {
+	Attributes    appClipAppStoreReviewDetailAttributes                 `json:"attributes"`
+	Relationships appClipAppStoreReviewDetailCreateRequestRelationships `json:"relationships"`
+	Type          string                                                `json:"type"`
+}
+
+// appClipAppStoreReviewDetailAttributes are attributes for AppClipAppStoreReviewDetailCreateRequest
+// and AppClipAppStoreReviewDetailUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetailcreaterequest/data/attributes
+type appClipAppStoreReviewDetailAttributes struct {
+	InvocationURLs []string `json:"invocationUrls"`
+}
+
+// appClipAppStoreReviewDetailCreateRequestRelationships are relationships for AppClipAppStoreReviewDetailCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetailcreaterequest/data/relationships
+type appClipAppStoreReviewDetailCreateRequestRelationships struct {
+	AppClipDefaultExperience relationshipDeclaration `json:"appClipDefaultExperience"`
+}
+
+// appClipAppStoreReviewDetailUpdateRequest defines model for AppClipAppStoreReviewDetailUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetailupdaterequest/data
+type appClipAppStoreReviewDetailUpdateRequest struct {
+	Attributes appClipAppStoreReviewDetailAttributes `json:"attributes"`
+	ID         string                                `json:"id"`
+	Type       string                                `json:"type"`
+}
+
+// AppClipAppStoreReviewDetailResponse defines model for AppClipAppStoreReviewDetailResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipappstorereviewdetailresponse
+type AppClipAppStoreReviewDetailResponse struct {
+	Data     AppClipAppStoreReviewDetail `json:"data"`
+	Included []AppClipDefaultExperience  `json:"included,omitempty"`
+	Links    DocumentLinks               `json:"links"`
+}
+
+// ListAppClipDefaultExperiencesForAppClipQuery are query options for ListAppClipDefaultExperiencesForAppClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_default_app_clip_experiences_for_an_app_clip
+type ListAppClipDefaultExperiencesForAppClipQuery struct {
+	FieldsAppClipAppStoreReviewDetails          []string `url:"fields[appClipAppStoreReviewDetails],omitempty"`
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClipDefaultExperiences             []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	FieldsAppClips                              []string `url:"fields[appClips],omitempty"`
+	FieldsAppStoreVersions                      []string `url:"fields[appStoreVersions],omitempty"`
+	FilterReleaseWithAppStoreVersion            []string `url:"filter[releaseWithAppStoreVersion],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	LimitAppClipDefaultExperienceLocalizations  int      `url:"limit[appClipDefaultExperienceLocalizations],omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipDefaultExperienceQuery are query options for GetAppClipDefaultExperience and
+// GetAppClipDefaultExperienceForAppStoreVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_default_app_clip_experience_information
+type GetAppClipDefaultExperienceQuery struct {
+	FieldsAppClipAppStoreReviewDetails          []string `url:"fields[appClipAppStoreReviewDetails],omitempty"`
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClipDefaultExperiences             []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	FieldsAppClips                              []string `url:"fields[appClips],omitempty"`
+	FieldsAppStoreVersions                      []string `url:"fields[appStoreVersions],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	LimitAppClipDefaultExperienceLocalizations  int      `url:"limit[appClipDefaultExperienceLocalizations],omitempty"`
+}
+
+// GetAppClipAppStoreReviewDetailQuery are query options for GetAppClipAppStoreReviewDetail and
+// GetAppClipAppStoreReviewDetailForDefaultExperience
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_app_store_review_detail_information
+type GetAppClipAppStoreReviewDetailQuery struct {
+	FieldsAppClipAppStoreReviewDetails []string `url:"fields[appClipAppStoreReviewDetails],omitempty"`
+	FieldsAppClipDefaultExperiences    []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	Include                            []string `url:"include,omitempty"`
+}
+
+// ListAppClipDefaultExperiencesForAppClip lists the default experiences of an App Clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_default_app_clip_experiences_for_an_app_clip
+func (s *AppClipsService) ListAppClipDefaultExperiencesForAppClip(ctx context.Context, id string, params *ListAppClipDefaultExperiencesForAppClipQuery) (*AppClipDefaultExperiencesResponse, *Response, error) {
+	url := fmt.Sprintf("appClips/%s/appClipDefaultExperiences", id)
+	res := new(AppClipDefaultExperiencesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipDefaultExperience gets information about a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_default_app_clip_experience_information
+func (s *AppClipsService) GetAppClipDefaultExperience(ctx context.Context, id string, params *GetAppClipDefaultExperienceQuery) (*AppClipDefaultExperienceResponse, *Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperiences/%s", id)
+	res := new(AppClipDefaultExperienceResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipDefaultExperienceForAppStoreVersion gets the default App Clip experience that releases with an App Store version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_default_app_clip_experience_for_an_app_store_version
+func (s *AppClipsService) GetAppClipDefaultExperienceForAppStoreVersion(ctx context.Context, id string, params *GetAppClipDefaultExperienceQuery) (*AppClipDefaultExperienceResponse, *Response, error) {
+	url := fmt.Sprintf("appStoreVersions/%s/appClipDefaultExperience", id)
+	res := new(AppClipDefaultExperienceResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppClipDefaultExperience creates a default experience for an App Clip, optionally copying the
+// localizations and review details of an existing experience given by templateID.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_default_app_clip_experience
+func (s *AppClipsService) CreateAppClipDefaultExperience(ctx context.Context, action *AppClipAction, appClipID string, releaseWithAppStoreVersionID *string, templateID *string) (*AppClipDefaultExperienceResponse, *Response, error) {
+	req := appClipDefaultExperienceCreateRequest{
+		Relationships: appClipDefaultExperienceCreateRequestRelationships{
+			AppClip:                          *newRelationshipDeclaration(&appClipID, "appClips"),
+			AppClipDefaultExperienceTemplate: newRelationshipDeclaration(templateID, "appClipDefaultExperiences"),
+			ReleaseWithAppStoreVersion:       newRelationshipDeclaration(releaseWithAppStoreVersionID, "appStoreVersions"),
+		},
+		Type: "appClipDefaultExperiences",
+	}
+
+	if action != nil {
+		req.Attributes = &appClipDefaultExperienceCreateRequestAttributes{
+			Action: action,
+		}
+	}
+
+	res := new(AppClipDefaultExperienceResponse)
+	resp, err := s.client.post(ctx, "appClipDefaultExperiences", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipDefaultExperience changes the action of a default App Clip experience or the App Store version it releases with.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_default_app_clip_experience
+func (s *AppClipsService) UpdateAppClipDefaultExperience(ctx context.Context, id string, action *AppClipAction, releaseWithAppStoreVersionID *string) (*AppClipDefaultExperienceResponse, *Response, error) {
+	req := appClipDefaultExperienceUpdateRequest{
+		ID:   id,
+		Type: "appClipDefaultExperiences",
+	}
+
+	if action != nil {
+		req.Attributes = &appClipDefaultExperienceUpdateRequestAttributes{
+			Action: action,
+		}
+	}
+
+	if releaseWithAppStoreVersionID != nil {
+		req.Relationships = &appClipDefaultExperienceUpdateRequestRelationships{
+			ReleaseWithAppStoreVersion: newRelationshipDeclaration(releaseWithAppStoreVersionID, "appStoreVersions"),
+		}
+	}
+
+	url := fmt.Sprintf("appClipDefaultExperiences/%s", id)
+	res := new(AppClipDefaultExperienceResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppClipDefaultExperience deletes a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_default_app_clip_experience
+func (s *AppClipsService) DeleteAppClipDefaultExperience(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperiences/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetAppClipAppStoreReviewDetail gets the invocation URLs App Review uses to test a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_app_store_review_detail_information
+func (s *AppClipsService) GetAppClipAppStoreReviewDetail(ctx context.Context, id string, params *GetAppClipAppStoreReviewDetailQuery) (*AppClipAppStoreReviewDetailResponse, *Response, error) {
+	url := fmt.Sprintf("appClipAppStoreReviewDetails/%s", id)
+	res := new(AppClipAppStoreReviewDetailResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipAppStoreReviewDetailForDefaultExperience gets the App Store review details of a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_review_details_resource_information_of_a_default_app_clip_experience
+func (s *AppClipsService) GetAppClipAppStoreReviewDetailForDefaultExperience(ctx context.Context, id string, params *GetAppClipAppStoreReviewDetailQuery) (*AppClipAppStoreReviewDetailResponse, *Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperiences/%s/appClipAppStoreReviewDetail", id)
+	res := new(AppClipAppStoreReviewDetailResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppClipAppStoreReviewDetail adds the invocation URLs App Review uses to test a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_clip_app_store_review_detail_resource
+func (s *AppClipsService) CreateAppClipAppStoreReviewDetail(ctx context.Context, invocationURLs []string, appClipDefaultExperienceID string) (*AppClipAppStoreReviewDetailResponse, *Response, error) {
+	req := appClipAppStoreReviewDetailCreateRequest{
+		Attributes: appClipAppStoreReviewDetailAttributes{
+			InvocationURLs: invocationURLs,
+		},
+		Relationships: appClipAppStoreReviewDetailCreateRequestRelationships{
+			AppClipDefaultExperience: *newRelationshipDeclaration(&appClipDefaultExperienceID, "appClipDefaultExperiences"),
+		},
+		Type: "appClipAppStoreReviewDetails",
+	}
+	res := new(AppClipAppStoreReviewDetailResponse)
+	resp, err := s.client.post(ctx, "appClipAppStoreReviewDetails", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipAppStoreReviewDetail replaces the invocation URLs App Review uses to test a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_clip_app_store_review_detail_resource
+func (s *AppClipsService) UpdateAppClipAppStoreReviewDetail(ctx context.Context, id string, invocationURLs []string) (*AppClipAppStoreReviewDetailResponse, *Response, error) {
+	req := appClipAppStoreReviewDetailUpdateRequest{
+		Attributes: appClipAppStoreReviewDetailAttributes{
+			InvocationURLs: invocationURLs,
+		},
+		ID:   id,
+		Type: "appClipAppStoreReviewDetails",
+	}
+	url := fmt.Sprintf("appClipAppStoreReviewDetails/%s", id)
+	res := new(AppClipAppStoreReviewDetailResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipDefaultExperienceResponseIncluded.
+func (i *AppClipDefaultExperienceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppClip returns the AppClip stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppClip() *AppClip {
+	return extractIncludedAppClip(i.inner)
+}
+
+// AppClipAppStoreReviewDetail returns the AppClipAppStoreReviewDetail stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppClipAppStoreReviewDetail() *AppClipAppStoreReviewDetail {
+	return extractIncludedAppClipAppStoreReviewDetail(i.inner)
+}
+
+// AppClipDefaultExperienceLocalization returns the AppClipDefaultExperienceLocalization stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppClipDefaultExperienceLocalization() *AppClipDefaultExperienceLocalization {
+	return extractIncludedAppClipDefaultExperienceLocalization(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
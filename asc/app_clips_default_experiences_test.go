@@ -0,0 +1,132 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppClipDefaultExperiencesForAppClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperiencesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.ListAppClipDefaultExperiencesForAppClip(ctx, "10", &ListAppClipDefaultExperiencesForAppClipQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipDefaultExperience(ctx, "10", &GetAppClipDefaultExperienceQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperienceForAppStoreVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipDefaultExperienceForAppStoreVersion(ctx, "10", &GetAppClipDefaultExperienceQuery{})
+	})
+}
+
+func TestCreateAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	action := AppClipActionOpen
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.CreateAppClipDefaultExperience(ctx, &action, "10", String("11"), String("12"))
+	})
+}
+
+func TestUpdateAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	action := AppClipActionOpen
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.UpdateAppClipDefaultExperience(ctx, "10", &action, String("11"))
+	})
+}
+
+func TestDeleteAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.AppClips.DeleteAppClipDefaultExperience(ctx, "10")
+	})
+}
+
+func TestGetAppClipAppStoreReviewDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAppStoreReviewDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipAppStoreReviewDetail(ctx, "10", &GetAppClipAppStoreReviewDetailQuery{})
+	})
+}
+
+func TestGetAppClipAppStoreReviewDetailForDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAppStoreReviewDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipAppStoreReviewDetailForDefaultExperience(ctx, "10", &GetAppClipAppStoreReviewDetailQuery{})
+	})
+}
+
+func TestCreateAppClipAppStoreReviewDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAppStoreReviewDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.CreateAppClipAppStoreReviewDetail(ctx, []string{"https://appclip.example.com/store/1"}, "10")
+	})
+}
+
+func TestUpdateAppClipAppStoreReviewDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAppStoreReviewDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.UpdateAppClipAppStoreReviewDetail(ctx, "10", []string{"https://appclip.example.com/store/1"})
+	})
+}
+
+func TestGetAppClipDefaultExperienceIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appClips"},{"type":"appClipAppStoreReviewDetails"},{"type":"appClipDefaultExperienceLocalizations"},{"type":"appStoreVersions"}]}`, func(ctx context.Context, client *Client) {
+		experience, _, err := client.AppClips.GetAppClipDefaultExperience(ctx, "10", &GetAppClipDefaultExperienceQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, experience.Included)
+
+		assert.NotNil(t, experience.Included[0].AppClip())
+		assert.NotNil(t, experience.Included[1].AppClipAppStoreReviewDetail())
+		assert.NotNil(t, experience.Included[2].AppClipDefaultExperienceLocalization())
+		assert.NotNil(t, experience.Included[3].AppStoreVersion())
+
+		assert.Nil(t, experience.Included[0].AppClipAppStoreReviewDetail())
+		assert.Nil(t, experience.Included[1].AppClipDefaultExperienceLocalization())
+		assert.Nil(t, experience.Included[2].AppStoreVersion())
+		assert.Nil(t, experience.Included[3].AppClip())
+	})
+}
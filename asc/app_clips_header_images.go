@@ -0,0 +1,189 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppClipHeaderImage defines model for AppClipHeaderImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimage
+type AppClipHeaderImage struct {
+	Attributes    *AppClipHeaderImageAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Links         ResourceLinks                    `json:"links"`
+	Relationships *AppClipHeaderImageRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// AppClipHeaderImageAttributes defines model for AppClipHeaderImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimage/attributes
+type AppClipHeaderImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// AppClipHeaderImageRelationships defines model for AppClipHeaderImage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimage/relationships
+type AppClipHeaderImageRelationships struct {
+	AppClipDefaultExperienceLocalization *Relationship `json:"appClipDefaultExperienceLocalization,omitempty"`
+}
+
+// appClipHeaderImageCreateRequest defines model for AppClipHeaderImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimagecreaterequest/data
+type appClipHeaderImageCreateRequest struct {
+	Attributes    appClipHeaderImageCreateRequestAttributes    `json:"attributes"`
+	Relationships appClipHeaderImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                       `json:"type"`
+}
+
+// appClipHeaderImageCreateRequestAttributes are attributes for AppClipHeaderImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimagecreaterequest/data/attributes
+type appClipHeaderImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// appClipHeaderImageCreateRequestRelationships are relationships for AppClipHeaderImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimagecreaterequest/data/relationships
+type appClipHeaderImageCreateRequestRelationships struct {
+	AppClipDefaultExperienceLocalization relationshipDeclaration `json:"appClipDefaultExperienceLocalization"`
+}
+
+// appClipHeaderImageUpdateRequest defines model for AppClipHeaderImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimageupdaterequest/data
+type appClipHeaderImageUpdateRequest struct {
+	Attributes *appClipHeaderImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                     `json:"id"`
+	Type       string                                     `json:"type"`
+}
+
+// appClipHeaderImageUpdateRequestAttributes are attributes for AppClipHeaderImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimageupdaterequest/data/attributes
+type appClipHeaderImageUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// AppClipHeaderImageResponse defines model for AppClipHeaderImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimageresponse
+type AppClipHeaderImageResponse struct {
+	Data     AppClipHeaderImage                     `json:"data"`
+	Included []AppClipDefaultExperienceLocalization `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// GetAppClipHeaderImageQuery are query options for GetAppClipHeaderImage and GetAppClipHeaderImageForLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_header_image_information
+type GetAppClipHeaderImageQuery struct {
+	FieldsAppClipHeaderImages []string `url:"fields[appClipHeaderImages],omitempty"`
+	Include                   []string `url:"include,omitempty"`
+}
+
+// GetAppClipHeaderImage gets information about an App Clip header image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_header_image_information
+func (s *AppClipsService) GetAppClipHeaderImage(ctx context.Context, id string, params *GetAppClipHeaderImageQuery) (*AppClipHeaderImageResponse, *Response, error) {
+	url := fmt.Sprintf("appClipHeaderImages/%s", id)
+	res := new(AppClipHeaderImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppClipHeaderImageForLocalization gets the header image of a default App Clip experience localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_header_image_for_a_default_app_clip_experience_localization
+func (s *AppClipsService) GetAppClipHeaderImageForLocalization(ctx context.Context, id string, params *GetAppClipHeaderImageQuery) (*AppClipHeaderImageResponse, *Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperienceLocalizations/%s/appClipHeaderImage", id)
+	res := new(AppClipHeaderImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppClipHeaderImage reserves a header image for a default App Clip experience localization. Upload
+// the file with the returned upload operations using Client.Upload, then commit it with CommitAppClipHeaderImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_clip_header_image
+func (s *AppClipsService) CreateAppClipHeaderImage(ctx context.Context, fileName string, fileSize int64, appClipDefaultExperienceLocalizationID string) (*AppClipHeaderImageResponse, *Response, error) {
+	req := appClipHeaderImageCreateRequest{
+		Attributes: appClipHeaderImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: appClipHeaderImageCreateRequestRelationships{
+			AppClipDefaultExperienceLocalization: *newRelationshipDeclaration(&appClipDefaultExperienceLocalizationID, "appClipDefaultExperienceLocalizations"),
+		},
+		Type: "appClipHeaderImages",
+	}
+	res := new(AppClipHeaderImageResponse)
+	resp, err := s.client.post(ctx, "appClipHeaderImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppClipHeaderImage commits an App Clip header image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_clip_header_image
+func (s *AppClipsService) CommitAppClipHeaderImage(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*AppClipHeaderImageResponse, *Response, error) {
+	req := appClipHeaderImageUpdateRequest{
+		ID:   id,
+		Type: "appClipHeaderImages",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &appClipHeaderImageUpdateRequestAttributes{
+			SourceFileChecksum: sourceFileChecksum,
+			Uploaded:           uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appClipHeaderImages/%s", id)
+	res := new(AppClipHeaderImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppClipHeaderImage deletes an App Clip header image.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_clip_header_image
+func (s *AppClipsService) DeleteAppClipHeaderImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appClipHeaderImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,91 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppClipsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.ListAppClipsForApp(ctx, "10", &ListAppClipsForAppQuery{})
+	})
+}
+
+func TestGetAppClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClip(ctx, "10", &GetAppClipQuery{})
+	})
+}
+
+func TestGetAppClipDomainCacheStatusForBuildBundle(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDomainStatusResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipDomainCacheStatusForBuildBundle(ctx, "10", &GetAppClipDomainStatusQuery{})
+	})
+}
+
+func TestGetAppClipDomainDebugStatusForBuildBundle(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDomainStatusResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppClips.GetAppClipDomainDebugStatusForBuildBundle(ctx, "10", &GetAppClipDomainStatusQuery{})
+	})
+}
+
+func TestGetAppClipIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appClipDefaultExperiences"}]}`, func(ctx context.Context, client *Client) {
+		clip, _, err := client.AppClips.GetAppClip(ctx, "10", &GetAppClipQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, clip.Included)
+
+		assert.NotNil(t, clip.Included[0].App())
+		assert.NotNil(t, clip.Included[1].AppClipDefaultExperience())
+
+		assert.Nil(t, clip.Included[0].AppClipDefaultExperience())
+		assert.Nil(t, clip.Included[1].App())
+	})
+}
+
+func TestAppClipDomainStatusInvalidDomains(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":{"id":"10","type":"appClipDomainStatuses","attributes":{"domains":[{"domain":"example.com","isValid":true},{"domain":"shop.example.com","isValid":false,"errorCode":"BAD_HTTP_RESPONSE"},{"domain":"old.example.com"}]}}}`, func(ctx context.Context, client *Client) {
+		status, _, err := client.AppClips.GetAppClipDomainDebugStatusForBuildBundle(ctx, "10", &GetAppClipDomainStatusQuery{})
+		assert.NoError(t, err)
+
+		invalid := status.Data.InvalidDomains()
+		assert.Len(t, invalid, 2)
+		assert.Equal(t, "shop.example.com", *invalid[0].Domain)
+		assert.Equal(t, "old.example.com", *invalid[1].Domain)
+		assert.Empty(t, AppClipDomainStatus{}.InvalidDomains())
+	})
+}
@@ -0,0 +1,331 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppEventsService handles communication with in-app event-related methods of the App Store Connect API
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/app_events
+type AppEventsService service
+
+// AppEventBadge defines model for AppEventBadge.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventBadge string
+
+const (
+	// AppEventBadgeChallenge is an app event badge for Challenge.
+	AppEventBadgeChallenge AppEventBadge = "CHALLENGE"
+	// AppEventBadgeCompetition is an app event badge for Competition.
+	AppEventBadgeCompetition AppEventBadge = "COMPETITION"
+	// AppEventBadgeLiveEvent is an app event badge for LiveEvent.
+	AppEventBadgeLiveEvent AppEventBadge = "LIVE_EVENT"
+	// AppEventBadgeMajorUpdate is an app event badge for MajorUpdate.
+	AppEventBadgeMajorUpdate AppEventBadge = "MAJOR_UPDATE"
+	// AppEventBadgeNewSeason is an app event badge for NewSeason.
+	AppEventBadgeNewSeason AppEventBadge = "NEW_SEASON"
+	// AppEventBadgePremiere is an app event badge for Premiere.
+	AppEventBadgePremiere AppEventBadge = "PREMIERE"
+	// AppEventBadgeSpecialEvent is an app event badge for SpecialEvent.
+	AppEventBadgeSpecialEvent AppEventBadge = "SPECIAL_EVENT"
+)
+
+// AppEventState defines model for AppEventState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventState string
+
+const (
+	// AppEventStateAccepted is an app event state for Accepted.
+	AppEventStateAccepted AppEventState = "ACCEPTED"
+	// AppEventStateApproved is an app event state for Approved.
+	AppEventStateApproved AppEventState = "APPROVED"
+	// AppEventStateArchived is an app event state for Archived.
+	AppEventStateArchived AppEventState = "ARCHIVED"
+	// AppEventStateDraft is an app event state for Draft.
+	AppEventStateDraft AppEventState = "DRAFT"
+	// AppEventStateInReview is an app event state for InReview.
+	AppEventStateInReview AppEventState = "IN_REVIEW"
+	// AppEventStatePast is an app event state for Past.
+	AppEventStatePast AppEventState = "PAST"
+	// AppEventStatePublished is an app event state for Published.
+	AppEventStatePublished AppEventState = "PUBLISHED"
+	// AppEventStateReadyForReview is an app event state for ReadyForReview.
+	AppEventStateReadyForReview AppEventState = "READY_FOR_REVIEW"
+	// AppEventStateRejected is an app event state for Rejected.
+	AppEventStateRejected AppEventState = "REJECTED"
+	// AppEventStateWaitingForReview is an app event state for WaitingForReview.
+	AppEventStateWaitingForReview AppEventState = "WAITING_FOR_REVIEW"
+)
+
+// AppEventPriority defines model for AppEventPriority.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventPriority string
+
+const (
+	// AppEventPriorityHigh is an app event priority for High.
+	AppEventPriorityHigh AppEventPriority = "HIGH"
+	// AppEventPriorityNormal is an app event priority for Normal.
+	AppEventPriorityNormal AppEventPriority = "NORMAL"
+)
+
+// AppEventPurchaseRequirement defines model for AppEventPurchaseRequirement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventPurchaseRequirement string
+
+const (
+	// AppEventPurchaseRequirementInAppPurchase is an app event purchase requirement for InAppPurchase.
+	AppEventPurchaseRequirementInAppPurchase AppEventPurchaseRequirement = "IN_APP_PURCHASE"
+	// AppEventPurchaseRequirementInAppPurchaseAndSubscription is an app event purchase requirement for InAppPurchaseAndSubscription.
+	AppEventPurchaseRequirementInAppPurchaseAndSubscription AppEventPurchaseRequirement = "IN_APP_PURCHASE_AND_SUBSCRIPTION"
+	// AppEventPurchaseRequirementInAppPurchaseOrSubscription is an app event purchase requirement for InAppPurchaseOrSubscription.
+	AppEventPurchaseRequirementInAppPurchaseOrSubscription AppEventPurchaseRequirement = "IN_APP_PURCHASE_OR_SUBSCRIPTION"
+	// AppEventPurchaseRequirementNoCostAssociated is an app event purchase requirement for NoCostAssociated.
+	AppEventPurchaseRequirementNoCostAssociated AppEventPurchaseRequirement = "NO_COST_ASSOCIATED"
+	// AppEventPurchaseRequirementSubscription is an app event purchase requirement for Subscription.
+	AppEventPurchaseRequirementSubscription AppEventPurchaseRequirement = "SUBSCRIPTION"
+)
+
+// AppEventPurpose defines model for AppEventPurpose.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventPurpose string
+
+const (
+	// AppEventPurposeAppropriateForAllUsers is an app event purpose for AppropriateForAllUsers.
+	AppEventPurposeAppropriateForAllUsers AppEventPurpose = "APPROPRIATE_FOR_ALL_USERS"
+	// AppEventPurposeAttractNewUsers is an app event purpose for AttractNewUsers.
+	AppEventPurposeAttractNewUsers AppEventPurpose = "ATTRACT_NEW_USERS"
+	// AppEventPurposeBringBackLapsedUsers is an app event purpose for BringBackLapsedUsers.
+	AppEventPurposeBringBackLapsedUsers AppEventPurpose = "BRING_BACK_LAPSED_USERS"
+	// AppEventPurposeKeepActiveUsersInformed is an app event purpose for KeepActiveUsersInformed.
+	AppEventPurposeKeepActiveUsersInformed AppEventPurpose = "KEEP_ACTIVE_USERS_INFORMED"
+)
+
+// AppEventTerritorySchedule defines model for AppEvent.Attributes.TerritorySchedules
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes/territoryschedules
+type AppEventTerritorySchedule struct {
+	EventEnd     *DateTime `json:"eventEnd,omitempty"`
+	EventStart   *DateTime `json:"eventStart,omitempty"`
+	PublishStart *DateTime `json:"publishStart,omitempty"`
+	Territories  []string  `json:"territories,omitempty"`
+}
+
+// AppEvent defines model for AppEvent.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent
+type AppEvent struct {
+	Attributes    *AppEventAttributes    `json:"attributes,omitempty"`
+	ID            string                 `json:"id"`
+	Links         ResourceLinks          `json:"links"`
+	Relationships *AppEventRelationships `json:"relationships,omitempty"`
+	Type          string                 `json:"type"`
+}
+
+// AppEventAttributes defines model for AppEvent.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventAttributes struct {
+	ArchivedTerritorySchedules []AppEventTerritorySchedule  `json:"archivedTerritorySchedules,omitempty"`
+	Badge                      *AppEventBadge               `json:"badge,omitempty"`
+	DeepLink                   *string                      `json:"deepLink,omitempty"`
+	EventState                 *AppEventState               `json:"eventState,omitempty"`
+	PrimaryLocale              *string                      `json:"primaryLocale,omitempty"`
+	Priority                   *AppEventPriority            `json:"priority,omitempty"`
+	PurchaseRequirement        *AppEventPurchaseRequirement `json:"purchaseRequirement,omitempty"`
+	Purpose                    *AppEventPurpose             `json:"purpose,omitempty"`
+	ReferenceName              *string                      `json:"referenceName,omitempty"`
+	TerritorySchedules         []AppEventTerritorySchedule  `json:"territorySchedules,omitempty"`
+}
+
+// AppEventRelationships defines model for AppEvent.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/relationships
+type AppEventRelationships struct {
+	Localizations *PagedRelationship `json:"localizations,omitempty"`
+}
+
+// appEventCreateRequest defines model for AppEventCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventcreaterequest/data
+type appEventCreateRequest struct {
+	Attributes    AppEventCreateRequestAttributes    `json:"attributes"`
+	Relationships appEventCreateRequestRelationships `json:"relationships"`
+	Type          string                             `json:"type"`
+}
+
+// AppEventCreateRequestAttributes are attributes for AppEventCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventcreaterequest/data/attributes
+type AppEventCreateRequestAttributes struct {
+	Badge               *AppEventBadge               `json:"badge,omitempty"`
+	DeepLink            *string                      `json:"deepLink,omitempty"`
+	PrimaryLocale       *string                      `json:"primaryLocale,omitempty"`
+	Priority            *AppEventPriority            `json:"priority,omitempty"`
+	PurchaseRequirement *AppEventPurchaseRequirement `json:"purchaseRequirement,omitempty"`
+	Purpose             *AppEventPurpose             `json:"purpose,omitempty"`
+	ReferenceName       string                       `json:"referenceName"`
+	TerritorySchedules  []AppEventTerritorySchedule  `json:"territorySchedules,omitempty"`
+}
+
+// appEventCreateRequestRelationships are relationships for AppEventCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventcreaterequest/data/relationships
+type appEventCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// appEventUpdateRequest defines model for AppEventUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventupdaterequest/data
+type appEventUpdateRequest struct {
+	Attributes *AppEventUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                           `json:"id"`
+	Type       string                           `json:"type"`
+}
+
+// AppEventUpdateRequestAttributes are attributes for AppEventUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventupdaterequest/data/attributes
+type AppEventUpdateRequestAttributes struct {
+	Badge               *AppEventBadge               `json:"badge,omitempty"`
+	DeepLink            *string                      `json:"deepLink,omitempty"`
+	PrimaryLocale       *string                      `json:"primaryLocale,omitempty"`
+	Priority            *AppEventPriority            `json:"priority,omitempty"`
+	PurchaseRequirement *AppEventPurchaseRequirement `json:"purchaseRequirement,omitempty"`
+	Purpose             *AppEventPurpose             `json:"purpose,omitempty"`
+	ReferenceName       *string                      `json:"referenceName,omitempty"`
+	TerritorySchedules  []AppEventTerritorySchedule  `json:"territorySchedules,omitempty"`
+}
+
+// AppEventResponse defines model for AppEventResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventresponse
+type AppEventResponse struct {
+	Data     AppEvent               `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// AppEventsResponse defines model for AppEventsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventsresponse
+type AppEventsResponse struct {
+	Data     []AppEvent             `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    PagedDocumentLinks     `json:"links"`
+	Meta     *PagingInformation     `json:"meta,omitempty"`
+}
+
+// ListAppEventsForAppQuery are query options for ListAppEventsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_events_for_an_app
+type ListAppEventsForAppQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEvents             []string `url:"fields[appEvents],omitempty"`
+	FilterEventState            []string `url:"filter[eventState],omitempty"`
+	FilterID                    []string `url:"filter[id],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitLocalizations          int      `url:"limit[localizations],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventQuery are query options for GetAppEvent
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_information
+type GetAppEventQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEvents             []string `url:"fields[appEvents],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitLocalizations          int      `url:"limit[localizations],omitempty"`
+}
+
+// ListAppEventsForApp lists the in-app events of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_events_for_an_app
+func (s *AppEventsService) ListAppEventsForApp(ctx context.Context, id string, params *ListAppEventsForAppQuery) (*AppEventsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appEvents", id)
+	res := new(AppEventsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppEvent gets information about a specific in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_information
+func (s *AppEventsService) GetAppEvent(ctx context.Context, id string, params *GetAppEventQuery) (*AppEventResponse, *Response, error) {
+	url := fmt.Sprintf("appEvents/%s", id)
+	res := new(AppEventResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppEvent creates an in-app event for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_event
+func (s *AppEventsService) CreateAppEvent(ctx context.Context, attributes AppEventCreateRequestAttributes, appID string) (*AppEventResponse, *Response, error) {
+	req := appEventCreateRequest{
+		Attributes: attributes,
+		Relationships: appEventCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "appEvents",
+	}
+	res := new(AppEventResponse)
+	resp, err := s.client.post(ctx, "appEvents", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppEvent updates the details, schedule or deep link of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_event
+func (s *AppEventsService) UpdateAppEvent(ctx context.Context, id string, attributes *AppEventUpdateRequestAttributes) (*AppEventResponse, *Response, error) {
+	req := appEventUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appEvents",
+	}
+	url := fmt.Sprintf("appEvents/%s", id)
+	res := new(AppEventResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEvent deletes an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_event
+func (s *AppEventsService) DeleteAppEvent(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEvents/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
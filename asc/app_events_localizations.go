@@ -0,0 +1,239 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppEventLocalization defines model for AppEventLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalization
+type AppEventLocalization struct {
+	Attributes    *AppEventLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *AppEventLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// AppEventLocalizationAttributes defines model for AppEventLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalization/attributes
+type AppEventLocalizationAttributes struct {
+	Locale           *string `json:"locale,omitempty"`
+	LongDescription  *string `json:"longDescription,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"shortDescription,omitempty"`
+}
+
+// AppEventLocalizationRelationships defines model for AppEventLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalization/relationships
+type AppEventLocalizationRelationships struct {
+	AppEvent            *Relationship      `json:"appEvent,omitempty"`
+	AppEventScreenshots *PagedRelationship `json:"appEventScreenshots,omitempty"`
+	AppEventVideoClips  *PagedRelationship `json:"appEventVideoClips,omitempty"`
+}
+
+// appEventLocalizationCreateRequest defines model for AppEventLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationcreaterequest/data
+type appEventLocalizationCreateRequest struct {
+	Attributes    AppEventLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appEventLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// AppEventLocalizationCreateRequestAttributes are attributes for AppEventLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationcreaterequest/data/attributes
+type AppEventLocalizationCreateRequestAttributes struct {
+	Locale           string  `json:"locale"`
+	LongDescription  *string `json:"longDescription,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"shortDescription,omitempty"`
+}
+
+// appEventLocalizationCreateRequestRelationships are relationships for AppEventLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationcreaterequest/data/relationships
+type appEventLocalizationCreateRequestRelationships struct {
+	AppEvent relationshipDeclaration `json:"appEvent"`
+}
+
+// appEventLocalizationUpdateRequest defines model for AppEventLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationupdaterequest/data
+type appEventLocalizationUpdateRequest struct {
+	Attributes *AppEventLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                       `json:"id"`
+	Type       string                                       `json:"type"`
+}
+
+// AppEventLocalizationUpdateRequestAttributes are attributes for AppEventLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationupdaterequest/data/attributes
+type AppEventLocalizationUpdateRequestAttributes struct {
+	LongDescription  *string `json:"longDescription,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"shortDescription,omitempty"`
+}
+
+// AppEventLocalizationResponse defines model for AppEventLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationresponse
+type AppEventLocalizationResponse struct {
+	Data     AppEventLocalization                   `json:"data"`
+	Included []AppEventLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// AppEventLocalizationsResponse defines model for AppEventLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationsresponse
+type AppEventLocalizationsResponse struct {
+	Data     []AppEventLocalization                 `json:"data"`
+	Included []AppEventLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                     `json:"links"`
+	Meta     *PagingInformation                     `json:"meta,omitempty"`
+}
+
+// AppEventLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppEventLocalizationResponse or AppEventLocalizationsResponse.
+type AppEventLocalizationResponseIncluded included
+
+// ListLocalizationsForAppEventQuery are query options for ListLocalizationsForAppEvent
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_app_event
+type ListLocalizationsForAppEventQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEvents             []string `url:"fields[appEvents],omitempty"`
+	FieldsAppEventScreenshots   []string `url:"fields[appEventScreenshots],omitempty"`
+	FieldsAppEventVideoClips    []string `url:"fields[appEventVideoClips],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitAppEventScreenshots    int      `url:"limit[appEventScreenshots],omitempty"`
+	LimitAppEventVideoClips     int      `url:"limit[appEventVideoClips],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventLocalizationQuery are query options for GetAppEventLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_localization_information
+type GetAppEventLocalizationQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEvents             []string `url:"fields[appEvents],omitempty"`
+	FieldsAppEventScreenshots   []string `url:"fields[appEventScreenshots],omitempty"`
+	FieldsAppEventVideoClips    []string `url:"fields[appEventVideoClips],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitAppEventScreenshots    int      `url:"limit[appEventScreenshots],omitempty"`
+	LimitAppEventVideoClips     int      `url:"limit[appEventVideoClips],omitempty"`
+}
+
+// ListLocalizationsForAppEvent lists the localized names and descriptions of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_app_event
+func (s *AppEventsService) ListLocalizationsForAppEvent(ctx context.Context, id string, params *ListLocalizationsForAppEventQuery) (*AppEventLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("appEvents/%s/localizations", id)
+	res := new(AppEventLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppEventLocalization gets a specific localization of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_localization_information
+func (s *AppEventsService) GetAppEventLocalization(ctx context.Context, id string, params *GetAppEventLocalizationQuery) (*AppEventLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("appEventLocalizations/%s", id)
+	res := new(AppEventLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppEventLocalization adds a localization to an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_event_localization
+func (s *AppEventsService) CreateAppEventLocalization(ctx context.Context, attributes AppEventLocalizationCreateRequestAttributes, appEventID string) (*AppEventLocalizationResponse, *Response, error) {
+	req := appEventLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: appEventLocalizationCreateRequestRelationships{
+			AppEvent: *newRelationshipDeclaration(&appEventID, "appEvents"),
+		},
+		Type: "appEventLocalizations",
+	}
+	res := new(AppEventLocalizationResponse)
+	resp, err := s.client.post(ctx, "appEventLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppEventLocalization updates the name and descriptions of an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_event_localization
+func (s *AppEventsService) UpdateAppEventLocalization(ctx context.Context, id string, attributes *AppEventLocalizationUpdateRequestAttributes) (*AppEventLocalizationResponse, *Response, error) {
+	req := appEventLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appEventLocalizations",
+	}
+	url := fmt.Sprintf("appEventLocalizations/%s", id)
+	res := new(AppEventLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEventLocalization deletes a localization of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_event_localization
+func (s *AppEventsService) DeleteAppEventLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEventLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppEventLocalizationResponseIncluded.
+func (i *AppEventLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppEvent returns the AppEvent stored within, if one is present.
+func (i *AppEventLocalizationResponseIncluded) AppEvent() *AppEvent {
+	return extractIncludedAppEvent(i.inner)
+}
+
+// AppEventScreenshot returns the AppEventScreenshot stored within, if one is present.
+func (i *AppEventLocalizationResponseIncluded) AppEventScreenshot() *AppEventScreenshot {
+	return extractIncludedAppEventScreenshot(i.inner)
+}
+
+// AppEventVideoClip returns the AppEventVideoClip stored within, if one is present.
+func (i *AppEventLocalizationResponseIncluded) AppEventVideoClip() *AppEventVideoClip {
+	return extractIncludedAppEventVideoClip(i.inner)
+}
@@ -0,0 +1,86 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.ListLocalizationsForAppEvent(ctx, "10", &ListLocalizationsForAppEventQuery{})
+	})
+}
+
+func TestGetAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.GetAppEventLocalization(ctx, "10", &GetAppEventLocalizationQuery{})
+	})
+}
+
+func TestCreateAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.CreateAppEventLocalization(ctx, AppEventLocalizationCreateRequestAttributes{Locale: "en-US"}, "10")
+	})
+}
+
+func TestUpdateAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.UpdateAppEventLocalization(ctx, "10", &AppEventLocalizationUpdateRequestAttributes{})
+	})
+}
+
+func TestDeleteAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.AppEvents.DeleteAppEventLocalization(ctx, "10")
+	})
+}
+
+func TestGetAppEventLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appEvents"},{"type":"appEventScreenshots"},{"type":"appEventVideoClips"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.AppEvents.GetAppEventLocalization(ctx, "10", &GetAppEventLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].AppEvent())
+		assert.NotNil(t, localization.Included[1].AppEventScreenshot())
+		assert.NotNil(t, localization.Included[2].AppEventVideoClip())
+
+		assert.Nil(t, localization.Included[0].AppEventScreenshot())
+		assert.Nil(t, localization.Included[1].AppEventVideoClip())
+		assert.Nil(t, localization.Included[2].AppEvent())
+	})
+}
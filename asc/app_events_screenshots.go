@@ -0,0 +1,224 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppEventAssetType defines model for AppEventAssetType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventassettype
+type AppEventAssetType string
+
+const (
+	// AppEventAssetTypeEventCard is an app event asset type for the event card shown in search and on the App Store.
+	AppEventAssetTypeEventCard AppEventAssetType = "EVENT_CARD"
+	// AppEventAssetTypeEventDetailsPage is an app event asset type for the event details page.
+	AppEventAssetTypeEventDetailsPage AppEventAssetType = "EVENT_DETAILS_PAGE"
+)
+
+// AppEventScreenshot defines model for AppEventScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshot
+type AppEventScreenshot struct {
+	Attributes    *AppEventScreenshotAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Links         ResourceLinks                    `json:"links"`
+	Relationships *AppEventScreenshotRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// AppEventScreenshotAttributes defines model for AppEventScreenshot.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshot/attributes
+type AppEventScreenshotAttributes struct {
+	AppEventAssetType  *AppEventAssetType  `json:"appEventAssetType,omitempty"`
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	AssetToken         *string             `json:"assetToken,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// AppEventScreenshotRelationships defines model for AppEventScreenshot.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshot/relationships
+type AppEventScreenshotRelationships struct {
+	AppEventLocalization *Relationship `json:"appEventLocalization,omitempty"`
+}
+
+// appEventScreenshotCreateRequest defines model for AppEventScreenshotCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotcreaterequest/data
+type appEventScreenshotCreateRequest struct {
+	Attributes    appEventScreenshotCreateRequestAttributes    `json:"attributes"`
+	Relationships appEventScreenshotCreateRequestRelationships `json:"relationships"`
+	Type          string                                       `json:"type"`
+}
+
+// appEventScreenshotCreateRequestAttributes are attributes for AppEventScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotcreaterequest/data/attributes
+type appEventScreenshotCreateRequestAttributes struct {
+	AppEventAssetType AppEventAssetType `json:"appEventAssetType"`
+	FileName          string            `json:"fileName"`
+	FileSize          int64             `json:"fileSize"`
+}
+
+// appEventScreenshotCreateRequestRelationships are relationships for AppEventScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotcreaterequest/data/relationships
+type appEventScreenshotCreateRequestRelationships struct {
+	AppEventLocalization relationshipDeclaration `json:"appEventLocalization"`
+}
+
+// appEventScreenshotUpdateRequest defines model for AppEventScreenshotUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotupdaterequest/data
+type appEventScreenshotUpdateRequest struct {
+	Attributes *appEventScreenshotUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                     `json:"id"`
+	Type       string                                     `json:"type"`
+}
+
+// appEventScreenshotUpdateRequestAttributes are attributes for AppEventScreenshotUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotupdaterequest/data/attributes
+type appEventScreenshotUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// AppEventScreenshotResponse defines model for AppEventScreenshotResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotresponse
+type AppEventScreenshotResponse struct {
+	Data     AppEventScreenshot     `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// AppEventScreenshotsResponse defines model for AppEventScreenshotsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotsresponse
+type AppEventScreenshotsResponse struct {
+	Data     []AppEventScreenshot   `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    PagedDocumentLinks     `json:"links"`
+	Meta     *PagingInformation     `json:"meta,omitempty"`
+}
+
+// ListAppEventScreenshotsQuery are query options for ListAppEventScreenshotsForLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_screenshots_for_an_app_event_localization
+type ListAppEventScreenshotsQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEventScreenshots   []string `url:"fields[appEventScreenshots],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventScreenshotQuery are query options for GetAppEventScreenshot
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_screenshot_information
+type GetAppEventScreenshotQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEventScreenshots   []string `url:"fields[appEventScreenshots],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+}
+
+// ListAppEventScreenshotsForLocalization lists the event card and details page images of an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_screenshots_for_an_app_event_localization
+func (s *AppEventsService) ListAppEventScreenshotsForLocalization(ctx context.Context, id string, params *ListAppEventScreenshotsQuery) (*AppEventScreenshotsResponse, *Response, error) {
+	url := fmt.Sprintf("appEventLocalizations/%s/appEventScreenshots", id)
+	res := new(AppEventScreenshotsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppEventScreenshot gets information about an in-app event image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_screenshot_information
+func (s *AppEventsService) GetAppEventScreenshot(ctx context.Context, id string, params *GetAppEventScreenshotQuery) (*AppEventScreenshotResponse, *Response, error) {
+	url := fmt.Sprintf("appEventScreenshots/%s", id)
+	res := new(AppEventScreenshotResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppEventScreenshot reserves an event card or details page image for an in-app event localization. Upload
+// the file with the returned upload operations using Client.Upload, then commit it with CommitAppEventScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_event_screenshot
+func (s *AppEventsService) CreateAppEventScreenshot(ctx context.Context, assetType AppEventAssetType, fileName string, fileSize int64, appEventLocalizationID string) (*AppEventScreenshotResponse, *Response, error) {
+	req := appEventScreenshotCreateRequest{
+		Attributes: appEventScreenshotCreateRequestAttributes{
+			AppEventAssetType: assetType,
+			FileName:          fileName,
+			FileSize:          fileSize,
+		},
+		Relationships: appEventScreenshotCreateRequestRelationships{
+			AppEventLocalization: *newRelationshipDeclaration(&appEventLocalizationID, "appEventLocalizations"),
+		},
+		Type: "appEventScreenshots",
+	}
+	res := new(AppEventScreenshotResponse)
+	resp, err := s.client.post(ctx, "appEventScreenshots", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppEventScreenshot commits an in-app event image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_event_screenshot
+func (s *AppEventsService) CommitAppEventScreenshot(ctx context.Context, id string, uploaded *bool) (*AppEventScreenshotResponse, *Response, error) {
+	req := appEventScreenshotUpdateRequest{
+		ID:   id,
+		Type: "appEventScreenshots",
+	}
+
+	if uploaded != nil {
+		req.Attributes = &appEventScreenshotUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appEventScreenshots/%s", id)
+	res := new(AppEventScreenshotResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEventScreenshot deletes an in-app event image.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_event_screenshot
+func (s *AppEventsService) DeleteAppEventScreenshot(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEventScreenshots/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
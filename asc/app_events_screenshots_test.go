@@ -0,0 +1,66 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListAppEventScreenshotsForLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.ListAppEventScreenshotsForLocalization(ctx, "10", &ListAppEventScreenshotsQuery{})
+	})
+}
+
+func TestGetAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.GetAppEventScreenshot(ctx, "10", &GetAppEventScreenshotQuery{})
+	})
+}
+
+func TestCreateAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.CreateAppEventScreenshot(ctx, AppEventAssetTypeEventCard, "card.png", 20, "10")
+	})
+}
+
+func TestCommitAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.CommitAppEventScreenshot(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.AppEvents.DeleteAppEventScreenshot(ctx, "10")
+	})
+}
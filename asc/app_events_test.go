@@ -0,0 +1,66 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListAppEventsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.ListAppEventsForApp(ctx, "10", &ListAppEventsForAppQuery{})
+	})
+}
+
+func TestGetAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.GetAppEvent(ctx, "10", &GetAppEventQuery{})
+	})
+}
+
+func TestCreateAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.CreateAppEvent(ctx, AppEventCreateRequestAttributes{ReferenceName: "Summer Games"}, "10")
+	})
+}
+
+func TestUpdateAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.UpdateAppEvent(ctx, "10", &AppEventUpdateRequestAttributes{})
+	})
+}
+
+func TestDeleteAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.AppEvents.DeleteAppEvent(ctx, "10")
+	})
+}
@@ -0,0 +1,218 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppEventVideoClip defines model for AppEventVideoClip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclip
+type AppEventVideoClip struct {
+	Attributes    *AppEventVideoClipAttributes    `json:"attributes,omitempty"`
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *AppEventVideoClipRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// AppEventVideoClipAttributes defines model for AppEventVideoClip.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclip/attributes
+type AppEventVideoClipAttributes struct {
+	AppEventAssetType    *AppEventAssetType  `json:"appEventAssetType,omitempty"`
+	AssetDeliveryState   *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName             *string             `json:"fileName,omitempty"`
+	FileSize             *int64              `json:"fileSize,omitempty"`
+	PreviewFrameTimeCode *string             `json:"previewFrameTimeCode,omitempty"`
+	PreviewImage         *ImageAsset         `json:"previewImage,omitempty"`
+	UploadOperations     []UploadOperation   `json:"uploadOperations,omitempty"`
+	VideoURL             *string             `json:"videoUrl,omitempty"`
+}
+
+// AppEventVideoClipRelationships defines model for AppEventVideoClip.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclip/relationships
+type AppEventVideoClipRelationships struct {
+	AppEventLocalization *Relationship `json:"appEventLocalization,omitempty"`
+}
+
+// appEventVideoClipCreateRequest defines model for AppEventVideoClipCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipcreaterequest/data
+type appEventVideoClipCreateRequest struct {
+	Attributes    appEventVideoClipCreateRequestAttributes    `json:"attributes"`
+	Relationships appEventVideoClipCreateRequestRelationships `json:"relationships"`
+	Type          string                                      `json:"type"`
+}
+
+// appEventVideoClipCreateRequestAttributes are attributes for AppEventVideoClipCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipcreaterequest/data/attributes
+type appEventVideoClipCreateRequestAttributes struct {
+	AppEventAssetType    AppEventAssetType `json:"appEventAssetType"`
+	FileName             string            `json:"fileName"`
+	FileSize             int64             `json:"fileSize"`
+	PreviewFrameTimeCode *string           `json:"previewFrameTimeCode,omitempty"`
+}
+
+// appEventVideoClipCreateRequestRelationships are relationships for AppEventVideoClipCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipcreaterequest/data/relationships
+type appEventVideoClipCreateRequestRelationships struct {
+	AppEventLocalization relationshipDeclaration `json:"appEventLocalization"`
+}
+
+// appEventVideoClipUpdateRequest defines model for AppEventVideoClipUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipupdaterequest/data
+type appEventVideoClipUpdateRequest struct {
+	Attributes *appEventVideoClipUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                    `json:"id"`
+	Type       string                                    `json:"type"`
+}
+
+// appEventVideoClipUpdateRequestAttributes are attributes for AppEventVideoClipUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipupdaterequest/data/attributes
+type appEventVideoClipUpdateRequestAttributes struct {
+	PreviewFrameTimeCode *string `json:"previewFrameTimeCode,omitempty"`
+	Uploaded             *bool   `json:"uploaded,omitempty"`
+}
+
+// AppEventVideoClipResponse defines model for AppEventVideoClipResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipresponse
+type AppEventVideoClipResponse struct {
+	Data     AppEventVideoClip      `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// AppEventVideoClipsResponse defines model for AppEventVideoClipsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipsresponse
+type AppEventVideoClipsResponse struct {
+	Data     []AppEventVideoClip    `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    PagedDocumentLinks     `json:"links"`
+	Meta     *PagingInformation     `json:"meta,omitempty"`
+}
+
+// ListAppEventVideoClipsQuery are query options for ListAppEventVideoClipsForLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_video_clips_for_an_app_event_localization
+type ListAppEventVideoClipsQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEventVideoClips    []string `url:"fields[appEventVideoClips],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventVideoClipQuery are query options for GetAppEventVideoClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_video_clip_information
+type GetAppEventVideoClipQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEventVideoClips    []string `url:"fields[appEventVideoClips],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+}
+
+// ListAppEventVideoClipsForLocalization lists the video clips of an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_video_clips_for_an_app_event_localization
+func (s *AppEventsService) ListAppEventVideoClipsForLocalization(ctx context.Context, id string, params *ListAppEventVideoClipsQuery) (*AppEventVideoClipsResponse, *Response, error) {
+	url := fmt.Sprintf("appEventLocalizations/%s/appEventVideoClips", id)
+	res := new(AppEventVideoClipsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppEventVideoClip gets information about an in-app event video clip and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_event_video_clip_information
+func (s *AppEventsService) GetAppEventVideoClip(ctx context.Context, id string, params *GetAppEventVideoClipQuery) (*AppEventVideoClipResponse, *Response, error) {
+	url := fmt.Sprintf("appEventVideoClips/%s", id)
+	res := new(AppEventVideoClipResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppEventVideoClip reserves a video clip for an in-app event localization. Upload the file with the
+// returned upload operations using Client.Upload, then commit it with CommitAppEventVideoClip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_event_video_clip
+func (s *AppEventsService) CreateAppEventVideoClip(ctx context.Context, assetType AppEventAssetType, fileName string, fileSize int64, previewFrameTimeCode *string, appEventLocalizationID string) (*AppEventVideoClipResponse, *Response, error) {
+	req := appEventVideoClipCreateRequest{
+		Attributes: appEventVideoClipCreateRequestAttributes{
+			AppEventAssetType:    assetType,
+			FileName:             fileName,
+			FileSize:             fileSize,
+			PreviewFrameTimeCode: previewFrameTimeCode,
+		},
+		Relationships: appEventVideoClipCreateRequestRelationships{
+			AppEventLocalization: *newRelationshipDeclaration(&appEventLocalizationID, "appEventLocalizations"),
+		},
+		Type: "appEventVideoClips",
+	}
+	res := new(AppEventVideoClipResponse)
+	resp, err := s.client.post(ctx, "appEventVideoClips", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppEventVideoClip commits an in-app event video clip after uploading it, or changes the frame used
+// as its poster image.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_event_video_clip
+func (s *AppEventsService) CommitAppEventVideoClip(ctx context.Context, id string, uploaded *bool, previewFrameTimeCode *string) (*AppEventVideoClipResponse, *Response, error) {
+	req := appEventVideoClipUpdateRequest{
+		ID:   id,
+		Type: "appEventVideoClips",
+	}
+
+	if uploaded != nil || previewFrameTimeCode != nil {
+		req.Attributes = &appEventVideoClipUpdateRequestAttributes{
+			PreviewFrameTimeCode: previewFrameTimeCode,
+			Uploaded:             uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appEventVideoClips/%s", id)
+	res := new(AppEventVideoClipResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEventVideoClip deletes an in-app event video clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_event_video_clip
+func (s *AppEventsService) DeleteAppEventVideoClip(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEventVideoClips/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,66 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListAppEventVideoClipsForLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.ListAppEventVideoClipsForLocalization(ctx, "10", &ListAppEventVideoClipsQuery{})
+	})
+}
+
+func TestGetAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.GetAppEventVideoClip(ctx, "10", &GetAppEventVideoClipQuery{})
+	})
+}
+
+func TestCreateAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.CreateAppEventVideoClip(ctx, AppEventAssetTypeEventDetailsPage, "clip.mov", 20, String("00:00:05:00"), "10")
+	})
+}
+
+func TestCommitAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.AppEvents.CommitAppEventVideoClip(ctx, "10", Bool(true), nil)
+	})
+}
+
+func TestDeleteAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.AppEvents.DeleteAppEventVideoClip(ctx, "10")
+	})
+}
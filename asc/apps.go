@@ -45,6 +45,26 @@ const (
 	PlatformTVOS Platform = "TV_OS"
 )
 
+// DeviceFamily defines model for DeviceFamily.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/devicefamily
+type DeviceFamily string
+
+const (
+	// DeviceFamilyIPhone is a device family for iPhone.
+	DeviceFamilyIPhone DeviceFamily = "IPHONE"
+	// DeviceFamilyIPad is a device family for iPad.
+	DeviceFamilyIPad DeviceFamily = "IPAD"
+	// DeviceFamilyAppleTV is a device family for AppleTV.
+	DeviceFamilyAppleTV DeviceFamily = "APPLE_TV"
+	// DeviceFamilyAppleWatch is a device family for AppleWatch.
+	DeviceFamilyAppleWatch DeviceFamily = "APPLE_WATCH"
+	// DeviceFamilyMac is a device family for Mac.
+	DeviceFamilyMac DeviceFamily = "MAC"
+	// DeviceFamilyVision is a device family for Vision.
+	DeviceFamilyVision DeviceFamily = "VISION"
+)
+
 // App defines model for App.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/app
@@ -73,6 +93,7 @@ type AppAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/app/relationships
 type AppRelationships struct {
+	AccessibilityDeclarations *PagedRelationship `json:"accessibilityDeclarations,omitempty"`
 	AppInfos                  *PagedRelationship `json:"appInfos,omitempty"`
 	AppStoreVersions          *PagedRelationship `json:"appStoreVersions,omitempty"`
 	AvailableTerritories      *PagedRelationship `json:"availableTerritories,omitempty"`
@@ -349,6 +370,9 @@ func (r NewAppPriceRelationship) relationship(index int) appPriceRelationship {
 // Furthermore, changes made to app pricing and available territories take effect immediately. Take caution when testing this API against live apps.
 // If you discover any unusual behavior when customizing pricing relationships with this method, please file an issue.
 //
+// availableTerritoryIDs uses the deprecated v1 availability model. Use PricingService.CreateAppAvailability
+// and PricingService.UpdateTerritoryAvailability to manage availability per territory instead.
+//
 // https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app
 // https://help.apple.com/app-store-connect/#/dev9fc06e23d
 // https://developer.apple.com/videos/play/wwdc2020/10004/
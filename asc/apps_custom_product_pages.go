@@ -0,0 +1,448 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppCustomProductPageVersionState defines model for AppCustomProductPageVersionState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion/attributes
+type AppCustomProductPageVersionState string
+
+const (
+	// AppCustomProductPageVersionStateAccepted is a custom product page version state for Accepted.
+	AppCustomProductPageVersionStateAccepted AppCustomProductPageVersionState = "ACCEPTED"
+	// AppCustomProductPageVersionStateApproved is a custom product page version state for Approved.
+	AppCustomProductPageVersionStateApproved AppCustomProductPageVersionState = "APPROVED"
+	// AppCustomProductPageVersionStateInReview is a custom product page version state for InReview.
+	AppCustomProductPageVersionStateInReview AppCustomProductPageVersionState = "IN_REVIEW"
+	// AppCustomProductPageVersionStatePrepareForSubmission is a custom product page version state for PrepareForSubmission.
+	AppCustomProductPageVersionStatePrepareForSubmission AppCustomProductPageVersionState = "PREPARE_FOR_SUBMISSION"
+	// AppCustomProductPageVersionStateReadyForReview is a custom product page version state for ReadyForReview.
+	AppCustomProductPageVersionStateReadyForReview AppCustomProductPageVersionState = "READY_FOR_REVIEW"
+	// AppCustomProductPageVersionStateRejected is a custom product page version state for Rejected.
+	AppCustomProductPageVersionStateRejected AppCustomProductPageVersionState = "REJECTED"
+	// AppCustomProductPageVersionStateReplacedWithNewVersion is a custom product page version state for ReplacedWithNewVersion.
+	AppCustomProductPageVersionStateReplacedWithNewVersion AppCustomProductPageVersionState = "REPLACED_WITH_NEW_VERSION"
+	// AppCustomProductPageVersionStateWaitingForReview is a custom product page version state for WaitingForReview.
+	AppCustomProductPageVersionStateWaitingForReview AppCustomProductPageVersionState = "WAITING_FOR_REVIEW"
+)
+
+// AppCustomProductPage defines model for AppCustomProductPage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpage
+type AppCustomProductPage struct {
+	Attributes    *AppCustomProductPageAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *AppCustomProductPageRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// AppCustomProductPageAttributes defines model for AppCustomProductPage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpage/attributes
+type AppCustomProductPageAttributes struct {
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
+}
+
+// AppCustomProductPageRelationships defines model for AppCustomProductPage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpage/relationships
+type AppCustomProductPageRelationships struct {
+	App                          *Relationship      `json:"app,omitempty"`
+	AppCustomProductPageVersions *PagedRelationship `json:"appCustomProductPageVersions,omitempty"`
+}
+
+// appCustomProductPageCreateRequest defines model for AppCustomProductPageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data
+type appCustomProductPageCreateRequest struct {
+	Attributes    appCustomProductPageCreateRequestAttributes    `json:"attributes"`
+	Relationships appCustomProductPageCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// appCustomProductPageCreateRequestAttributes are attributes for AppCustomProductPageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data/attributes
+type appCustomProductPageCreateRequestAttributes struct {
+	Name string `json:"name"`
+}
+
+// appCustomProductPageCreateRequestRelationships are relationships for AppCustomProductPageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data/relationships
+type appCustomProductPageCreateRequestRelationships struct {
+	App                       relationshipDeclaration  `json:"app"`
+	AppStoreVersionTemplate   *relationshipDeclaration `json:"appStoreVersionTemplate,omitempty"`
+	CustomProductPageTemplate *relationshipDeclaration `json:"customProductPageTemplate,omitempty"`
+}
+
+// appCustomProductPageUpdateRequest defines model for AppCustomProductPageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageupdaterequest/data
+type appCustomProductPageUpdateRequest struct {
+	Attributes *AppCustomProductPageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                       `json:"id"`
+	Type       string                                       `json:"type"`
+}
+
+// AppCustomProductPageUpdateRequestAttributes are attributes for AppCustomProductPageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageupdaterequest/data/attributes
+type AppCustomProductPageUpdateRequestAttributes struct {
+	Name    *string `json:"name,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
+}
+
+// AppCustomProductPageResponse defines model for AppCustomProductPageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageresponse
+type AppCustomProductPageResponse struct {
+	Data     AppCustomProductPage                   `json:"data"`
+	Included []AppCustomProductPageResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// AppCustomProductPagesResponse defines model for AppCustomProductPagesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagesresponse
+type AppCustomProductPagesResponse struct {
+	Data     []AppCustomProductPage                 `json:"data"`
+	Included []AppCustomProductPageResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                     `json:"links"`
+	Meta     *PagingInformation                     `json:"meta,omitempty"`
+}
+
+// AppCustomProductPageResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppCustomProductPageResponse or AppCustomProductPagesResponse.
+type AppCustomProductPageResponseIncluded included
+
+// AppCustomProductPageVersion defines model for AppCustomProductPageVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion
+type AppCustomProductPageVersion struct {
+	Attributes    *AppCustomProductPageVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                                    `json:"id"`
+	Links         ResourceLinks                             `json:"links"`
+	Relationships *AppCustomProductPageVersionRelationships `json:"relationships,omitempty"`
+	Type          string                                    `json:"type"`
+}
+
+// AppCustomProductPageVersionAttributes defines model for AppCustomProductPageVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion/attributes
+type AppCustomProductPageVersionAttributes struct {
+	DeepLink *string                           `json:"deepLink,omitempty"`
+	State    *AppCustomProductPageVersionState `json:"state,omitempty"`
+	Version  *string                           `json:"version,omitempty"`
+}
+
+// AppCustomProductPageVersionRelationships defines model for AppCustomProductPageVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion/relationships
+type AppCustomProductPageVersionRelationships struct {
+	AppCustomProductPage              *Relationship      `json:"appCustomProductPage,omitempty"`
+	AppCustomProductPageLocalizations *PagedRelationship `json:"appCustomProductPageLocalizations,omitempty"`
+}
+
+// appCustomProductPageVersionCreateRequest defines model for AppCustomProductPageVersionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversioncreaterequest/data
+type appCustomProductPageVersionCreateRequest struct {
+	Attributes    *appCustomProductPageVersionAttributes                `json:"attributes,omitempty"`
+	Relationships appCustomProductPageVersionCreateRequestRelationships `json:"relationships"`
+	Type          string                                                `json:"type"`
+}
+
+// appCustomProductPageVersionAttributes are attributes for AppCustomProductPageVersionCreateRequest and
+// AppCustomProductPageVersionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversioncreaterequest/data/attributes
+type appCustomProductPageVersionAttributes struct {
+	DeepLink *string `json:"deepLink,omitempty"`
+}
+
+// appCustomProductPageVersionCreateRequestRelationships are relationships for AppCustomProductPageVersionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversioncreaterequest/data/relationships
+type appCustomProductPageVersionCreateRequestRelationships struct {
+	AppCustomProductPage relationshipDeclaration `json:"appCustomProductPage"`
+}
+
+// appCustomProductPageVersionUpdateRequest defines model for AppCustomProductPageVersionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversionupdaterequest/data
+type appCustomProductPageVersionUpdateRequest struct {
+	Attributes *appCustomProductPageVersionAttributes `json:"attributes,omitempty"`
+	ID         string                                 `json:"id"`
+	Type       string                                 `json:"type"`
+}
+
+// AppCustomProductPageVersionResponse defines model for AppCustomProductPageVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversionresponse
+type AppCustomProductPageVersionResponse struct {
+	Data     AppCustomProductPageVersion                   `json:"data"`
+	Included []AppCustomProductPageVersionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                 `json:"links"`
+}
+
+// AppCustomProductPageVersionsResponse defines model for AppCustomProductPageVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversionsresponse
+type AppCustomProductPageVersionsResponse struct {
+	Data     []AppCustomProductPageVersion                 `json:"data"`
+	Included []AppCustomProductPageVersionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                            `json:"links"`
+	Meta     *PagingInformation                            `json:"meta,omitempty"`
+}
+
+// AppCustomProductPageVersionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppCustomProductPageVersionResponse or AppCustomProductPageVersionsResponse.
+type AppCustomProductPageVersionResponseIncluded included
+
+// ListAppCustomProductPagesForAppQuery are query options for ListAppCustomProductPagesForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_pages_for_an_app
+type ListAppCustomProductPagesForAppQuery struct {
+	FieldsAppCustomProductPages        []string `url:"fields[appCustomProductPages],omitempty"`
+	FieldsAppCustomProductPageVersions []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	FilterVisible                      []string `url:"filter[visible],omitempty"`
+	Include                            []string `url:"include,omitempty"`
+	Limit                              int      `url:"limit,omitempty"`
+	LimitAppCustomProductPageVersions  int      `url:"limit[appCustomProductPageVersions],omitempty"`
+	Cursor                             string   `url:"cursor,omitempty"`
+}
+
+// GetAppCustomProductPageQuery are query options for GetAppCustomProductPage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_information
+type GetAppCustomProductPageQuery struct {
+	FieldsAppCustomProductPages        []string `url:"fields[appCustomProductPages],omitempty"`
+	FieldsAppCustomProductPageVersions []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	Include                            []string `url:"include,omitempty"`
+	LimitAppCustomProductPageVersions  int      `url:"limit[appCustomProductPageVersions],omitempty"`
+}
+
+// ListAppCustomProductPageVersionsQuery are query options for ListVersionsForAppCustomProductPage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_versions_for_a_custom_product_page
+type ListAppCustomProductPageVersionsQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppCustomProductPageVersions      []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	FilterState                             []string `url:"filter[state],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	LimitAppCustomProductPageLocalizations  int      `url:"limit[appCustomProductPageLocalizations],omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// GetAppCustomProductPageVersionQuery are query options for GetAppCustomProductPageVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_version_information
+type GetAppCustomProductPageVersionQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppCustomProductPageVersions      []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	LimitAppCustomProductPageLocalizations  int      `url:"limit[appCustomProductPageLocalizations],omitempty"`
+}
+
+// ListAppCustomProductPagesForApp lists the custom product pages of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_pages_for_an_app
+func (s *AppsService) ListAppCustomProductPagesForApp(ctx context.Context, id string, params *ListAppCustomProductPagesForAppQuery) (*AppCustomProductPagesResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appCustomProductPages", id)
+	res := new(AppCustomProductPagesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppCustomProductPage gets information about a specific custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_information
+func (s *AppsService) GetAppCustomProductPage(ctx context.Context, id string, params *GetAppCustomProductPageQuery) (*AppCustomProductPageResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
+	res := new(AppCustomProductPageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppCustomProductPage creates a custom product page for an app. The page's first version can be
+// copied from an App Store version or from another custom product page by passing its ID as a template.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_product_page
+func (s *AppsService) CreateAppCustomProductPage(ctx context.Context, name string, appID string, appStoreVersionTemplateID *string, customProductPageTemplateID *string) (*AppCustomProductPageResponse, *Response, error) {
+	req := appCustomProductPageCreateRequest{
+		Attributes: appCustomProductPageCreateRequestAttributes{
+			Name: name,
+		},
+		Relationships: appCustomProductPageCreateRequestRelationships{
+			App:                       *newRelationshipDeclaration(&appID, "apps"),
+			AppStoreVersionTemplate:   newRelationshipDeclaration(appStoreVersionTemplateID, "appStoreVersions"),
+			CustomProductPageTemplate: newRelationshipDeclaration(customProductPageTemplateID, "appCustomProductPages"),
+		},
+		Type: "appCustomProductPages",
+	}
+	res := new(AppCustomProductPageResponse)
+	resp, err := s.client.post(ctx, "appCustomProductPages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppCustomProductPage renames a custom product page or changes whether it is visible on the App Store.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_product_page
+func (s *AppsService) UpdateAppCustomProductPage(ctx context.Context, id string, attributes *AppCustomProductPageUpdateRequestAttributes) (*AppCustomProductPageResponse, *Response, error) {
+	req := appCustomProductPageUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appCustomProductPages",
+	}
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
+	res := new(AppCustomProductPageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppCustomProductPage deletes a custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_custom_product_page
+func (s *AppsService) DeleteAppCustomProductPage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListVersionsForAppCustomProductPage lists the versions of a custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_versions_for_a_custom_product_page
+func (s *AppsService) ListVersionsForAppCustomProductPage(ctx context.Context, id string, params *ListAppCustomProductPageVersionsQuery) (*AppCustomProductPageVersionsResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPages/%s/appCustomProductPageVersions", id)
+	res := new(AppCustomProductPageVersionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppCustomProductPageVersion gets information about a specific custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_version_information
+func (s *AppsService) GetAppCustomProductPageVersion(ctx context.Context, id string, params *GetAppCustomProductPageVersionQuery) (*AppCustomProductPageVersionResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPageVersions/%s", id)
+	res := new(AppCustomProductPageVersionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppCustomProductPageVersion creates a new version of a custom product page, to be edited and
+// submitted for review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_product_page_version
+func (s *AppsService) CreateAppCustomProductPageVersion(ctx context.Context, appCustomProductPageID string, deepLink *string) (*AppCustomProductPageVersionResponse, *Response, error) {
+	req := appCustomProductPageVersionCreateRequest{
+		Relationships: appCustomProductPageVersionCreateRequestRelationships{
+			AppCustomProductPage: *newRelationshipDeclaration(&appCustomProductPageID, "appCustomProductPages"),
+		},
+		Type: "appCustomProductPageVersions",
+	}
+
+	if deepLink != nil {
+		req.Attributes = &appCustomProductPageVersionAttributes{
+			DeepLink: deepLink,
+		}
+	}
+
+	res := new(AppCustomProductPageVersionResponse)
+	resp, err := s.client.post(ctx, "appCustomProductPageVersions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppCustomProductPageVersion changes the deep link of a custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_product_page_version
+func (s *AppsService) UpdateAppCustomProductPageVersion(ctx context.Context, id string, deepLink *string) (*AppCustomProductPageVersionResponse, *Response, error) {
+	req := appCustomProductPageVersionUpdateRequest{
+		ID:   id,
+		Type: "appCustomProductPageVersions",
+	}
+
+	if deepLink != nil {
+		req.Attributes = &appCustomProductPageVersionAttributes{
+			DeepLink: deepLink,
+		}
+	}
+
+	url := fmt.Sprintf("appCustomProductPageVersions/%s", id)
+	res := new(AppCustomProductPageVersionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCustomProductPageResponseIncluded.
+func (i *AppCustomProductPageResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppCustomProductPageResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppCustomProductPageVersion returns the AppCustomProductPageVersion stored within, if one is present.
+func (i *AppCustomProductPageResponseIncluded) AppCustomProductPageVersion() *AppCustomProductPageVersion {
+	return extractIncludedAppCustomProductPageVersion(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCustomProductPageVersionResponseIncluded.
+func (i *AppCustomProductPageVersionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppCustomProductPage returns the AppCustomProductPage stored within, if one is present.
+func (i *AppCustomProductPageVersionResponseIncluded) AppCustomProductPage() *AppCustomProductPage {
+	return extractIncludedAppCustomProductPage(i.inner)
+}
+
+// AppCustomProductPageLocalization returns the AppCustomProductPageLocalization stored within, if one is present.
+func (i *AppCustomProductPageVersionResponseIncluded) AppCustomProductPageLocalization() *AppCustomProductPageLocalization {
+	return extractIncludedAppCustomProductPageLocalization(i.inner)
+}
@@ -0,0 +1,286 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppCustomProductPageLocalization defines model for AppCustomProductPageLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalization
+type AppCustomProductPageLocalization struct {
+	Attributes    *AppCustomProductPageLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                         `json:"id"`
+	Links         ResourceLinks                                  `json:"links"`
+	Relationships *AppCustomProductPageLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                         `json:"type"`
+}
+
+// AppCustomProductPageLocalizationAttributes defines model for AppCustomProductPageLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalization/attributes
+type AppCustomProductPageLocalizationAttributes struct {
+	Locale          *string `json:"locale,omitempty"`
+	PromotionalText *string `json:"promotionalText,omitempty"`
+}
+
+// AppCustomProductPageLocalizationRelationships defines model for AppCustomProductPageLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalization/relationships
+type AppCustomProductPageLocalizationRelationships struct {
+	AppCustomProductPageVersion *Relationship      `json:"appCustomProductPageVersion,omitempty"`
+	AppPreviewSets              *PagedRelationship `json:"appPreviewSets,omitempty"`
+	AppScreenshotSets           *PagedRelationship `json:"appScreenshotSets,omitempty"`
+}
+
+// appCustomProductPageLocalizationCreateRequest defines model for AppCustomProductPageLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationcreaterequest/data
+type appCustomProductPageLocalizationCreateRequest struct {
+	Attributes    AppCustomProductPageLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appCustomProductPageLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// AppCustomProductPageLocalizationCreateRequestAttributes are attributes for AppCustomProductPageLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationcreaterequest/data/attributes
+type AppCustomProductPageLocalizationCreateRequestAttributes struct {
+	Locale          string  `json:"locale"`
+	PromotionalText *string `json:"promotionalText,omitempty"`
+}
+
+// appCustomProductPageLocalizationCreateRequestRelationships are relationships for AppCustomProductPageLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationcreaterequest/data/relationships
+type appCustomProductPageLocalizationCreateRequestRelationships struct {
+	AppCustomProductPageVersion relationshipDeclaration `json:"appCustomProductPageVersion"`
+}
+
+// appCustomProductPageLocalizationUpdateRequest defines model for AppCustomProductPageLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationupdaterequest/data
+type appCustomProductPageLocalizationUpdateRequest struct {
+	Attributes *appCustomProductPageLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                   `json:"id"`
+	Type       string                                                   `json:"type"`
+}
+
+// appCustomProductPageLocalizationUpdateRequestAttributes are attributes for AppCustomProductPageLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationupdaterequest/data/attributes
+type appCustomProductPageLocalizationUpdateRequestAttributes struct {
+	PromotionalText *string `json:"promotionalText,omitempty"`
+}
+
+// AppCustomProductPageLocalizationResponse defines model for AppCustomProductPageLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationresponse
+type AppCustomProductPageLocalizationResponse struct {
+	Data     AppCustomProductPageLocalization                   `json:"data"`
+	Included []AppCustomProductPageLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                      `json:"links"`
+}
+
+// AppCustomProductPageLocalizationsResponse defines model for AppCustomProductPageLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationsresponse
+type AppCustomProductPageLocalizationsResponse struct {
+	Data     []AppCustomProductPageLocalization                 `json:"data"`
+	Included []AppCustomProductPageLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                 `json:"links"`
+	Meta     *PagingInformation                                 `json:"meta,omitempty"`
+}
+
+// AppCustomProductPageLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppCustomProductPageLocalizationResponse or AppCustomProductPageLocalizationsResponse.
+type AppCustomProductPageLocalizationResponseIncluded included
+
+// ListLocalizationsForAppCustomProductPageVersionQuery are query options for ListLocalizationsForAppCustomProductPageVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_localizations_for_a_custom_product_page_version
+type ListLocalizationsForAppCustomProductPageVersionQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppPreviewSets                    []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                 []string `url:"fields[appScreenshotSets],omitempty"`
+	FilterLocale                            []string `url:"filter[locale],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	LimitAppPreviewSets                     int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                  int      `url:"limit[appScreenshotSets],omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// GetAppCustomProductPageLocalizationQuery are query options for GetAppCustomProductPageLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_localization_information
+type GetAppCustomProductPageLocalizationQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppPreviewSets                    []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                 []string `url:"fields[appScreenshotSets],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	LimitAppPreviewSets                     int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                  int      `url:"limit[appScreenshotSets],omitempty"`
+}
+
+// ListAppScreenshotSetsForCustomProductPageLocalizationQuery are query options for ListAppScreenshotSetsForCustomProductPageLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_a_custom_product_page_localization
+type ListAppScreenshotSetsForCustomProductPageLocalizationQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppScreenshotSets                 []string `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppScreenshots                    []string `url:"fields[appScreenshots],omitempty"`
+	FilterScreenshotDisplayType             []string `url:"filter[screenshotDisplayType],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// ListAppPreviewSetsForCustomProductPageLocalizationQuery are query options for ListAppPreviewSetsForCustomProductPageLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_a_custom_product_page_localization
+type ListAppPreviewSetsForCustomProductPageLocalizationQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppPreviewSets                    []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppPreviews                       []string `url:"fields[appPreviews],omitempty"`
+	FilterPreviewType                       []string `url:"filter[previewType],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// ListLocalizationsForAppCustomProductPageVersion lists the localizations of a custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_localizations_for_a_custom_product_page_version
+func (s *AppsService) ListLocalizationsForAppCustomProductPageVersion(ctx context.Context, id string, params *ListLocalizationsForAppCustomProductPageVersionQuery) (*AppCustomProductPageLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPageVersions/%s/appCustomProductPageLocalizations", id)
+	res := new(AppCustomProductPageLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppCustomProductPageLocalization gets information about a specific custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_localization_information
+func (s *AppsService) GetAppCustomProductPageLocalization(ctx context.Context, id string, params *GetAppCustomProductPageLocalizationQuery) (*AppCustomProductPageLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s", id)
+	res := new(AppCustomProductPageLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppCustomProductPageLocalization adds a localization to a custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_product_page_localization
+func (s *AppsService) CreateAppCustomProductPageLocalization(ctx context.Context, attributes AppCustomProductPageLocalizationCreateRequestAttributes, appCustomProductPageVersionID string) (*AppCustomProductPageLocalizationResponse, *Response, error) {
+	req := appCustomProductPageLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: appCustomProductPageLocalizationCreateRequestRelationships{
+			AppCustomProductPageVersion: *newRelationshipDeclaration(&appCustomProductPageVersionID, "appCustomProductPageVersions"),
+		},
+		Type: "appCustomProductPageLocalizations",
+	}
+	res := new(AppCustomProductPageLocalizationResponse)
+	resp, err := s.client.post(ctx, "appCustomProductPageLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppCustomProductPageLocalization changes the promotional text of a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_product_page_localization
+func (s *AppsService) UpdateAppCustomProductPageLocalization(ctx context.Context, id string, promotionalText *string) (*AppCustomProductPageLocalizationResponse, *Response, error) {
+	req := appCustomProductPageLocalizationUpdateRequest{
+		ID:   id,
+		Type: "appCustomProductPageLocalizations",
+	}
+
+	if promotionalText != nil {
+		req.Attributes = &appCustomProductPageLocalizationUpdateRequestAttributes{
+			PromotionalText: promotionalText,
+		}
+	}
+
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s", id)
+	res := new(AppCustomProductPageLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppCustomProductPageLocalization deletes a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_custom_product_page_localization
+func (s *AppsService) DeleteAppCustomProductPageLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListAppScreenshotSetsForCustomProductPageLocalization lists the screenshot sets of a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_a_custom_product_page_localization
+func (s *AppsService) ListAppScreenshotSetsForCustomProductPageLocalization(ctx context.Context, id string, params *ListAppScreenshotSetsForCustomProductPageLocalizationQuery) (*AppScreenshotSetsResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s/appScreenshotSets", id)
+	res := new(AppScreenshotSetsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListAppPreviewSetsForCustomProductPageLocalization lists the preview sets of a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_a_custom_product_page_localization
+func (s *AppsService) ListAppPreviewSetsForCustomProductPageLocalization(ctx context.Context, id string, params *ListAppPreviewSetsForCustomProductPageLocalizationQuery) (*AppPreviewSetsResponse, *Response, error) {
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s/appPreviewSets", id)
+	res := new(AppPreviewSetsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCustomProductPageLocalizationResponseIncluded.
+func (i *AppCustomProductPageLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppCustomProductPageVersion returns the AppCustomProductPageVersion stored within, if one is present.
+func (i *AppCustomProductPageLocalizationResponseIncluded) AppCustomProductPageVersion() *AppCustomProductPageVersion {
+	return extractIncludedAppCustomProductPageVersion(i.inner)
+}
+
+// AppPreviewSet returns the AppPreviewSet stored within, if one is present.
+func (i *AppCustomProductPageLocalizationResponseIncluded) AppPreviewSet() *AppPreviewSet {
+	return extractIncludedAppPreviewSet(i.inner)
+}
+
+// AppScreenshotSet returns the AppScreenshotSet stored within, if one is present.
+func (i *AppCustomProductPageLocalizationResponseIncluded) AppScreenshotSet() *AppScreenshotSet {
+	return extractIncludedAppScreenshotSet(i.inner)
+}
@@ -0,0 +1,102 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForAppCustomProductPageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListLocalizationsForAppCustomProductPageVersion(ctx, "10", &ListLocalizationsForAppCustomProductPageVersionQuery{})
+	})
+}
+
+func TestGetAppCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppCustomProductPageLocalization(ctx, "10", &GetAppCustomProductPageLocalizationQuery{})
+	})
+}
+
+func TestCreateAppCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppCustomProductPageLocalization(ctx, AppCustomProductPageLocalizationCreateRequestAttributes{Locale: "en-US"}, "10")
+	})
+}
+
+func TestUpdateAppCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppCustomProductPageLocalization(ctx, "10", String("Summer sale"))
+	})
+}
+
+func TestDeleteAppCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppCustomProductPageLocalization(ctx, "10")
+	})
+}
+
+func TestListAppScreenshotSetsForCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppScreenshotSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppScreenshotSetsForCustomProductPageLocalization(ctx, "10", &ListAppScreenshotSetsForCustomProductPageLocalizationQuery{})
+	})
+}
+
+func TestListAppPreviewSetsForCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPreviewSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppPreviewSetsForCustomProductPageLocalization(ctx, "10", &ListAppPreviewSetsForCustomProductPageLocalizationQuery{})
+	})
+}
+
+func TestGetAppCustomProductPageLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appCustomProductPageVersions"},{"type":"appPreviewSets"},{"type":"appScreenshotSets"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.Apps.GetAppCustomProductPageLocalization(ctx, "10", &GetAppCustomProductPageLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].AppCustomProductPageVersion())
+		assert.NotNil(t, localization.Included[1].AppPreviewSet())
+		assert.NotNil(t, localization.Included[2].AppScreenshotSet())
+
+		assert.Nil(t, localization.Included[0].AppPreviewSet())
+		assert.Nil(t, localization.Included[1].AppScreenshotSet())
+		assert.Nil(t, localization.Included[2].AppCustomProductPageVersion())
+	})
+}
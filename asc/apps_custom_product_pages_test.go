@@ -0,0 +1,132 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppCustomProductPagesForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppCustomProductPagesForApp(ctx, "10", &ListAppCustomProductPagesForAppQuery{})
+	})
+}
+
+func TestGetAppCustomProductPage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppCustomProductPage(ctx, "10", &GetAppCustomProductPageQuery{})
+	})
+}
+
+func TestCreateAppCustomProductPage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppCustomProductPage(ctx, "Summer", "10", String("11"), nil)
+	})
+}
+
+func TestUpdateAppCustomProductPage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppCustomProductPage(ctx, "10", &AppCustomProductPageUpdateRequestAttributes{Visible: Bool(true)})
+	})
+}
+
+func TestDeleteAppCustomProductPage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppCustomProductPage(ctx, "10")
+	})
+}
+
+func TestListVersionsForAppCustomProductPage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListVersionsForAppCustomProductPage(ctx, "10", &ListAppCustomProductPageVersionsQuery{})
+	})
+}
+
+func TestGetAppCustomProductPageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppCustomProductPageVersion(ctx, "10", &GetAppCustomProductPageVersionQuery{})
+	})
+}
+
+func TestCreateAppCustomProductPageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppCustomProductPageVersion(ctx, "10", String("myapp://summer"))
+	})
+}
+
+func TestUpdateAppCustomProductPageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppCustomProductPageVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppCustomProductPageVersion(ctx, "10", String("myapp://summer"))
+	})
+}
+
+func TestGetAppCustomProductPageIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appCustomProductPageVersions"}]}`, func(ctx context.Context, client *Client) {
+		page, _, err := client.Apps.GetAppCustomProductPage(ctx, "10", &GetAppCustomProductPageQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, page.Included)
+
+		assert.NotNil(t, page.Included[0].App())
+		assert.NotNil(t, page.Included[1].AppCustomProductPageVersion())
+
+		assert.Nil(t, page.Included[0].AppCustomProductPageVersion())
+		assert.Nil(t, page.Included[1].App())
+	})
+}
+
+func TestGetAppCustomProductPageVersionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appCustomProductPages"},{"type":"appCustomProductPageLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		version, _, err := client.Apps.GetAppCustomProductPageVersion(ctx, "10", &GetAppCustomProductPageVersionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, version.Included)
+
+		assert.NotNil(t, version.Included[0].AppCustomProductPage())
+		assert.NotNil(t, version.Included[1].AppCustomProductPageLocalization())
+
+		assert.Nil(t, version.Included[0].AppCustomProductPageLocalization())
+		assert.Nil(t, version.Included[1].AppCustomProductPage())
+	})
+}
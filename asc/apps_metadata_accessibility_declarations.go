@@ -0,0 +1,226 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AccessibilityDeclarationState defines model for AccessibilityDeclaration.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclaration/attributes
+type AccessibilityDeclarationState string
+
+const (
+	// AccessibilityDeclarationStateDraft is an accessibility declaration state for Draft.
+	AccessibilityDeclarationStateDraft AccessibilityDeclarationState = "DRAFT"
+	// AccessibilityDeclarationStatePublished is an accessibility declaration state for Published.
+	AccessibilityDeclarationStatePublished AccessibilityDeclarationState = "PUBLISHED"
+	// AccessibilityDeclarationStateReplaced is an accessibility declaration state for Replaced.
+	AccessibilityDeclarationStateReplaced AccessibilityDeclarationState = "REPLACED"
+)
+
+// AccessibilityDeclaration defines model for AccessibilityDeclaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclaration
+type AccessibilityDeclaration struct {
+	Attributes *AccessibilityDeclarationAttributes `json:"attributes,omitempty"`
+	ID         string                              `json:"id"`
+	Links      ResourceLinks                       `json:"links"`
+	Type       string                              `json:"type"`
+}
+
+// AccessibilityFeatures are the accessibility features an app can declare support for on a device family.
+// They are shared by AccessibilityDeclarationAttributes and the create and update request attributes so
+// the same set of features can be applied to many declarations.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclaration/attributes
+type AccessibilityFeatures struct {
+	SupportsAudioDescriptions              *bool `json:"supportsAudioDescriptions,omitempty"`
+	SupportsCaptions                       *bool `json:"supportsCaptions,omitempty"`
+	SupportsDarkInterface                  *bool `json:"supportsDarkInterface,omitempty"`
+	SupportsDifferentiateWithoutColorAlone *bool `json:"supportsDifferentiateWithoutColorAlone,omitempty"`
+	SupportsLargerText                     *bool `json:"supportsLargerText,omitempty"`
+	SupportsReducedMotion                  *bool `json:"supportsReducedMotion,omitempty"`
+	SupportsSufficientContrast             *bool `json:"supportsSufficientContrast,omitempty"`
+	SupportsVoiceControl                   *bool `json:"supportsVoiceControl,omitempty"`
+	SupportsVoiceover                      *bool `json:"supportsVoiceover,omitempty"`
+}
+
+// AccessibilityDeclarationAttributes defines model for AccessibilityDeclaration.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclaration/attributes
+type AccessibilityDeclarationAttributes struct {
+	AccessibilityFeatures
+	DeviceFamily *DeviceFamily                  `json:"deviceFamily,omitempty"`
+	State        *AccessibilityDeclarationState `json:"state,omitempty"`
+}
+
+// accessibilityDeclarationCreateRequest defines model for AccessibilityDeclarationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationcreaterequest/data
+type accessibilityDeclarationCreateRequest struct {
+	Attributes    AccessibilityDeclarationCreateRequestAttributes    `json:"attributes"`
+	Relationships accessibilityDeclarationCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// AccessibilityDeclarationCreateRequestAttributes are attributes for AccessibilityDeclarationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationcreaterequest/data/attributes
+type AccessibilityDeclarationCreateRequestAttributes struct {
+	AccessibilityFeatures
+	DeviceFamily DeviceFamily `json:"deviceFamily"`
+}
+
+// accessibilityDeclarationCreateRequestRelationships are relationships for AccessibilityDeclarationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationcreaterequest/data/relationships
+type accessibilityDeclarationCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// accessibilityDeclarationUpdateRequest defines model for AccessibilityDeclarationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationupdaterequest/data
+type accessibilityDeclarationUpdateRequest struct {
+	Attributes *AccessibilityDeclarationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Type       string                                           `json:"type"`
+}
+
+// AccessibilityDeclarationUpdateRequestAttributes are attributes for AccessibilityDeclarationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationupdaterequest/data/attributes
+type AccessibilityDeclarationUpdateRequestAttributes struct {
+	AccessibilityFeatures
+	Publish *bool `json:"publish,omitempty"`
+}
+
+// AccessibilityDeclarationResponse defines model for AccessibilityDeclarationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationresponse
+type AccessibilityDeclarationResponse struct {
+	Data  AccessibilityDeclaration `json:"data"`
+	Links DocumentLinks            `json:"links"`
+}
+
+// AccessibilityDeclarationsResponse defines model for AccessibilityDeclarationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/accessibilitydeclarationsresponse
+type AccessibilityDeclarationsResponse struct {
+	Data  []AccessibilityDeclaration `json:"data"`
+	Links PagedDocumentLinks         `json:"links"`
+	Meta  *PagingInformation         `json:"meta,omitempty"`
+}
+
+// ListAccessibilityDeclarationsForAppQuery are query options for ListAccessibilityDeclarationsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_accessibility_declarations_for_an_app
+type ListAccessibilityDeclarationsForAppQuery struct {
+	FieldsAccessibilityDeclarations []string `url:"fields[accessibilityDeclarations],omitempty"`
+	FilterDeviceFamily              []string `url:"filter[deviceFamily],omitempty"`
+	FilterState                     []string `url:"filter[state],omitempty"`
+	Limit                           int      `url:"limit,omitempty"`
+	Cursor                          string   `url:"cursor,omitempty"`
+}
+
+// GetAccessibilityDeclarationQuery are query options for GetAccessibilityDeclaration
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_accessibility_declaration_information
+type GetAccessibilityDeclarationQuery struct {
+	FieldsAccessibilityDeclarations []string `url:"fields[accessibilityDeclarations],omitempty"`
+}
+
+// ListAccessibilityDeclarationsForApp lists the accessibility declarations of an app across its device families.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_accessibility_declarations_for_an_app
+func (s *AppsService) ListAccessibilityDeclarationsForApp(ctx context.Context, id string, params *ListAccessibilityDeclarationsForAppQuery) (*AccessibilityDeclarationsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/accessibilityDeclarations", id)
+	res := new(AccessibilityDeclarationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAccessibilityDeclaration gets a specific accessibility declaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_accessibility_declaration_information
+func (s *AppsService) GetAccessibilityDeclaration(ctx context.Context, id string, params *GetAccessibilityDeclarationQuery) (*AccessibilityDeclarationResponse, *Response, error) {
+	url := fmt.Sprintf("accessibilityDeclarations/%s", id)
+	res := new(AccessibilityDeclarationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAccessibilityDeclaration creates a draft accessibility declaration for one device family of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_accessibility_declaration
+func (s *AppsService) CreateAccessibilityDeclaration(ctx context.Context, attributes AccessibilityDeclarationCreateRequestAttributes, appID string) (*AccessibilityDeclarationResponse, *Response, error) {
+	req := accessibilityDeclarationCreateRequest{
+		Attributes: attributes,
+		Relationships: accessibilityDeclarationCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "accessibilityDeclarations",
+	}
+	res := new(AccessibilityDeclarationResponse)
+	resp, err := s.client.post(ctx, "accessibilityDeclarations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAccessibilityDeclaration changes the supported features of a draft accessibility declaration, or publishes it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_accessibility_declaration
+func (s *AppsService) UpdateAccessibilityDeclaration(ctx context.Context, id string, attributes *AccessibilityDeclarationUpdateRequestAttributes) (*AccessibilityDeclarationResponse, *Response, error) {
+	req := accessibilityDeclarationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "accessibilityDeclarations",
+	}
+	url := fmt.Sprintf("accessibilityDeclarations/%s", id)
+	res := new(AccessibilityDeclarationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// PublishAccessibilityDeclaration publishes a draft accessibility declaration to the App Store, replacing
+// the previously published declaration for the same device family.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_accessibility_declaration
+func (s *AppsService) PublishAccessibilityDeclaration(ctx context.Context, id string) (*AccessibilityDeclarationResponse, *Response, error) {
+	return s.UpdateAccessibilityDeclaration(ctx, id, &AccessibilityDeclarationUpdateRequestAttributes{
+		Publish: Bool(true),
+	})
+}
+
+// DeleteAccessibilityDeclaration deletes an accessibility declaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_accessibility_declaration
+func (s *AppsService) DeleteAccessibilityDeclaration(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("accessibilityDeclarations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
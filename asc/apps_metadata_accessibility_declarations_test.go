@@ -0,0 +1,114 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAccessibilityDeclarationsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AccessibilityDeclarationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAccessibilityDeclarationsForApp(ctx, "10", &ListAccessibilityDeclarationsForAppQuery{})
+	})
+}
+
+func TestGetAccessibilityDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AccessibilityDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAccessibilityDeclaration(ctx, "10", &GetAccessibilityDeclarationQuery{})
+	})
+}
+
+func TestCreateAccessibilityDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AccessibilityDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAccessibilityDeclaration(ctx, AccessibilityDeclarationCreateRequestAttributes{DeviceFamily: DeviceFamilyIPhone}, "10")
+	})
+}
+
+func TestUpdateAccessibilityDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AccessibilityDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAccessibilityDeclaration(ctx, "10", &AccessibilityDeclarationUpdateRequestAttributes{AccessibilityFeatures: AccessibilityFeatures{SupportsLargerText: Bool(true)}})
+	})
+}
+
+func TestPublishAccessibilityDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AccessibilityDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.PublishAccessibilityDeclaration(ctx, "10")
+	})
+}
+
+func TestDeleteAccessibilityDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAccessibilityDeclaration(ctx, "10")
+	})
+}
+
+func TestCreateAccessibilityDeclarationFlattensFeatures(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"POST /v1/accessibilityDeclarations": respondWith(http.StatusOK, `{"data":{"id":"10","type":"accessibilityDeclarations","attributes":{"deviceFamily":"IPAD","state":"DRAFT","supportsVoiceover":true}}}`),
+	})
+	defer server.Close()
+
+	declaration, _, err := client.Apps.CreateAccessibilityDeclaration(context.Background(), AccessibilityDeclarationCreateRequestAttributes{
+		AccessibilityFeatures: AccessibilityFeatures{
+			SupportsCaptions:  Bool(false),
+			SupportsVoiceover: Bool(true),
+		},
+		DeviceFamily: DeviceFamilyIPad,
+	}, "20")
+
+	assert.NoError(t, err)
+
+	var body struct {
+		Data struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+
+	requests := server.requests("POST /v1/accessibilityDeclarations")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.Equal(t, map[string]interface{}{
+		"deviceFamily":      "IPAD",
+		"supportsCaptions":  false,
+		"supportsVoiceover": true,
+	}, body.Data.Attributes)
+	assert.Equal(t, DeviceFamilyIPad, *declaration.Data.Attributes.DeviceFamily)
+	assert.Equal(t, AccessibilityDeclarationStateDraft, *declaration.Data.Attributes.State)
+	assert.True(t, *declaration.Data.Attributes.SupportsVoiceover)
+}
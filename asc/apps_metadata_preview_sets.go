@@ -47,8 +47,10 @@ type AppPreviewSetAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreviewset/relationships
 type AppPreviewSetRelationships struct {
-	AppPreviews                 *PagedRelationship `json:"appPreviews,omitempty"`
-	AppStoreVersionLocalization *Relationship      `json:"appStoreVersionLocalization,omitempty"`
+	AppCustomProductPageLocalization               *Relationship      `json:"appCustomProductPageLocalization,omitempty"`
+	AppPreviews                                    *PagedRelationship `json:"appPreviews,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *Relationship      `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *Relationship      `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // appPreviewSetCreateRequest defines model for AppPreviewSetCreateRequest.
@@ -71,7 +73,9 @@ type appPreviewSetCreateRequestAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreviewsetcreaterequest/data/relationships
 type appPreviewSetCreateRequestRelationships struct {
-	AppStoreVersionLocalization relationshipDeclaration `json:"appStoreVersionLocalization"`
+	AppCustomProductPageLocalization               *relationshipDeclaration `json:"appCustomProductPageLocalization,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *relationshipDeclaration `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *relationshipDeclaration `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // AppPreviewSetResponse defines model for AppPreviewSetResponse.
@@ -151,12 +155,45 @@ func (s *AppsService) CreateAppPreviewSet(ctx context.Context, previewType Previ
 			PreviewType: previewType,
 		},
 		Relationships: appPreviewSetCreateRequestRelationships{
-			AppStoreVersionLocalization: relationshipDeclaration{
-				Data: RelationshipData{
-					ID:   appStoreVersionLocalizationID,
-					Type: "appStoreVersionLocalizations",
-				},
-			},
+			AppStoreVersionLocalization: newRelationshipDeclaration(&appStoreVersionLocalizationID, "appStoreVersionLocalizations"),
+		},
+		Type: "appPreviewSets",
+	}
+	res := new(AppPreviewSetResponse)
+	resp, err := s.client.post(ctx, "appPreviewSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateAppPreviewSetForCustomProductPageLocalization adds a new preview set to a custom product page localization for a specific preview type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_preview_set
+func (s *AppsService) CreateAppPreviewSetForCustomProductPageLocalization(ctx context.Context, previewType PreviewType, appCustomProductPageLocalizationID string) (*AppPreviewSetResponse, *Response, error) {
+	req := appPreviewSetCreateRequest{
+		Attributes: appPreviewSetCreateRequestAttributes{
+			PreviewType: previewType,
+		},
+		Relationships: appPreviewSetCreateRequestRelationships{
+			AppCustomProductPageLocalization: newRelationshipDeclaration(&appCustomProductPageLocalizationID, "appCustomProductPageLocalizations"),
+		},
+		Type: "appPreviewSets",
+	}
+	res := new(AppPreviewSetResponse)
+	resp, err := s.client.post(ctx, "appPreviewSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateAppPreviewSetForTreatmentLocalization adds a new preview set to an experiment treatment localization for a specific preview type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_preview_set
+func (s *AppsService) CreateAppPreviewSetForTreatmentLocalization(ctx context.Context, previewType PreviewType, appStoreVersionExperimentTreatmentLocalizationID string) (*AppPreviewSetResponse, *Response, error) {
+	req := appPreviewSetCreateRequest{
+		Attributes: appPreviewSetCreateRequestAttributes{
+			PreviewType: previewType,
+		},
+		Relationships: appPreviewSetCreateRequestRelationships{
+			AppStoreVersionExperimentTreatmentLocalization: newRelationshipDeclaration(&appStoreVersionExperimentTreatmentLocalizationID, "appStoreVersionExperimentTreatmentLocalizations"),
 		},
 		Type: "appPreviewSets",
 	}
@@ -41,6 +41,22 @@ func TestCreateAppPreviewSet(t *testing.T) {
 	})
 }
 
+func TestCreateAppPreviewSetForCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPreviewSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppPreviewSetForCustomProductPageLocalization(ctx, PreviewTypeiPadPro129, "10")
+	})
+}
+
+func TestCreateAppPreviewSetForTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPreviewSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppPreviewSetForTreatmentLocalization(ctx, PreviewTypeiPadPro129, "10")
+	})
+}
+
 func TestDeleteAppPreviewSet(t *testing.T) {
 	t.Parallel()
 
@@ -105,8 +105,10 @@ type AppScreenshotSetAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshotset/relationships
 type AppScreenshotSetRelationships struct {
-	AppScreenshots              *PagedRelationship `json:"appScreenshots,omitempty"`
-	AppStoreVersionLocalization *Relationship      `json:"appStoreVersionLocalization,omitempty"`
+	AppCustomProductPageLocalization               *Relationship      `json:"appCustomProductPageLocalization,omitempty"`
+	AppScreenshots                                 *PagedRelationship `json:"appScreenshots,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *Relationship      `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *Relationship      `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // appScreenshotSetCreateRequest defines model for AppScreenshotSetCreateRequest.
@@ -129,7 +131,9 @@ type appScreenshotSetCreateRequestAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshotsetcreaterequest/data/relationships
 type appScreenshotSetCreateRequestRelationships struct {
-	AppStoreVersionLocalization relationshipDeclaration `json:"appStoreVersionLocalization"`
+	AppCustomProductPageLocalization               *relationshipDeclaration `json:"appCustomProductPageLocalization,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *relationshipDeclaration `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *relationshipDeclaration `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // AppScreenshotSetResponse defines model for AppScreenshotSetResponse.
@@ -208,12 +212,45 @@ func (s *AppsService) CreateAppScreenshotSet(ctx context.Context, screenshotDisp
 			ScreenshotDisplayType: screenshotDisplayType,
 		},
 		Relationships: appScreenshotSetCreateRequestRelationships{
-			AppStoreVersionLocalization: relationshipDeclaration{
-				Data: RelationshipData{
-					ID:   appStoreVersionLocalizationID,
-					Type: "appStoreVersionLocalizations",
-				},
-			},
+			AppStoreVersionLocalization: newRelationshipDeclaration(&appStoreVersionLocalizationID, "appStoreVersionLocalizations"),
+		},
+		Type: "appScreenshotSets",
+	}
+	res := new(AppScreenshotSetResponse)
+	resp, err := s.client.post(ctx, "appScreenshotSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateAppScreenshotSetForCustomProductPageLocalization adds a new screenshot set to a custom product page localization for a specific screenshot type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_screenshot_set
+func (s *AppsService) CreateAppScreenshotSetForCustomProductPageLocalization(ctx context.Context, screenshotDisplayType ScreenshotDisplayType, appCustomProductPageLocalizationID string) (*AppScreenshotSetResponse, *Response, error) {
+	req := appScreenshotSetCreateRequest{
+		Attributes: appScreenshotSetCreateRequestAttributes{
+			ScreenshotDisplayType: screenshotDisplayType,
+		},
+		Relationships: appScreenshotSetCreateRequestRelationships{
+			AppCustomProductPageLocalization: newRelationshipDeclaration(&appCustomProductPageLocalizationID, "appCustomProductPageLocalizations"),
+		},
+		Type: "appScreenshotSets",
+	}
+	res := new(AppScreenshotSetResponse)
+	resp, err := s.client.post(ctx, "appScreenshotSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateAppScreenshotSetForTreatmentLocalization adds a new screenshot set to an experiment treatment localization for a specific screenshot type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_screenshot_set
+func (s *AppsService) CreateAppScreenshotSetForTreatmentLocalization(ctx context.Context, screenshotDisplayType ScreenshotDisplayType, appStoreVersionExperimentTreatmentLocalizationID string) (*AppScreenshotSetResponse, *Response, error) {
+	req := appScreenshotSetCreateRequest{
+		Attributes: appScreenshotSetCreateRequestAttributes{
+			ScreenshotDisplayType: screenshotDisplayType,
+		},
+		Relationships: appScreenshotSetCreateRequestRelationships{
+			AppStoreVersionExperimentTreatmentLocalization: newRelationshipDeclaration(&appStoreVersionExperimentTreatmentLocalizationID, "appStoreVersionExperimentTreatmentLocalizations"),
 		},
 		Type: "appScreenshotSets",
 	}
@@ -41,6 +41,22 @@ func TestCreateAppScreenshotSet(t *testing.T) {
 	})
 }
 
+func TestCreateAppScreenshotSetForCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppScreenshotSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppScreenshotSetForCustomProductPageLocalization(ctx, ScreenshotDisplayTypeAppiPadPro129, "10")
+	})
+}
+
+func TestCreateAppScreenshotSetForTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppScreenshotSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppScreenshotSetForTreatmentLocalization(ctx, ScreenshotDisplayTypeAppiPadPro129, "10")
+	})
+}
+
 func TestDeleteAppScreenshotSet(t *testing.T) {
 	t.Parallel()
 
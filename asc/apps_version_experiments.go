@@ -0,0 +1,496 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppStoreVersionExperimentState defines model for AppStoreVersionExperimentState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2/attributes
+type AppStoreVersionExperimentState string
+
+const (
+	// AppStoreVersionExperimentStateAccepted is an experiment state for Accepted.
+	AppStoreVersionExperimentStateAccepted AppStoreVersionExperimentState = "ACCEPTED"
+	// AppStoreVersionExperimentStateApproved is an experiment state for Approved.
+	AppStoreVersionExperimentStateApproved AppStoreVersionExperimentState = "APPROVED"
+	// AppStoreVersionExperimentStateCompleted is an experiment state for Completed.
+	AppStoreVersionExperimentStateCompleted AppStoreVersionExperimentState = "COMPLETED"
+	// AppStoreVersionExperimentStateInReview is an experiment state for InReview.
+	AppStoreVersionExperimentStateInReview AppStoreVersionExperimentState = "IN_REVIEW"
+	// AppStoreVersionExperimentStatePrepareForSubmission is an experiment state for PrepareForSubmission.
+	AppStoreVersionExperimentStatePrepareForSubmission AppStoreVersionExperimentState = "PREPARE_FOR_SUBMISSION"
+	// AppStoreVersionExperimentStateReadyForReview is an experiment state for ReadyForReview.
+	AppStoreVersionExperimentStateReadyForReview AppStoreVersionExperimentState = "READY_FOR_REVIEW"
+	// AppStoreVersionExperimentStateRejected is an experiment state for Rejected.
+	AppStoreVersionExperimentStateRejected AppStoreVersionExperimentState = "REJECTED"
+	// AppStoreVersionExperimentStateStopped is an experiment state for Stopped.
+	AppStoreVersionExperimentStateStopped AppStoreVersionExperimentState = "STOPPED"
+	// AppStoreVersionExperimentStateWaitingForReview is an experiment state for WaitingForReview.
+	AppStoreVersionExperimentStateWaitingForReview AppStoreVersionExperimentState = "WAITING_FOR_REVIEW"
+)
+
+// IsFinished reports whether the experiment has stopped collecting results, either because it ran to
+// completion or because it was stopped early.
+func (s AppStoreVersionExperimentState) IsFinished() bool {
+	return s == AppStoreVersionExperimentStateCompleted || s == AppStoreVersionExperimentStateStopped
+}
+
+// AppStoreVersionExperiment defines model for AppStoreVersionExperiment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2
+type AppStoreVersionExperiment struct {
+	Attributes    *AppStoreVersionExperimentAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *AppStoreVersionExperimentRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// AppStoreVersionExperimentAttributes defines model for AppStoreVersionExperiment.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2/attributes
+type AppStoreVersionExperimentAttributes struct {
+	EndDate           *DateTime                       `json:"endDate,omitempty"`
+	Name              *string                         `json:"name,omitempty"`
+	Platform          *Platform                       `json:"platform,omitempty"`
+	ReviewRequired    *bool                           `json:"reviewRequired,omitempty"`
+	StartDate         *DateTime                       `json:"startDate,omitempty"`
+	State             *AppStoreVersionExperimentState `json:"state,omitempty"`
+	TrafficProportion *int                            `json:"trafficProportion,omitempty"`
+}
+
+// AppStoreVersionExperimentRelationships defines model for AppStoreVersionExperiment.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2/relationships
+type AppStoreVersionExperimentRelationships struct {
+	App                                 *Relationship      `json:"app,omitempty"`
+	AppStoreVersionExperimentTreatments *PagedRelationship `json:"appStoreVersionExperimentTreatments,omitempty"`
+	ControlVersions                     *PagedRelationship `json:"controlVersions,omitempty"`
+	LatestControlVersion                *Relationship      `json:"latestControlVersion,omitempty"`
+}
+
+// appStoreVersionExperimentCreateRequest defines model for AppStoreVersionExperimentV2CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2createrequest/data
+type appStoreVersionExperimentCreateRequest struct {
+	Attributes    appStoreVersionExperimentCreateRequestAttributes    `json:"attributes"`
+	Relationships appStoreVersionExperimentCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// appStoreVersionExperimentCreateRequestAttributes are attributes for AppStoreVersionExperimentV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2createrequest/data/attributes
+type appStoreVersionExperimentCreateRequestAttributes struct {
+	Name              string   `json:"name"`
+	Platform          Platform `json:"platform"`
+	TrafficProportion int      `json:"trafficProportion"`
+}
+
+// appStoreVersionExperimentCreateRequestRelationships are relationships for AppStoreVersionExperimentV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2createrequest/data/relationships
+type appStoreVersionExperimentCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// appStoreVersionExperimentUpdateRequest defines model for AppStoreVersionExperimentV2UpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2updaterequest/data
+type appStoreVersionExperimentUpdateRequest struct {
+	Attributes *AppStoreVersionExperimentUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                            `json:"id"`
+	Type       string                                            `json:"type"`
+}
+
+// AppStoreVersionExperimentUpdateRequestAttributes are attributes for AppStoreVersionExperimentV2UpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2updaterequest/data/attributes
+type AppStoreVersionExperimentUpdateRequestAttributes struct {
+	Name              *string `json:"name,omitempty"`
+	Started           *bool   `json:"started,omitempty"`
+	TrafficProportion *int    `json:"trafficProportion,omitempty"`
+}
+
+// AppStoreVersionExperimentResponse defines model for AppStoreVersionExperimentV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2response
+type AppStoreVersionExperimentResponse struct {
+	Data     AppStoreVersionExperiment                   `json:"data"`
+	Included []AppStoreVersionExperimentResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                               `json:"links"`
+}
+
+// AppStoreVersionExperimentsResponse defines model for AppStoreVersionExperimentsV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentsv2response
+type AppStoreVersionExperimentsResponse struct {
+	Data     []AppStoreVersionExperiment                 `json:"data"`
+	Included []AppStoreVersionExperimentResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                          `json:"links"`
+	Meta     *PagingInformation                          `json:"meta,omitempty"`
+}
+
+// AppStoreVersionExperimentResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppStoreVersionExperimentResponse or AppStoreVersionExperimentsResponse.
+type AppStoreVersionExperimentResponseIncluded included
+
+// AppStoreVersionExperimentTreatment defines model for AppStoreVersionExperimentTreatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatment
+type AppStoreVersionExperimentTreatment struct {
+	Attributes    *AppStoreVersionExperimentTreatmentAttributes    `json:"attributes,omitempty"`
+	ID            string                                           `json:"id"`
+	Links         ResourceLinks                                    `json:"links"`
+	Relationships *AppStoreVersionExperimentTreatmentRelationships `json:"relationships,omitempty"`
+	Type          string                                           `json:"type"`
+}
+
+// AppStoreVersionExperimentTreatmentAttributes defines model for AppStoreVersionExperimentTreatment.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatment/attributes
+type AppStoreVersionExperimentTreatmentAttributes struct {
+	AppIcon      *ImageAsset `json:"appIcon,omitempty"`
+	AppIconName  *string     `json:"appIconName,omitempty"`
+	Name         *string     `json:"name,omitempty"`
+	PromotedDate *DateTime   `json:"promotedDate,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentRelationships defines model for AppStoreVersionExperimentTreatment.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatment/relationships
+type AppStoreVersionExperimentTreatmentRelationships struct {
+	AppStoreVersionExperimentTreatmentLocalizations *PagedRelationship `json:"appStoreVersionExperimentTreatmentLocalizations,omitempty"`
+	AppStoreVersionExperimentV2                     *Relationship      `json:"appStoreVersionExperimentV2,omitempty"`
+}
+
+// appStoreVersionExperimentTreatmentCreateRequest defines model for AppStoreVersionExperimentTreatmentCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentcreaterequest/data
+type appStoreVersionExperimentTreatmentCreateRequest struct {
+	Attributes    appStoreVersionExperimentTreatmentCreateRequestAttributes    `json:"attributes"`
+	Relationships appStoreVersionExperimentTreatmentCreateRequestRelationships `json:"relationships"`
+	Type          string                                                       `json:"type"`
+}
+
+// appStoreVersionExperimentTreatmentCreateRequestAttributes are attributes for AppStoreVersionExperimentTreatmentCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentcreaterequest/data/attributes
+type appStoreVersionExperimentTreatmentCreateRequestAttributes struct {
+	AppIconName *string `json:"appIconName,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// appStoreVersionExperimentTreatmentCreateRequestRelationships are relationships for AppStoreVersionExperimentTreatmentCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentcreaterequest/data/relationships
+type appStoreVersionExperimentTreatmentCreateRequestRelationships struct {
+	AppStoreVersionExperimentV2 relationshipDeclaration `json:"appStoreVersionExperimentV2"`
+}
+
+// appStoreVersionExperimentTreatmentUpdateRequest defines model for AppStoreVersionExperimentTreatmentUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentupdaterequest/data
+type appStoreVersionExperimentTreatmentUpdateRequest struct {
+	Attributes *AppStoreVersionExperimentTreatmentUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                     `json:"id"`
+	Type       string                                                     `json:"type"`
+}
+
+// AppStoreVersionExperimentTreatmentUpdateRequestAttributes are attributes for AppStoreVersionExperimentTreatmentUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentupdaterequest/data/attributes
+type AppStoreVersionExperimentTreatmentUpdateRequestAttributes struct {
+	AppIconName *string `json:"appIconName,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentResponse defines model for AppStoreVersionExperimentTreatmentResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentresponse
+type AppStoreVersionExperimentTreatmentResponse struct {
+	Data     AppStoreVersionExperimentTreatment                   `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                        `json:"links"`
+}
+
+// AppStoreVersionExperimentTreatmentsResponse defines model for AppStoreVersionExperimentTreatmentsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentsresponse
+type AppStoreVersionExperimentTreatmentsResponse struct {
+	Data     []AppStoreVersionExperimentTreatment                 `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                   `json:"links"`
+	Meta     *PagingInformation                                   `json:"meta,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppStoreVersionExperimentTreatmentResponse or AppStoreVersionExperimentTreatmentsResponse.
+type AppStoreVersionExperimentTreatmentResponseIncluded included
+
+// ListAppStoreVersionExperimentsForAppQuery are query options for ListAppStoreVersionExperimentsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_experiments_for_an_app
+type ListAppStoreVersionExperimentsForAppQuery struct {
+	FieldsAppStoreVersionExperiments          []string `url:"fields[appStoreVersionExperiments],omitempty"`
+	FieldsAppStoreVersionExperimentTreatments []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	FilterState                               []string `url:"filter[state],omitempty"`
+	Include                                   []string `url:"include,omitempty"`
+	Limit                                     int      `url:"limit,omitempty"`
+	LimitAppStoreVersionExperimentTreatments  int      `url:"limit[appStoreVersionExperimentTreatments],omitempty"`
+	Cursor                                    string   `url:"cursor,omitempty"`
+}
+
+// GetAppStoreVersionExperimentQuery are query options for GetAppStoreVersionExperiment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_information_v2
+type GetAppStoreVersionExperimentQuery struct {
+	FieldsAppStoreVersionExperiments          []string `url:"fields[appStoreVersionExperiments],omitempty"`
+	FieldsAppStoreVersionExperimentTreatments []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	Include                                   []string `url:"include,omitempty"`
+	LimitAppStoreVersionExperimentTreatments  int      `url:"limit[appStoreVersionExperimentTreatments],omitempty"`
+}
+
+// ListTreatmentsForAppStoreVersionExperimentQuery are query options for ListTreatmentsForAppStoreVersionExperiment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_treatments_for_an_app_store_version_experiment_v2
+type ListTreatmentsForAppStoreVersionExperimentQuery struct {
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FieldsAppStoreVersionExperimentTreatments             []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	Limit                                                 int      `url:"limit,omitempty"`
+	LimitAppStoreVersionExperimentTreatmentLocalizations  int      `url:"limit[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	Cursor                                                string   `url:"cursor,omitempty"`
+}
+
+// GetAppStoreVersionExperimentTreatmentQuery are query options for GetAppStoreVersionExperimentTreatment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_information
+type GetAppStoreVersionExperimentTreatmentQuery struct {
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FieldsAppStoreVersionExperimentTreatments             []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	LimitAppStoreVersionExperimentTreatmentLocalizations  int      `url:"limit[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+}
+
+// ListAppStoreVersionExperimentsForApp lists the product page optimization experiments of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_experiments_for_an_app
+func (s *AppsService) ListAppStoreVersionExperimentsForApp(ctx context.Context, id string, params *ListAppStoreVersionExperimentsForAppQuery) (*AppStoreVersionExperimentsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appStoreVersionExperimentsV2", id)
+	res := new(AppStoreVersionExperimentsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppStoreVersionExperiment gets information about a specific product page optimization experiment,
+// including its state and the share of traffic it receives.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_information_v2
+func (s *AppsService) GetAppStoreVersionExperiment(ctx context.Context, id string, params *GetAppStoreVersionExperimentQuery) (*AppStoreVersionExperimentResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("appStoreVersionExperiments/%s", id))
+	res := new(AppStoreVersionExperimentResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppStoreVersionExperiment creates a product page optimization experiment for an app. trafficProportion
+// is the percentage of App Store visitors that are shown one of the experiment's treatments.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_store_version_experiment_v2
+func (s *AppsService) CreateAppStoreVersionExperiment(ctx context.Context, name string, platform Platform, trafficProportion int, appID string) (*AppStoreVersionExperimentResponse, *Response, error) {
+	req := appStoreVersionExperimentCreateRequest{
+		Attributes: appStoreVersionExperimentCreateRequestAttributes{
+			Name:              name,
+			Platform:          platform,
+			TrafficProportion: trafficProportion,
+		},
+		Relationships: appStoreVersionExperimentCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "appStoreVersionExperiments",
+	}
+	res := new(AppStoreVersionExperimentResponse)
+	resp, err := s.client.post(ctx, versionedPath("v2", "appStoreVersionExperiments"), newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppStoreVersionExperiment updates the name or traffic proportion of an experiment, or starts or stops it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_store_version_experiment_v2
+func (s *AppsService) UpdateAppStoreVersionExperiment(ctx context.Context, id string, attributes *AppStoreVersionExperimentUpdateRequestAttributes) (*AppStoreVersionExperimentResponse, *Response, error) {
+	req := appStoreVersionExperimentUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appStoreVersionExperiments",
+	}
+	url := versionedPath("v2", fmt.Sprintf("appStoreVersionExperiments/%s", id))
+	res := new(AppStoreVersionExperimentResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// StartAppStoreVersionExperiment starts an approved experiment, so that its treatments begin being shown to
+// App Store visitors.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_store_version_experiment_v2
+func (s *AppsService) StartAppStoreVersionExperiment(ctx context.Context, id string) (*AppStoreVersionExperimentResponse, *Response, error) {
+	return s.UpdateAppStoreVersionExperiment(ctx, id, &AppStoreVersionExperimentUpdateRequestAttributes{
+		Started: Bool(true),
+	})
+}
+
+// StopAppStoreVersionExperiment stops a running experiment before it completes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_store_version_experiment_v2
+func (s *AppsService) StopAppStoreVersionExperiment(ctx context.Context, id string) (*AppStoreVersionExperimentResponse, *Response, error) {
+	return s.UpdateAppStoreVersionExperiment(ctx, id, &AppStoreVersionExperimentUpdateRequestAttributes{
+		Started: Bool(false),
+	})
+}
+
+// DeleteAppStoreVersionExperiment deletes an experiment that hasn't been started.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_store_version_experiment_v2
+func (s *AppsService) DeleteAppStoreVersionExperiment(ctx context.Context, id string) (*Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("appStoreVersionExperiments/%s", id))
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListTreatmentsForAppStoreVersionExperiment lists the treatments of an experiment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_treatments_for_an_app_store_version_experiment_v2
+func (s *AppsService) ListTreatmentsForAppStoreVersionExperiment(ctx context.Context, id string, params *ListTreatmentsForAppStoreVersionExperimentQuery) (*AppStoreVersionExperimentTreatmentsResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("appStoreVersionExperiments/%s/appStoreVersionExperimentTreatments", id))
+	res := new(AppStoreVersionExperimentTreatmentsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppStoreVersionExperimentTreatment gets information about a specific experiment treatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_information
+func (s *AppsService) GetAppStoreVersionExperimentTreatment(ctx context.Context, id string, params *GetAppStoreVersionExperimentTreatmentQuery) (*AppStoreVersionExperimentTreatmentResponse, *Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatments/%s", id)
+	res := new(AppStoreVersionExperimentTreatmentResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppStoreVersionExperimentTreatment adds a treatment to an experiment. appIconName names an alternate
+// app icon included in the build, when the treatment tests an icon.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_store_version_experiment_treatment
+func (s *AppsService) CreateAppStoreVersionExperimentTreatment(ctx context.Context, name string, appIconName *string, appStoreVersionExperimentID string) (*AppStoreVersionExperimentTreatmentResponse, *Response, error) {
+	req := appStoreVersionExperimentTreatmentCreateRequest{
+		Attributes: appStoreVersionExperimentTreatmentCreateRequestAttributes{
+			AppIconName: appIconName,
+			Name:        name,
+		},
+		Relationships: appStoreVersionExperimentTreatmentCreateRequestRelationships{
+			AppStoreVersionExperimentV2: *newRelationshipDeclaration(&appStoreVersionExperimentID, "appStoreVersionExperiments"),
+		},
+		Type: "appStoreVersionExperimentTreatments",
+	}
+	res := new(AppStoreVersionExperimentTreatmentResponse)
+	resp, err := s.client.post(ctx, "appStoreVersionExperimentTreatments", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppStoreVersionExperimentTreatment updates the name or app icon of a treatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_store_version_experiment_treatment
+func (s *AppsService) UpdateAppStoreVersionExperimentTreatment(ctx context.Context, id string, attributes *AppStoreVersionExperimentTreatmentUpdateRequestAttributes) (*AppStoreVersionExperimentTreatmentResponse, *Response, error) {
+	req := appStoreVersionExperimentTreatmentUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appStoreVersionExperimentTreatments",
+	}
+	url := fmt.Sprintf("appStoreVersionExperimentTreatments/%s", id)
+	res := new(AppStoreVersionExperimentTreatmentResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppStoreVersionExperimentTreatment deletes a treatment from an experiment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_store_version_experiment_treatment
+func (s *AppsService) DeleteAppStoreVersionExperimentTreatment(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatments/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionExperimentResponseIncluded.
+func (i *AppStoreVersionExperimentResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppStoreVersionExperimentResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *AppStoreVersionExperimentResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
+
+// AppStoreVersionExperimentTreatment returns the AppStoreVersionExperimentTreatment stored within, if one is present.
+func (i *AppStoreVersionExperimentResponseIncluded) AppStoreVersionExperimentTreatment() *AppStoreVersionExperimentTreatment {
+	return extractIncludedAppStoreVersionExperimentTreatment(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionExperimentTreatmentResponseIncluded.
+func (i *AppStoreVersionExperimentTreatmentResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppStoreVersionExperiment returns the AppStoreVersionExperiment stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentResponseIncluded) AppStoreVersionExperiment() *AppStoreVersionExperiment {
+	return extractIncludedAppStoreVersionExperiment(i.inner)
+}
+
+// AppStoreVersionExperimentTreatmentLocalization returns the AppStoreVersionExperimentTreatmentLocalization stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentResponseIncluded) AppStoreVersionExperimentTreatmentLocalization() *AppStoreVersionExperimentTreatmentLocalization {
+	return extractIncludedAppStoreVersionExperimentTreatmentLocalization(i.inner)
+}
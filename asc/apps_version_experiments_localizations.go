@@ -0,0 +1,249 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppStoreVersionExperimentTreatmentLocalization defines model for AppStoreVersionExperimentTreatmentLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalization
+type AppStoreVersionExperimentTreatmentLocalization struct {
+	Attributes    *AppStoreVersionExperimentTreatmentLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                                       `json:"id"`
+	Links         ResourceLinks                                                `json:"links"`
+	Relationships *AppStoreVersionExperimentTreatmentLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                                       `json:"type"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationAttributes defines model for AppStoreVersionExperimentTreatmentLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalization/attributes
+type AppStoreVersionExperimentTreatmentLocalizationAttributes struct {
+	Locale *string `json:"locale,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationRelationships defines model for AppStoreVersionExperimentTreatmentLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalization/relationships
+type AppStoreVersionExperimentTreatmentLocalizationRelationships struct {
+	AppPreviewSets                     *PagedRelationship `json:"appPreviewSets,omitempty"`
+	AppScreenshotSets                  *PagedRelationship `json:"appScreenshotSets,omitempty"`
+	AppStoreVersionExperimentTreatment *Relationship      `json:"appStoreVersionExperimentTreatment,omitempty"`
+}
+
+// appStoreVersionExperimentTreatmentLocalizationCreateRequest defines model for AppStoreVersionExperimentTreatmentLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationcreaterequest/data
+type appStoreVersionExperimentTreatmentLocalizationCreateRequest struct {
+	Attributes    appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                                   `json:"type"`
+}
+
+// appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes are attributes for AppStoreVersionExperimentTreatmentLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationcreaterequest/data/attributes
+type appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes struct {
+	Locale string `json:"locale"`
+}
+
+// appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships are relationships for AppStoreVersionExperimentTreatmentLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationcreaterequest/data/relationships
+type appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships struct {
+	AppStoreVersionExperimentTreatment relationshipDeclaration `json:"appStoreVersionExperimentTreatment"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationResponse defines model for AppStoreVersionExperimentTreatmentLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationresponse
+type AppStoreVersionExperimentTreatmentLocalizationResponse struct {
+	Data     AppStoreVersionExperimentTreatmentLocalization                   `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                                    `json:"links"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationsResponse defines model for AppStoreVersionExperimentTreatmentLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationsresponse
+type AppStoreVersionExperimentTreatmentLocalizationsResponse struct {
+	Data     []AppStoreVersionExperimentTreatmentLocalization                 `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                               `json:"links"`
+	Meta     *PagingInformation                                               `json:"meta,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppStoreVersionExperimentTreatmentLocalizationResponse or AppStoreVersionExperimentTreatmentLocalizationsResponse.
+type AppStoreVersionExperimentTreatmentLocalizationResponseIncluded included
+
+// ListLocalizationsForAppStoreVersionExperimentTreatmentQuery are query options for ListLocalizationsForAppStoreVersionExperimentTreatment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_app_store_version_experiment_treatment
+type ListLocalizationsForAppStoreVersionExperimentTreatmentQuery struct {
+	FieldsAppPreviewSets                                  []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                               []string `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FilterLocale                                          []string `url:"filter[locale],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	Limit                                                 int      `url:"limit,omitempty"`
+	LimitAppPreviewSets                                   int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                                int      `url:"limit[appScreenshotSets],omitempty"`
+	Cursor                                                string   `url:"cursor,omitempty"`
+}
+
+// GetAppStoreVersionExperimentTreatmentLocalizationQuery are query options for GetAppStoreVersionExperimentTreatmentLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_localization_information
+type GetAppStoreVersionExperimentTreatmentLocalizationQuery struct {
+	FieldsAppPreviewSets                                  []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                               []string `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	LimitAppPreviewSets                                   int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                                int      `url:"limit[appScreenshotSets],omitempty"`
+}
+
+// ListAppScreenshotSetsForTreatmentLocalizationQuery are query options for ListAppScreenshotSetsForTreatmentLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_an_app_store_version_experiment_treatment_localization
+type ListAppScreenshotSetsForTreatmentLocalizationQuery struct {
+	FieldsAppScreenshotSets                               []string `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppScreenshots                                  []string `url:"fields[appScreenshots],omitempty"`
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FilterScreenshotDisplayType                           []string `url:"filter[screenshotDisplayType],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	Limit                                                 int      `url:"limit,omitempty"`
+	Cursor                                                string   `url:"cursor,omitempty"`
+}
+
+// ListAppPreviewSetsForTreatmentLocalizationQuery are query options for ListAppPreviewSetsForTreatmentLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_an_app_store_version_experiment_treatment_localization
+type ListAppPreviewSetsForTreatmentLocalizationQuery struct {
+	FieldsAppPreviewSets                                  []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppPreviews                                     []string `url:"fields[appPreviews],omitempty"`
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FilterPreviewType                                     []string `url:"filter[previewType],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	Limit                                                 int      `url:"limit,omitempty"`
+	Cursor                                                string   `url:"cursor,omitempty"`
+}
+
+// ListLocalizationsForAppStoreVersionExperimentTreatment lists the localizations of an experiment treatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_app_store_version_experiment_treatment
+func (s *AppsService) ListLocalizationsForAppStoreVersionExperimentTreatment(ctx context.Context, id string, params *ListLocalizationsForAppStoreVersionExperimentTreatmentQuery) (*AppStoreVersionExperimentTreatmentLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatments/%s/appStoreVersionExperimentTreatmentLocalizations", id)
+	res := new(AppStoreVersionExperimentTreatmentLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppStoreVersionExperimentTreatmentLocalization gets information about a specific treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_localization_information
+func (s *AppsService) GetAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, id string, params *GetAppStoreVersionExperimentTreatmentLocalizationQuery) (*AppStoreVersionExperimentTreatmentLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s", id)
+	res := new(AppStoreVersionExperimentTreatmentLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppStoreVersionExperimentTreatmentLocalization adds a localization to a treatment, to hold the
+// screenshots and previews tested for that locale.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) CreateAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, locale string, appStoreVersionExperimentTreatmentID string) (*AppStoreVersionExperimentTreatmentLocalizationResponse, *Response, error) {
+	req := appStoreVersionExperimentTreatmentLocalizationCreateRequest{
+		Attributes: appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes{
+			Locale: locale,
+		},
+		Relationships: appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships{
+			AppStoreVersionExperimentTreatment: *newRelationshipDeclaration(&appStoreVersionExperimentTreatmentID, "appStoreVersionExperimentTreatments"),
+		},
+		Type: "appStoreVersionExperimentTreatmentLocalizations",
+	}
+	res := new(AppStoreVersionExperimentTreatmentLocalizationResponse)
+	resp, err := s.client.post(ctx, "appStoreVersionExperimentTreatmentLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppStoreVersionExperimentTreatmentLocalization deletes a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) DeleteAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListAppScreenshotSetsForTreatmentLocalization lists the screenshot sets of a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) ListAppScreenshotSetsForTreatmentLocalization(ctx context.Context, id string, params *ListAppScreenshotSetsForTreatmentLocalizationQuery) (*AppScreenshotSetsResponse, *Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s/appScreenshotSets", id)
+	res := new(AppScreenshotSetsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListAppPreviewSetsForTreatmentLocalization lists the preview sets of a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) ListAppPreviewSetsForTreatmentLocalization(ctx context.Context, id string, params *ListAppPreviewSetsForTreatmentLocalizationQuery) (*AppPreviewSetsResponse, *Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s/appPreviewSets", id)
+	res := new(AppPreviewSetsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionExperimentTreatmentLocalizationResponseIncluded.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppPreviewSet returns the AppPreviewSet stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) AppPreviewSet() *AppPreviewSet {
+	return extractIncludedAppPreviewSet(i.inner)
+}
+
+// AppScreenshotSet returns the AppScreenshotSet stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) AppScreenshotSet() *AppScreenshotSet {
+	return extractIncludedAppScreenshotSet(i.inner)
+}
+
+// AppStoreVersionExperimentTreatment returns the AppStoreVersionExperimentTreatment stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) AppStoreVersionExperimentTreatment() *AppStoreVersionExperimentTreatment {
+	return extractIncludedAppStoreVersionExperimentTreatment(i.inner)
+}
@@ -0,0 +1,94 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForAppStoreVersionExperimentTreatment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListLocalizationsForAppStoreVersionExperimentTreatment(ctx, "10", &ListLocalizationsForAppStoreVersionExperimentTreatmentQuery{})
+	})
+}
+
+func TestGetAppStoreVersionExperimentTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppStoreVersionExperimentTreatmentLocalization(ctx, "10", &GetAppStoreVersionExperimentTreatmentLocalizationQuery{})
+	})
+}
+
+func TestCreateAppStoreVersionExperimentTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppStoreVersionExperimentTreatmentLocalization(ctx, "en-US", "10")
+	})
+}
+
+func TestDeleteAppStoreVersionExperimentTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppStoreVersionExperimentTreatmentLocalization(ctx, "10")
+	})
+}
+
+func TestListAppScreenshotSetsForTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppScreenshotSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppScreenshotSetsForTreatmentLocalization(ctx, "10", &ListAppScreenshotSetsForTreatmentLocalizationQuery{})
+	})
+}
+
+func TestListAppPreviewSetsForTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPreviewSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppPreviewSetsForTreatmentLocalization(ctx, "10", &ListAppPreviewSetsForTreatmentLocalizationQuery{})
+	})
+}
+
+func TestGetAppStoreVersionExperimentTreatmentLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appPreviewSets"},{"type":"appScreenshotSets"},{"type":"appStoreVersionExperimentTreatments"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.Apps.GetAppStoreVersionExperimentTreatmentLocalization(ctx, "10", &GetAppStoreVersionExperimentTreatmentLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].AppPreviewSet())
+		assert.NotNil(t, localization.Included[1].AppScreenshotSet())
+		assert.NotNil(t, localization.Included[2].AppStoreVersionExperimentTreatment())
+
+		assert.Nil(t, localization.Included[0].AppScreenshotSet())
+		assert.Nil(t, localization.Included[1].AppStoreVersionExperimentTreatment())
+		assert.Nil(t, localization.Included[2].AppPreviewSet())
+	})
+}
@@ -0,0 +1,191 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppStoreVersionExperimentsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppStoreVersionExperimentsForApp(ctx, "10", &ListAppStoreVersionExperimentsForAppQuery{})
+	})
+}
+
+func TestGetAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppStoreVersionExperiment(ctx, "10", &GetAppStoreVersionExperimentQuery{})
+	})
+}
+
+func TestCreateAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppStoreVersionExperiment(ctx, "Icon test", PlatformIOS, 30, "10")
+	})
+}
+
+func TestUpdateAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppStoreVersionExperiment(ctx, "10", &AppStoreVersionExperimentUpdateRequestAttributes{TrafficProportion: Int(50)})
+	})
+}
+
+func TestStartAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.StartAppStoreVersionExperiment(ctx, "10")
+	})
+}
+
+func TestStopAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.StopAppStoreVersionExperiment(ctx, "10")
+	})
+}
+
+func TestDeleteAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppStoreVersionExperiment(ctx, "10")
+	})
+}
+
+func TestListTreatmentsForAppStoreVersionExperiment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListTreatmentsForAppStoreVersionExperiment(ctx, "10", &ListTreatmentsForAppStoreVersionExperimentQuery{})
+	})
+}
+
+func TestGetAppStoreVersionExperimentTreatment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppStoreVersionExperimentTreatment(ctx, "10", &GetAppStoreVersionExperimentTreatmentQuery{})
+	})
+}
+
+func TestCreateAppStoreVersionExperimentTreatment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppStoreVersionExperimentTreatment(ctx, "Dark icon", String("AppIconDark"), "10")
+	})
+}
+
+func TestUpdateAppStoreVersionExperimentTreatment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionExperimentTreatmentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppStoreVersionExperimentTreatment(ctx, "10", &AppStoreVersionExperimentTreatmentUpdateRequestAttributes{Name: String("Light icon")})
+	})
+}
+
+func TestDeleteAppStoreVersionExperimentTreatment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppStoreVersionExperimentTreatment(ctx, "10")
+	})
+}
+
+func TestAppStoreVersionExperimentStateIsFinished(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AppStoreVersionExperimentStateCompleted.IsFinished())
+	assert.True(t, AppStoreVersionExperimentStateStopped.IsFinished())
+	assert.False(t, AppStoreVersionExperimentStateAccepted.IsFinished())
+	assert.False(t, AppStoreVersionExperimentStatePrepareForSubmission.IsFinished())
+}
+
+func TestStopAppStoreVersionExperimentSendsStarted(t *testing.T) {
+	t.Parallel()
+
+	var body struct {
+		Data appStoreVersionExperimentUpdateRequest `json:"data"`
+	}
+
+	client, server := newRoutedServer(map[string]route{
+		"PATCH /v2/appStoreVersionExperiments/10": respondWith(http.StatusOK, "{}"),
+	})
+	defer server.Close()
+
+	_, _, err := client.Apps.StopAppStoreVersionExperiment(context.Background(), "10")
+	assert.NoError(t, err)
+
+	requests := server.requests("PATCH /v2/appStoreVersionExperiments/10")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.Equal(t, "10", body.Data.ID)
+	assert.NotNil(t, body.Data.Attributes)
+	assert.Equal(t, Bool(false), body.Data.Attributes.Started)
+}
+
+func TestGetAppStoreVersionExperimentIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appStoreVersions"},{"type":"appStoreVersionExperimentTreatments"}]}`, func(ctx context.Context, client *Client) {
+		experiment, _, err := client.Apps.GetAppStoreVersionExperiment(ctx, "10", &GetAppStoreVersionExperimentQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, experiment.Included)
+
+		assert.NotNil(t, experiment.Included[0].App())
+		assert.NotNil(t, experiment.Included[1].AppStoreVersion())
+		assert.NotNil(t, experiment.Included[2].AppStoreVersionExperimentTreatment())
+
+		assert.Nil(t, experiment.Included[0].AppStoreVersion())
+		assert.Nil(t, experiment.Included[1].AppStoreVersionExperimentTreatment())
+		assert.Nil(t, experiment.Included[2].App())
+	})
+}
+
+func TestGetAppStoreVersionExperimentTreatmentIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appStoreVersionExperiments"},{"type":"appStoreVersionExperimentTreatmentLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		treatment, _, err := client.Apps.GetAppStoreVersionExperimentTreatment(ctx, "10", &GetAppStoreVersionExperimentTreatmentQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, treatment.Included)
+
+		assert.NotNil(t, treatment.Included[0].AppStoreVersionExperiment())
+		assert.NotNil(t, treatment.Included[1].AppStoreVersionExperimentTreatmentLocalization())
+
+		assert.Nil(t, treatment.Included[0].AppStoreVersionExperimentTreatmentLocalization())
+		assert.Nil(t, treatment.Included[1].AppStoreVersionExperiment())
+	})
+}
@@ -56,15 +56,21 @@ type Client struct {
 
 	common service
 
-	Apps         *AppsService
-	Builds       *BuildsService
-	Pricing      *PricingService
-	Provisioning *ProvisioningService
-	Publishing   *PublishingService
-	Reporting    *ReportingService
-	Submission   *SubmissionService
-	TestFlight   *TestflightService
-	Users        *UsersService
+	AppClips       *AppClipsService
+	AppEvents      *AppEventsService
+	Apps           *AppsService
+	Builds         *BuildsService
+	CI             *CIService
+	GameCenter     *GameCenterService
+	InAppPurchases *InAppPurchasesService
+	Pricing        *PricingService
+	Provisioning   *ProvisioningService
+	Publishing     *PublishingService
+	Reporting      *ReportingService
+	Submission     *SubmissionService
+	Subscriptions  *SubscriptionsService
+	TestFlight     *TestflightService
+	Users          *UsersService
 }
 
 // NewClient creates a new Client instance.
@@ -87,13 +93,19 @@ func NewClient(httpClient *http.Client) *Client {
 
 	c.common.client = c
 
+	c.AppClips = (*AppClipsService)(&c.common)
+	c.AppEvents = (*AppEventsService)(&c.common)
 	c.Apps = (*AppsService)(&c.common)
 	c.Builds = (*BuildsService)(&c.common)
+	c.CI = (*CIService)(&c.common)
+	c.GameCenter = (*GameCenterService)(&c.common)
+	c.InAppPurchases = (*InAppPurchasesService)(&c.common)
 	c.Pricing = (*PricingService)(&c.common)
 	c.Provisioning = (*ProvisioningService)(&c.common)
 	c.Publishing = (*PublishingService)(&c.common)
 	c.Reporting = (*ReportingService)(&c.common)
 	c.Submission = (*SubmissionService)(&c.common)
+	c.Subscriptions = (*SubscriptionsService)(&c.common)
 	c.TestFlight = (*TestflightService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 
@@ -211,6 +223,12 @@ func withContentType(typ string) requestOption {
 	}
 }
 
+// versionedPath returns a path relative to the base URL that targets another major version
+// of the API, such as "v2" or "v3", instead of the default "v1".
+func versionedPath(version string, path string) string {
+	return fmt.Sprintf("../%s/%s", version, path)
+}
+
 // AddOptions adds the parameters in opt as URL query parameters to s.  opt
 // must be a struct whose fields may contain "url" tags.
 func appendingQueryOptions(s string, opt interface{}) (string, error) {
@@ -257,6 +275,25 @@ func (c *Client) get(ctx context.Context, url string, query interface{}, v inter
 	return resp, err
 }
 
+// stream sends a GET request and returns the response with its body left open, for downloads that
+// should not be buffered in memory. The caller must close the response body.
+func (c *Client) stream(ctx context.Context, url string, query interface{}, options ...requestOption) (*Response, error) {
+	var err error
+	if query != nil {
+		url, err = appendingQueryOptions(url, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := c.newRequest(ctx, "GET", url, nil, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.send(ctx, req)
+}
+
 // post sends a POST request to the API as configured.
 func (c *Client) post(ctx context.Context, url string, body *requestBody, v interface{}) (*Response, error) {
 	req, err := c.newRequest(ctx, "POST", url, body, withContentType("application/json"))
@@ -336,6 +373,27 @@ func (c *Client) newRequest(ctx context.Context, method string, path string, bod
 }
 
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	response, err := c.send(ctx, req)
+	if err != nil {
+		return response, err
+	}
+
+	defer closeDesc(response.Body)
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err = io.Copy(w, response.Body)
+		} else {
+			err = json.NewDecoder(response.Body).Decode(v)
+		}
+	}
+
+	return response, err
+}
+
+// send sends the request, retrying on transport failures, and returns the response with its body
+// left open for the caller to read and close. The body is already closed if an error is returned.
+func (c *Client) send(ctx context.Context, req *http.Request) (*Response, error) {
 	respCh := make(chan *http.Response, 1)
 	op := func() error {
 		if c.httpDebug {
@@ -375,27 +433,21 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	resp := <-respCh
 
-	defer closeDesc(resp.Body)
-
 	response := newResponse(resp)
 
 	if err != nil {
+		closeDesc(resp.Body)
+
 		return response, err
 	}
 
 	if err := checkResponse(response); err != nil {
-		return response, err
-	}
+		closeDesc(resp.Body)
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-		}
+		return response, err
 	}
 
-	return response, err
+	return response, nil
 }
 
 func newResponse(r *http.Response) *Response {
@@ -22,6 +22,7 @@ package asc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -29,6 +30,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -146,6 +148,37 @@ func TestGetError(t *testing.T) {
 	assert.Equal(t, mockPayload{"TEST"}, unmarshaled)
 }
 
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledMockPayload, http.StatusOK, true)
+	defer server.Close()
+
+	resp, err := client.stream(context.Background(), "test", &mockParams{Field: "TEST"})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, marshaledMockPayload+"\n", string(body))
+}
+
+func TestStreamError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"500"}]}`, http.StatusInternalServerError, false)
+	defer server.Close()
+
+	_, err := client.stream(context.Background(), "test", nil)
+	assert.Error(t, err)
+
+	badQueryValue := []string{"horses"}
+	_, err = client.stream(context.Background(), "test", &badQueryValue)
+	assert.Error(t, err)
+}
+
 func TestPost(t *testing.T) {
 	t.Parallel()
 
@@ -271,6 +304,16 @@ func TestAppendingQueryOptions(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVersionedPath(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	req, err := client.newRequest(context.Background(), "GET", versionedPath("v2", "inAppPurchases/10"), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.appstoreconnect.apple.com/v2/inAppPurchases/10", req.URL.String())
+}
+
 func newServer(raw string, status int, addRateLimit bool) (*Client, *httptest.Server) { // nolint: unparam
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if addRateLimit {
@@ -287,6 +330,107 @@ func newServer(raw string, status int, addRateLimit bool) (*Client, *httptest.Se
 	return client, server
 }
 
+// capturedRequest is a request received by a server started with newRoutedServer.
+type capturedRequest struct {
+	Method string
+	Path   string
+	Host   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// decode unmarshals the JSON body of the request into v.
+func (r capturedRequest) decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// routeResponse is the response a server started with newRoutedServer writes for a route.
+// A zero Status is written as 200 OK.
+type routeResponse struct {
+	Status int
+	Header http.Header
+	Body   string
+}
+
+// route builds the response for a request received by a server started with newRoutedServer.
+type route func(req capturedRequest) routeResponse
+
+// respondWith returns a route that always responds with status and raw.
+func respondWith(status int, raw string) route {
+	return func(capturedRequest) routeResponse {
+		return routeResponse{Status: status, Body: raw}
+	}
+}
+
+// routedServer is a test server that records every request it receives.
+type routedServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	received []capturedRequest
+}
+
+// newRoutedServer starts a server that responds to each request with the route registered for its
+// method and path, such as "GET /v1/apps/10", and with a 404 for anything else. The returned client
+// uses the server as its base URL, including the /v1/ prefix of the API.
+func newRoutedServer(routes map[string]route) (*Client, *routedServer) {
+	s := &routedServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		req := capturedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Host:   r.Host,
+			Query:  r.URL.Query(),
+			Header: r.Header,
+			Body:   body,
+		}
+
+		s.mu.Lock()
+		s.received = append(s.received, req)
+		s.mu.Unlock()
+
+		handler, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		resp := handler(req)
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+
+		if resp.Status != 0 {
+			w.WriteHeader(resp.Status)
+		}
+
+		_, _ = io.WriteString(w, resp.Body)
+	}))
+
+	client := NewClient(s.Client())
+	client.baseURL, _ = url.Parse(s.URL + "/v1/")
+
+	return client, s
+}
+
+// requests returns the requests received for the given route, in the order they arrived.
+func (s *routedServer) requests(key string) []capturedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []capturedRequest
+
+	for _, req := range s.received {
+		if req.Method+" "+req.Path == key {
+			matched = append(matched, req)
+		}
+	}
+
+	return matched
+}
+
 func testEndpointWithResponse(t *testing.T, marshalledGot string, want interface{}, endpoint func(ctx context.Context, client *Client) (interface{}, *Response, error)) {
 	t.Helper()
 
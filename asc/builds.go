@@ -339,7 +339,14 @@ func (s *BuildsService) UpdateBuild(ctx context.Context, id string, expired *boo
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/assign_the_app_encryption_declaration_for_a_build
 func (s *BuildsService) UpdateAppEncryptionDeclarationForBuild(ctx context.Context, id string, appEncryptionDeclarationID *string) (*Response, error) {
-	linkage := newRelationshipDeclaration(appEncryptionDeclarationID, "builds")
+	var linkage *RelationshipData
+	if appEncryptionDeclarationID != nil {
+		linkage = &RelationshipData{
+			ID:   *appEncryptionDeclarationID,
+			Type: "appEncryptionDeclarations",
+		}
+	}
+
 	url := fmt.Sprintf("builds/%s/relationships/appEncryptionDeclaration", id)
 
 	return s.client.patch(ctx, url, newRequestBody(linkage), nil)
@@ -58,11 +58,13 @@ type AppEncryptionDeclaration struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclaration/attributes
 type AppEncryptionDeclarationAttributes struct {
+	AppDescription                  *string                        `json:"appDescription,omitempty"`
 	AppEncryptionDeclarationState   *AppEncryptionDeclarationState `json:"appEncryptionDeclarationState,omitempty"`
 	AvailableOnFrenchStore          *bool                          `json:"availableOnFrenchStore,omitempty"`
 	CodeValue                       *string                        `json:"codeValue,omitempty"`
 	ContainsProprietaryCryptography *bool                          `json:"containsProprietaryCryptography,omitempty"`
 	ContainsThirdPartyCryptography  *bool                          `json:"containsThirdPartyCryptography,omitempty"`
+	CreatedDate                     *DateTime                      `json:"createdDate,omitempty"`
 	DocumentName                    *string                        `json:"documentName,omitempty"`
 	DocumentType                    *string                        `json:"documentType,omitempty"`
 	DocumentURL                     *string                        `json:"documentUrl,omitempty"`
@@ -76,7 +78,35 @@ type AppEncryptionDeclarationAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclaration/relationships
 type AppEncryptionDeclarationRelationships struct {
-	App *Relationship `json:"app,omitempty"`
+	App                              *Relationship      `json:"app,omitempty"`
+	AppEncryptionDeclarationDocument *Relationship      `json:"appEncryptionDeclarationDocument,omitempty"`
+	Builds                           *PagedRelationship `json:"builds,omitempty"`
+}
+
+// appEncryptionDeclarationCreateRequest defines model for AppEncryptionDeclarationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationcreaterequest/data
+type appEncryptionDeclarationCreateRequest struct {
+	Attributes    AppEncryptionDeclarationCreateRequestAttributes    `json:"attributes"`
+	Relationships appEncryptionDeclarationCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// AppEncryptionDeclarationCreateRequestAttributes are attributes for AppEncryptionDeclarationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationcreaterequest/data/attributes
+type AppEncryptionDeclarationCreateRequestAttributes struct {
+	AppDescription                  string `json:"appDescription"`
+	AvailableOnFrenchStore          bool   `json:"availableOnFrenchStore"`
+	ContainsProprietaryCryptography bool   `json:"containsProprietaryCryptography"`
+	ContainsThirdPartyCryptography  bool   `json:"containsThirdPartyCryptography"`
+}
+
+// appEncryptionDeclarationCreateRequestRelationships are relationships for AppEncryptionDeclarationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationcreaterequest/data/relationships
+type appEncryptionDeclarationCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
 }
 
 // AppEncryptionDeclarationResponse defines model for AppEncryptionDeclarationResponse.
@@ -121,6 +151,13 @@ type GetAppEncryptionDeclarationQuery struct {
 	Include                         []string `url:"include,omitempty"`
 }
 
+// GetAppEncryptionDeclarationDocumentForAppEncryptionDeclarationQuery are query options for GetAppEncryptionDeclarationDocumentForAppEncryptionDeclaration
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_document_of_an_app_encryption_declaration
+type GetAppEncryptionDeclarationDocumentForAppEncryptionDeclarationQuery struct {
+	FieldsAppEncryptionDeclarationDocuments []string `url:"fields[appEncryptionDeclarationDocuments],omitempty"`
+}
+
 // GetAppForEncryptionDeclarationQuery are query options for GetAppForEncryptionDeclaration
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_an_app_encryption_declaration
@@ -160,12 +197,42 @@ func (s *BuildsService) GetAppForAppEncryptionDeclaration(ctx context.Context, i
 	return res, resp, err
 }
 
+// CreateAppEncryptionDeclaration declares an app's use of encryption with its export compliance answers. Once the
+// declaration exists, upload any supporting documentation with CreateAppEncryptionDeclarationDocument and attach
+// it to builds with AssignBuildsToAppEncryptionDeclaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_encryption_declaration
+func (s *BuildsService) CreateAppEncryptionDeclaration(ctx context.Context, attributes AppEncryptionDeclarationCreateRequestAttributes, appID string) (*AppEncryptionDeclarationResponse, *Response, error) {
+	req := appEncryptionDeclarationCreateRequest{
+		Attributes: attributes,
+		Relationships: appEncryptionDeclarationCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "appEncryptionDeclarations",
+	}
+	res := new(AppEncryptionDeclarationResponse)
+	resp, err := s.client.post(ctx, "appEncryptionDeclarations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetAppEncryptionDeclarationDocumentForAppEncryptionDeclaration gets the compliance document uploaded for an app encryption declaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_document_of_an_app_encryption_declaration
+func (s *BuildsService) GetAppEncryptionDeclarationDocumentForAppEncryptionDeclaration(ctx context.Context, id string, params *GetAppEncryptionDeclarationDocumentForAppEncryptionDeclarationQuery) (*AppEncryptionDeclarationDocumentResponse, *Response, error) {
+	url := fmt.Sprintf("appEncryptionDeclarations/%s/appEncryptionDeclarationDocument", id)
+	res := new(AppEncryptionDeclarationDocumentResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
 // AssignBuildsToAppEncryptionDeclaration assigns one or more builds to an app encryption declaration.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/assign_builds_to_an_app_encryption_declaration
 func (s *BuildsService) AssignBuildsToAppEncryptionDeclaration(ctx context.Context, id string, buildIDs []string) (*Response, error) {
 	linkages := newPagedRelationshipDeclaration(buildIDs, "builds")
-	url := fmt.Sprintf("appStoreVersionSubmissions/%s", id)
+	url := fmt.Sprintf("appEncryptionDeclarations/%s/relationships/builds", id)
 
 	return s.client.post(ctx, url, newRequestBody(linkages.Data), nil)
 }
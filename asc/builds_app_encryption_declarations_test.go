@@ -22,7 +22,10 @@ package asc
 
 import (
 	"context"
+	"net/http"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListAppEncryptionDeclarations(t *testing.T) {
@@ -49,6 +52,25 @@ func TestGetAppForAppEncryptionDeclaration(t *testing.T) {
 	})
 }
 
+func TestCreateAppEncryptionDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEncryptionDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Builds.CreateAppEncryptionDeclaration(ctx, AppEncryptionDeclarationCreateRequestAttributes{
+			AppDescription:                 "Uses TLS for network requests and AES to encrypt local caches.",
+			ContainsThirdPartyCryptography: true,
+		}, "10")
+	})
+}
+
+func TestGetAppEncryptionDeclarationDocumentForAppEncryptionDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEncryptionDeclarationDocumentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Builds.GetAppEncryptionDeclarationDocumentForAppEncryptionDeclaration(ctx, "10", &GetAppEncryptionDeclarationDocumentForAppEncryptionDeclarationQuery{})
+	})
+}
+
 func TestAssignBuildsToAppEncryptionDeclaration(t *testing.T) {
 	t.Parallel()
 
@@ -56,3 +78,15 @@ func TestAssignBuildsToAppEncryptionDeclaration(t *testing.T) {
 		return client.Builds.AssignBuildsToAppEncryptionDeclaration(ctx, "10", []string{"10"})
 	})
 }
+
+func TestAssignBuildsToAppEncryptionDeclarationUsesBuildsRelationship(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"POST /v1/appEncryptionDeclarations/10/relationships/builds": respondWith(http.StatusNoContent, ""),
+	})
+	defer server.Close()
+
+	_, err := client.Builds.AssignBuildsToAppEncryptionDeclaration(context.Background(), "10", []string{"20"})
+	assert.NoError(t, err)
+}
@@ -22,6 +22,7 @@ package asc
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -129,6 +130,28 @@ func TestUpdateAppEncryptionDeclarationForBuild(t *testing.T) {
 	})
 }
 
+func TestUpdateAppEncryptionDeclarationForBuildLinkage(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"PATCH /v1/builds/10/relationships/appEncryptionDeclaration": respondWith(http.StatusNoContent, ""),
+	})
+	defer server.Close()
+
+	_, err := client.Builds.UpdateAppEncryptionDeclarationForBuild(context.Background(), "10", String("20"))
+
+	assert.NoError(t, err)
+
+	var body struct {
+		Data RelationshipData `json:"data"`
+	}
+
+	requests := server.requests("PATCH /v1/builds/10/relationships/appEncryptionDeclaration")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.Equal(t, RelationshipData{ID: "20", Type: "appEncryptionDeclarations"}, body.Data)
+}
+
 func TestCreateAccessForBetaGroupsToBuild(t *testing.T) {
 	t.Parallel()
 
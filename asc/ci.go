@@ -0,0 +1,92 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+// CIService handles communication with Xcode Cloud-related methods of the App Store Connect API
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcode_cloud_workflows_and_builds
+type CIService service
+
+// CIActionType defines model for CiActionType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciactiontype
+type CIActionType string
+
+const (
+	// CIActionTypeBuild is a CI action type for Build.
+	CIActionTypeBuild CIActionType = "BUILD"
+	// CIActionTypeAnalyze is a CI action type for Analyze.
+	CIActionTypeAnalyze CIActionType = "ANALYZE"
+	// CIActionTypeTest is a CI action type for Test.
+	CIActionTypeTest CIActionType = "TEST"
+	// CIActionTypeArchive is a CI action type for Archive.
+	CIActionTypeArchive CIActionType = "ARCHIVE"
+)
+
+// CIExecutionProgress defines model for CiExecutionProgress.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciexecutionprogress
+type CIExecutionProgress string
+
+const (
+	// CIExecutionProgressPending is a CI execution progress for Pending.
+	CIExecutionProgressPending CIExecutionProgress = "PENDING"
+	// CIExecutionProgressRunning is a CI execution progress for Running.
+	CIExecutionProgressRunning CIExecutionProgress = "RUNNING"
+	// CIExecutionProgressComplete is a CI execution progress for Complete.
+	CIExecutionProgressComplete CIExecutionProgress = "COMPLETE"
+)
+
+// CICompletionStatus defines model for CiCompletionStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cicompletionstatus
+type CICompletionStatus string
+
+const (
+	// CICompletionStatusSucceeded is a CI completion status for Succeeded.
+	CICompletionStatusSucceeded CICompletionStatus = "SUCCEEDED"
+	// CICompletionStatusFailed is a CI completion status for Failed.
+	CICompletionStatusFailed CICompletionStatus = "FAILED"
+	// CICompletionStatusErrored is a CI completion status for Errored.
+	CICompletionStatusErrored CICompletionStatus = "ERRORED"
+	// CICompletionStatusCanceled is a CI completion status for Canceled.
+	CICompletionStatusCanceled CICompletionStatus = "CANCELED"
+	// CICompletionStatusSkipped is a CI completion status for Skipped.
+	CICompletionStatusSkipped CICompletionStatus = "SKIPPED"
+)
+
+// CIIssueCounts defines model for CiIssueCounts.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissuecounts
+type CIIssueCounts struct {
+	AnalyzerWarnings *int `json:"analyzerWarnings,omitempty"`
+	Errors           *int `json:"errors,omitempty"`
+	TestFailures     *int `json:"testFailures,omitempty"`
+	Warnings         *int `json:"warnings,omitempty"`
+}
+
+// FileLocation defines model for FileLocation.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/filelocation
+type FileLocation struct {
+	LineNumber *int    `json:"lineNumber,omitempty"`
+	Path       *string `json:"path,omitempty"`
+}
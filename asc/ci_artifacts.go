@@ -0,0 +1,137 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrMissingArtifactDownloadURL happens when DownloadCIArtifact is given an artifact without a download URL.
+var ErrMissingArtifactDownloadURL = errors.New("artifact has no download url")
+
+// CIArtifactFileType defines model for CiArtifact.Attributes.FileType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifact/attributes
+type CIArtifactFileType string
+
+const (
+	// CIArtifactFileTypeArchive is a file type for Archive.
+	CIArtifactFileTypeArchive CIArtifactFileType = "ARCHIVE"
+	// CIArtifactFileTypeArchiveExport is a file type for ArchiveExport.
+	CIArtifactFileTypeArchiveExport CIArtifactFileType = "ARCHIVE_EXPORT"
+	// CIArtifactFileTypeLogBundle is a file type for LogBundle.
+	CIArtifactFileTypeLogBundle CIArtifactFileType = "LOG_BUNDLE"
+	// CIArtifactFileTypeResultBundle is a file type for ResultBundle.
+	CIArtifactFileTypeResultBundle CIArtifactFileType = "RESULT_BUNDLE"
+	// CIArtifactFileTypeTestProducts is a file type for TestProducts.
+	CIArtifactFileTypeTestProducts CIArtifactFileType = "TEST_PRODUCTS"
+	// CIArtifactFileTypeXcodebuildProducts is a file type for XcodebuildProducts.
+	CIArtifactFileTypeXcodebuildProducts CIArtifactFileType = "XCODEBUILD_PRODUCTS"
+	// CIArtifactFileTypeStapledNotarizedArchive is a file type for StapledNotarizedArchive.
+	CIArtifactFileTypeStapledNotarizedArchive CIArtifactFileType = "STAPLED_NOTARIZED_ARCHIVE"
+)
+
+// CIArtifact defines model for CiArtifact.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifact
+type CIArtifact struct {
+	Attributes *CIArtifactAttributes `json:"attributes,omitempty"`
+	ID         string                `json:"id"`
+	Links      ResourceLinks         `json:"links"`
+	Type       string                `json:"type"`
+}
+
+// CIArtifactAttributes defines model for CiArtifact.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifact/attributes
+type CIArtifactAttributes struct {
+	DownloadURL *string             `json:"downloadUrl,omitempty"`
+	FileName    *string             `json:"fileName,omitempty"`
+	FileSize    *int                `json:"fileSize,omitempty"`
+	FileType    *CIArtifactFileType `json:"fileType,omitempty"`
+}
+
+// CIArtifactResponse defines model for CiArtifactResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifactresponse
+type CIArtifactResponse struct {
+	Data  CIArtifact    `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// CIArtifactsResponse defines model for CiArtifactsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifactsresponse
+type CIArtifactsResponse struct {
+	Data  []CIArtifact       `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// GetCIArtifactQuery are query options for GetCIArtifact
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_artifact_information
+type GetCIArtifactQuery struct {
+	FieldsCIArtifacts []string `url:"fields[ciArtifacts],omitempty"`
+}
+
+// ListArtifactsForCIBuildActionQuery are query options for ListArtifactsForCIBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_artifacts_for_a_build_action
+type ListArtifactsForCIBuildActionQuery struct {
+	FieldsCIArtifacts []string `url:"fields[ciArtifacts],omitempty"`
+	Limit             int      `url:"limit,omitempty"`
+	Cursor            string   `url:"cursor,omitempty"`
+}
+
+// ListArtifactsForCIBuildAction lists the artifacts a build action created.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_artifacts_for_a_build_action
+func (s *CIService) ListArtifactsForCIBuildAction(ctx context.Context, id string, params *ListArtifactsForCIBuildActionQuery) (*CIArtifactsResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildActions/%s/artifacts", id)
+	res := new(CIArtifactsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCIArtifact gets information about a specific artifact, including its download URL.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_artifact_information
+func (s *CIService) GetCIArtifact(ctx context.Context, id string, params *GetCIArtifactQuery) (*CIArtifactResponse, *Response, error) {
+	url := fmt.Sprintf("ciArtifacts/%s", id)
+	res := new(CIArtifactResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// DownloadCIArtifact downloads the contents of an artifact to the given writer.
+func (s *CIService) DownloadCIArtifact(ctx context.Context, artifact *CIArtifact, w io.Writer) (*Response, error) {
+	if artifact == nil || artifact.Attributes == nil || artifact.Attributes.DownloadURL == nil {
+		return nil, ErrMissingArtifactDownloadURL
+	}
+
+	return s.client.get(ctx, *artifact.Attributes.DownloadURL, nil, w)
+}
@@ -0,0 +1,76 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListArtifactsForCIBuildAction(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIArtifactsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListArtifactsForCIBuildAction(ctx, "10", &ListArtifactsForCIBuildActionQuery{})
+	})
+}
+
+func TestGetCIArtifact(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIArtifactResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIArtifact(ctx, "10", &GetCIArtifactQuery{})
+	})
+}
+
+func TestDownloadCIArtifact(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("artifact", http.StatusOK, false)
+	defer server.Close()
+
+	artifact := &CIArtifact{
+		Attributes: &CIArtifactAttributes{
+			DownloadURL: String(server.URL + "/artifact.zip"),
+		},
+	}
+
+	var buf bytes.Buffer
+	resp, err := client.CI.DownloadCIArtifact(context.Background(), artifact, &buf)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "artifact\n", buf.String())
+}
+
+func TestDownloadCIArtifactMissingURL(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	resp, err := client.CI.DownloadCIArtifact(context.Background(), &CIArtifact{}, &bytes.Buffer{})
+
+	assert.ErrorIs(t, err, ErrMissingArtifactDownloadURL)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,133 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CIBuildAction defines model for CiBuildAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildaction
+type CIBuildAction struct {
+	Attributes    *CIBuildActionAttributes    `json:"attributes,omitempty"`
+	ID            string                      `json:"id"`
+	Links         ResourceLinks               `json:"links"`
+	Relationships *CIBuildActionRelationships `json:"relationships,omitempty"`
+	Type          string                      `json:"type"`
+}
+
+// CIBuildActionAttributes defines model for CiBuildAction.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildaction/attributes
+type CIBuildActionAttributes struct {
+	ActionType        *CIActionType        `json:"actionType,omitempty"`
+	CompletionStatus  *CICompletionStatus  `json:"completionStatus,omitempty"`
+	ExecutionProgress *CIExecutionProgress `json:"executionProgress,omitempty"`
+	FinishedDate      *DateTime            `json:"finishedDate,omitempty"`
+	IsRequiredToPass  *bool                `json:"isRequiredToPass,omitempty"`
+	IssueCounts       *CIIssueCounts       `json:"issueCounts,omitempty"`
+	Name              *string              `json:"name,omitempty"`
+	StartedDate       *DateTime            `json:"startedDate,omitempty"`
+}
+
+// CIBuildActionRelationships defines model for CiBuildAction.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildaction/relationships
+type CIBuildActionRelationships struct {
+	Artifacts   *PagedRelationship `json:"artifacts,omitempty"`
+	BuildRun    *Relationship      `json:"buildRun,omitempty"`
+	Issues      *PagedRelationship `json:"issues,omitempty"`
+	TestResults *PagedRelationship `json:"testResults,omitempty"`
+}
+
+// CIBuildActionResponse defines model for CiBuildActionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildactionresponse
+type CIBuildActionResponse struct {
+	Data     CIBuildAction `json:"data"`
+	Included []CIBuildRun  `json:"included,omitempty"`
+	Links    DocumentLinks `json:"links"`
+}
+
+// CIBuildActionsResponse defines model for CiBuildActionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildactionsresponse
+type CIBuildActionsResponse struct {
+	Data     []CIBuildAction    `json:"data"`
+	Included []CIBuildRun       `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// GetCIBuildActionQuery are query options for GetCIBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_action_information
+type GetCIBuildActionQuery struct {
+	FieldsCIBuildActions []string `url:"fields[ciBuildActions],omitempty"`
+	FieldsCIBuildRuns    []string `url:"fields[ciBuildRuns],omitempty"`
+	Include              []string `url:"include,omitempty"`
+}
+
+// ListCIBuildActionsForBuildRunQuery are query options for ListCIBuildActionsForBuildRun
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_actions_for_a_build_run
+type ListCIBuildActionsForBuildRunQuery struct {
+	FieldsCIBuildActions []string `url:"fields[ciBuildActions],omitempty"`
+	FieldsCIBuildRuns    []string `url:"fields[ciBuildRuns],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// ListCIBuildActionsForBuildRun lists the actions performed as part of a build run.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_actions_for_a_build_run
+func (s *CIService) ListCIBuildActionsForBuildRun(ctx context.Context, id string, params *ListCIBuildActionsForBuildRunQuery) (*CIBuildActionsResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildRuns/%s/actions", id)
+	res := new(CIBuildActionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCIBuildAction gets information about a specific build action.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_action_information
+func (s *CIService) GetCIBuildAction(ctx context.Context, id string, params *GetCIBuildActionQuery) (*CIBuildActionResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildActions/%s", id)
+	res := new(CIBuildActionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetBuildRunForCIBuildAction gets the build run a build action belongs to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_run_information_of_a_build_action
+func (s *CIService) GetBuildRunForCIBuildAction(ctx context.Context, id string, params *GetCIBuildRunQuery) (*CIBuildRunResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildActions/%s/buildRun", id)
+	res := new(CIBuildRunResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
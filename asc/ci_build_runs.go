@@ -0,0 +1,325 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CIBuildRunStartReason defines model for CiBuildRun.Attributes.StartReason
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CIBuildRunStartReason string
+
+const (
+	// CIBuildRunStartReasonGitRefChange is a start reason for GitRefChange.
+	CIBuildRunStartReasonGitRefChange CIBuildRunStartReason = "GIT_REF_CHANGE"
+	// CIBuildRunStartReasonManual is a start reason for Manual.
+	CIBuildRunStartReasonManual CIBuildRunStartReason = "MANUAL"
+	// CIBuildRunStartReasonManualRebuild is a start reason for ManualRebuild.
+	CIBuildRunStartReasonManualRebuild CIBuildRunStartReason = "MANUAL_REBUILD"
+	// CIBuildRunStartReasonPullRequestOpen is a start reason for PullRequestOpen.
+	CIBuildRunStartReasonPullRequestOpen CIBuildRunStartReason = "PULL_REQUEST_OPEN"
+	// CIBuildRunStartReasonPullRequestUpdate is a start reason for PullRequestUpdate.
+	CIBuildRunStartReasonPullRequestUpdate CIBuildRunStartReason = "PULL_REQUEST_UPDATE"
+	// CIBuildRunStartReasonSchedule is a start reason for Schedule.
+	CIBuildRunStartReasonSchedule CIBuildRunStartReason = "SCHEDULE"
+)
+
+// CIBuildRunCancelReason defines model for CiBuildRun.Attributes.CancelReason
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CIBuildRunCancelReason string
+
+const (
+	// CIBuildRunCancelReasonAutomaticallyByNewerBuild is a cancel reason for AutomaticallyByNewerBuild.
+	CIBuildRunCancelReasonAutomaticallyByNewerBuild CIBuildRunCancelReason = "AUTOMATICALLY_BY_NEWER_BUILD"
+	// CIBuildRunCancelReasonManuallyByUser is a cancel reason for ManuallyByUser.
+	CIBuildRunCancelReasonManuallyByUser CIBuildRunCancelReason = "MANUALLY_BY_USER"
+)
+
+// CIGitUser defines model for CiGitUser.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cigituser
+type CIGitUser struct {
+	AvatarURL   *string `json:"avatarUrl,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// CIGitCommit defines model for CiBuildRun.Attributes.SourceCommit and CiBuildRun.Attributes.DestinationCommit
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CIGitCommit struct {
+	Author    *CIGitUser `json:"author,omitempty"`
+	CommitSha *string    `json:"commitSha,omitempty"`
+	Committer *CIGitUser `json:"committer,omitempty"`
+	Message   *string    `json:"message,omitempty"`
+	WebURL    *string    `json:"webUrl,omitempty"`
+}
+
+// CIBuildRun defines model for CiBuildRun.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun
+type CIBuildRun struct {
+	Attributes    *CIBuildRunAttributes    `json:"attributes,omitempty"`
+	ID            string                   `json:"id"`
+	Links         ResourceLinks            `json:"links"`
+	Relationships *CIBuildRunRelationships `json:"relationships,omitempty"`
+	Type          string                   `json:"type"`
+}
+
+// CIBuildRunAttributes defines model for CiBuildRun.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CIBuildRunAttributes struct {
+	CancelReason       *CIBuildRunCancelReason `json:"cancelReason,omitempty"`
+	CompletionStatus   *CICompletionStatus     `json:"completionStatus,omitempty"`
+	CreatedDate        *DateTime               `json:"createdDate,omitempty"`
+	DestinationCommit  *CIGitCommit            `json:"destinationCommit,omitempty"`
+	ExecutionProgress  *CIExecutionProgress    `json:"executionProgress,omitempty"`
+	FinishedDate       *DateTime               `json:"finishedDate,omitempty"`
+	IsPullRequestBuild *bool                   `json:"isPullRequestBuild,omitempty"`
+	IssueCounts        *CIIssueCounts          `json:"issueCounts,omitempty"`
+	Number             *int                    `json:"number,omitempty"`
+	SourceCommit       *CIGitCommit            `json:"sourceCommit,omitempty"`
+	StartedDate        *DateTime               `json:"startedDate,omitempty"`
+	StartReason        *CIBuildRunStartReason  `json:"startReason,omitempty"`
+}
+
+// CIBuildRunRelationships defines model for CiBuildRun.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/relationships
+type CIBuildRunRelationships struct {
+	Actions           *PagedRelationship `json:"actions,omitempty"`
+	Builds            *PagedRelationship `json:"builds,omitempty"`
+	DestinationBranch *Relationship      `json:"destinationBranch,omitempty"`
+	Product           *Relationship      `json:"product,omitempty"`
+	PullRequest       *Relationship      `json:"pullRequest,omitempty"`
+	SourceBranchOrTag *Relationship      `json:"sourceBranchOrTag,omitempty"`
+	Workflow          *Relationship      `json:"workflow,omitempty"`
+}
+
+// ciBuildRunCreateRequest defines model for CiBuildRunCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildruncreaterequest/data
+type ciBuildRunCreateRequest struct {
+	Attributes    *ciBuildRunCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships ciBuildRunCreateRequestRelationships `json:"relationships"`
+	Type          string                               `json:"type"`
+}
+
+// ciBuildRunCreateRequestAttributes are attributes for CIBuildRunCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildruncreaterequest/data/attributes
+type ciBuildRunCreateRequestAttributes struct {
+	Clean *bool `json:"clean,omitempty"`
+}
+
+// ciBuildRunCreateRequestRelationships are relationships for CIBuildRunCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildruncreaterequest/data/relationships
+type ciBuildRunCreateRequestRelationships struct {
+	BuildRun          *relationshipDeclaration `json:"buildRun,omitempty"`
+	PullRequest       *relationshipDeclaration `json:"pullRequest,omitempty"`
+	SourceBranchOrTag *relationshipDeclaration `json:"sourceBranchOrTag,omitempty"`
+	Workflow          *relationshipDeclaration `json:"workflow,omitempty"`
+}
+
+// CIBuildRunResponse defines model for CiBuildRunResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrunresponse
+type CIBuildRunResponse struct {
+	Data     CIBuildRun                   `json:"data"`
+	Included []CIBuildRunResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                `json:"links"`
+}
+
+// CIBuildRunsResponse defines model for CiBuildRunsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrunsresponse
+type CIBuildRunsResponse struct {
+	Data     []CIBuildRun                 `json:"data"`
+	Included []CIBuildRunResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks           `json:"links"`
+	Meta     *PagingInformation           `json:"meta,omitempty"`
+}
+
+// CIBuildRunResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CIBuildRunResponse or CIBuildRunsResponse.
+type CIBuildRunResponseIncluded included
+
+// GetCIBuildRunQuery are query options for GetCIBuildRun
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_run_information
+type GetCIBuildRunQuery struct {
+	FieldsBuilds           []string `url:"fields[builds],omitempty"`
+	FieldsCIBuildRuns      []string `url:"fields[ciBuildRuns],omitempty"`
+	FieldsCIProducts       []string `url:"fields[ciProducts],omitempty"`
+	FieldsCIWorkflows      []string `url:"fields[ciWorkflows],omitempty"`
+	FieldsSCMGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsSCMPullRequests  []string `url:"fields[scmPullRequests],omitempty"`
+	Include                []string `url:"include,omitempty"`
+	LimitBuilds            int      `url:"limit[builds],omitempty"`
+}
+
+// ListCIBuildRunsQuery are query options for ListCIBuildRunsForWorkflow and ListCIBuildRunsForProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_build_runs_for_a_workflow
+type ListCIBuildRunsQuery struct {
+	FieldsBuilds           []string `url:"fields[builds],omitempty"`
+	FieldsCIBuildRuns      []string `url:"fields[ciBuildRuns],omitempty"`
+	FieldsCIProducts       []string `url:"fields[ciProducts],omitempty"`
+	FieldsCIWorkflows      []string `url:"fields[ciWorkflows],omitempty"`
+	FieldsSCMGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsSCMPullRequests  []string `url:"fields[scmPullRequests],omitempty"`
+	FilterBuilds           []string `url:"filter[builds],omitempty"`
+	Include                []string `url:"include,omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	LimitBuilds            int      `url:"limit[builds],omitempty"`
+	Sort                   []string `url:"sort,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// ListCIBuildRunsForWorkflow lists the build runs of a workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_build_runs_for_a_workflow
+func (s *CIService) ListCIBuildRunsForWorkflow(ctx context.Context, id string, params *ListCIBuildRunsQuery) (*CIBuildRunsResponse, *Response, error) {
+	url := fmt.Sprintf("ciWorkflows/%s/buildRuns", id)
+	res := new(CIBuildRunsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListCIBuildRunsForProduct lists the build runs of all workflows of an Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_build_runs_for_an_xcode_cloud_product
+func (s *CIService) ListCIBuildRunsForProduct(ctx context.Context, id string, params *ListCIBuildRunsQuery) (*CIBuildRunsResponse, *Response, error) {
+	url := fmt.Sprintf("ciProducts/%s/buildRuns", id)
+	res := new(CIBuildRunsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCIBuildRun gets information about a specific build run.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_run_information
+func (s *CIService) GetCIBuildRun(ctx context.Context, id string, params *GetCIBuildRunQuery) (*CIBuildRunResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildRuns/%s", id)
+	res := new(CIBuildRunResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// StartCIBuildRunForGitReference starts a new build run of a workflow for a branch or tag.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/start_a_build
+func (s *CIService) StartCIBuildRunForGitReference(ctx context.Context, workflowID string, gitReferenceID string, clean *bool) (*CIBuildRunResponse, *Response, error) {
+	return s.createCIBuildRun(ctx, clean, ciBuildRunCreateRequestRelationships{
+		SourceBranchOrTag: newRelationshipDeclaration(&gitReferenceID, "scmGitReferences"),
+		Workflow:          newRelationshipDeclaration(&workflowID, "ciWorkflows"),
+	})
+}
+
+// StartCIBuildRunForPullRequest starts a new build run of a workflow for a pull request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/start_a_build
+func (s *CIService) StartCIBuildRunForPullRequest(ctx context.Context, workflowID string, pullRequestID string, clean *bool) (*CIBuildRunResponse, *Response, error) {
+	return s.createCIBuildRun(ctx, clean, ciBuildRunCreateRequestRelationships{
+		PullRequest: newRelationshipDeclaration(&pullRequestID, "scmPullRequests"),
+		Workflow:    newRelationshipDeclaration(&workflowID, "ciWorkflows"),
+	})
+}
+
+// RerunCIBuildRun starts a new build run that rebuilds the same commit as an existing build run.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/start_a_build
+func (s *CIService) RerunCIBuildRun(ctx context.Context, buildRunID string, clean *bool) (*CIBuildRunResponse, *Response, error) {
+	return s.createCIBuildRun(ctx, clean, ciBuildRunCreateRequestRelationships{
+		BuildRun: newRelationshipDeclaration(&buildRunID, "ciBuildRuns"),
+	})
+}
+
+func (s *CIService) createCIBuildRun(ctx context.Context, clean *bool, relationships ciBuildRunCreateRequestRelationships) (*CIBuildRunResponse, *Response, error) {
+	req := ciBuildRunCreateRequest{
+		Relationships: relationships,
+		Type:          "ciBuildRuns",
+	}
+
+	if clean != nil {
+		req.Attributes = &ciBuildRunCreateRequestAttributes{
+			Clean: clean,
+		}
+	}
+
+	res := new(CIBuildRunResponse)
+	resp, err := s.client.post(ctx, "ciBuildRuns", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListBuildsForCIBuildRun lists the builds a build run created.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_for_a_build_run
+func (s *CIService) ListBuildsForCIBuildRun(ctx context.Context, id string, params *ListBuildsQuery) (*BuildsResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildRuns/%s/builds", id)
+	res := new(BuildsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CIBuildRunResponseIncluded.
+func (i *CIBuildRunResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Build returns the Build stored within, if one is present.
+func (i *CIBuildRunResponseIncluded) Build() *Build {
+	return extractIncludedBuild(i.inner)
+}
+
+// CIProduct returns the CIProduct stored within, if one is present.
+func (i *CIBuildRunResponseIncluded) CIProduct() *CIProduct {
+	return extractIncludedCIProduct(i.inner)
+}
+
+// CIWorkflow returns the CIWorkflow stored within, if one is present.
+func (i *CIBuildRunResponseIncluded) CIWorkflow() *CIWorkflow {
+	return extractIncludedCIWorkflow(i.inner)
+}
+
+// SCMGitReference returns the SCMGitReference stored within, if one is present.
+func (i *CIBuildRunResponseIncluded) SCMGitReference() *SCMGitReference {
+	return extractIncludedSCMGitReference(i.inner)
+}
+
+// SCMPullRequest returns the SCMPullRequest stored within, if one is present.
+func (i *CIBuildRunResponseIncluded) SCMPullRequest() *SCMPullRequest {
+	return extractIncludedSCMPullRequest(i.inner)
+}
@@ -0,0 +1,106 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCIBuildRunsForWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIBuildRunsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListCIBuildRunsForWorkflow(ctx, "10", &ListCIBuildRunsQuery{})
+	})
+}
+
+func TestListCIBuildRunsForProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIBuildRunsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListCIBuildRunsForProduct(ctx, "10", &ListCIBuildRunsQuery{})
+	})
+}
+
+func TestGetCIBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIBuildRun(ctx, "10", &GetCIBuildRunQuery{})
+	})
+}
+
+func TestStartCIBuildRunForGitReference(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.StartCIBuildRunForGitReference(ctx, "10", "20", Bool(true))
+	})
+}
+
+func TestStartCIBuildRunForPullRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.StartCIBuildRunForPullRequest(ctx, "10", "20", nil)
+	})
+}
+
+func TestRerunCIBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.RerunCIBuildRun(ctx, "10", Bool(false))
+	})
+}
+
+func TestListBuildsForCIBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BuildsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListBuildsForCIBuildRun(ctx, "10", &ListBuildsQuery{})
+	})
+}
+
+func TestGetCIBuildRunIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"builds"},{"type":"ciProducts"},{"type":"ciWorkflows"},{"type":"scmGitReferences"},{"type":"scmPullRequests"}]}`, func(ctx context.Context, client *Client) {
+		run, _, err := client.CI.GetCIBuildRun(ctx, "10", &GetCIBuildRunQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, run.Included)
+
+		assert.NotNil(t, run.Included[0].Build())
+		assert.NotNil(t, run.Included[1].CIProduct())
+		assert.NotNil(t, run.Included[2].CIWorkflow())
+		assert.NotNil(t, run.Included[3].SCMGitReference())
+		assert.NotNil(t, run.Included[4].SCMPullRequest())
+
+		assert.Nil(t, run.Included[0].CIProduct())
+		assert.Nil(t, run.Included[1].CIWorkflow())
+		assert.Nil(t, run.Included[2].SCMGitReference())
+		assert.Nil(t, run.Included[3].SCMPullRequest())
+		assert.Nil(t, run.Included[4].Build())
+	})
+}
@@ -0,0 +1,117 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CIIssueType defines model for CiIssue.Attributes.IssueType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissue/attributes
+type CIIssueType string
+
+const (
+	// CIIssueTypeAnalyzerWarning is an issue type for AnalyzerWarning.
+	CIIssueTypeAnalyzerWarning CIIssueType = "ANALYZER_WARNING"
+	// CIIssueTypeError is an issue type for Error.
+	CIIssueTypeError CIIssueType = "ERROR"
+	// CIIssueTypeTestFailure is an issue type for TestFailure.
+	CIIssueTypeTestFailure CIIssueType = "TEST_FAILURE"
+	// CIIssueTypeWarning is an issue type for Warning.
+	CIIssueTypeWarning CIIssueType = "WARNING"
+)
+
+// CIIssue defines model for CiIssue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissue
+type CIIssue struct {
+	Attributes *CIIssueAttributes `json:"attributes,omitempty"`
+	ID         string             `json:"id"`
+	Links      ResourceLinks      `json:"links"`
+	Type       string             `json:"type"`
+}
+
+// CIIssueAttributes defines model for CiIssue.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissue/attributes
+type CIIssueAttributes struct {
+	Category   *string       `json:"category,omitempty"`
+	FileSource *FileLocation `json:"fileSource,omitempty"`
+	IssueType  *CIIssueType  `json:"issueType,omitempty"`
+	Message    *string       `json:"message,omitempty"`
+}
+
+// CIIssueResponse defines model for CiIssueResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissueresponse
+type CIIssueResponse struct {
+	Data  CIIssue       `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// CIIssuesResponse defines model for CiIssuesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissuesresponse
+type CIIssuesResponse struct {
+	Data  []CIIssue          `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// GetCIIssueQuery are query options for GetCIIssue
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_issue_information
+type GetCIIssueQuery struct {
+	FieldsCIIssues []string `url:"fields[ciIssues],omitempty"`
+}
+
+// ListIssuesForCIBuildActionQuery are query options for ListIssuesForCIBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_issues_for_a_build_action
+type ListIssuesForCIBuildActionQuery struct {
+	FieldsCIIssues []string `url:"fields[ciIssues],omitempty"`
+	Limit          int      `url:"limit,omitempty"`
+	Cursor         string   `url:"cursor,omitempty"`
+}
+
+// ListIssuesForCIBuildAction lists the errors, analyzer warnings, and test failures a build action produced.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_issues_for_a_build_action
+func (s *CIService) ListIssuesForCIBuildAction(ctx context.Context, id string, params *ListIssuesForCIBuildActionQuery) (*CIIssuesResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildActions/%s/issues", id)
+	res := new(CIIssuesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCIIssue gets information about a specific issue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_issue_information
+func (s *CIService) GetCIIssue(ctx context.Context, id string, params *GetCIIssueQuery) (*CIIssueResponse, *Response, error) {
+	url := fmt.Sprintf("ciIssues/%s", id)
+	res := new(CIIssueResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
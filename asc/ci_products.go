@@ -0,0 +1,197 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CIProductType defines model for CiProduct.Attributes.ProductType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct/attributes
+type CIProductType string
+
+const (
+	// CIProductTypeApp is a CI product type for App.
+	CIProductTypeApp CIProductType = "APP"
+	// CIProductTypeFramework is a CI product type for Framework.
+	CIProductTypeFramework CIProductType = "FRAMEWORK"
+)
+
+// CIProduct defines model for CiProduct.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct
+type CIProduct struct {
+	Attributes    *CIProductAttributes    `json:"attributes,omitempty"`
+	ID            string                  `json:"id"`
+	Links         ResourceLinks           `json:"links"`
+	Relationships *CIProductRelationships `json:"relationships,omitempty"`
+	Type          string                  `json:"type"`
+}
+
+// CIProductAttributes defines model for CiProduct.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct/attributes
+type CIProductAttributes struct {
+	CreatedDate *DateTime      `json:"createdDate,omitempty"`
+	Name        *string        `json:"name,omitempty"`
+	ProductType *CIProductType `json:"productType,omitempty"`
+}
+
+// CIProductRelationships defines model for CiProduct.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct/relationships
+type CIProductRelationships struct {
+	AdditionalRepositories *PagedRelationship `json:"additionalRepositories,omitempty"`
+	App                    *Relationship      `json:"app,omitempty"`
+	BuildRuns              *PagedRelationship `json:"buildRuns,omitempty"`
+	BundleID               *Relationship      `json:"bundleId,omitempty"`
+	PrimaryRepositories    *PagedRelationship `json:"primaryRepositories,omitempty"`
+	Workflows              *PagedRelationship `json:"workflows,omitempty"`
+}
+
+// CIProductResponse defines model for CiProductResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproductresponse
+type CIProductResponse struct {
+	Data     CIProduct                   `json:"data"`
+	Included []CIProductResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks               `json:"links"`
+}
+
+// CIProductsResponse defines model for CiProductsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproductsresponse
+type CIProductsResponse struct {
+	Data     []CIProduct                 `json:"data"`
+	Included []CIProductResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks          `json:"links"`
+	Meta     *PagingInformation          `json:"meta,omitempty"`
+}
+
+// CIProductResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CIProductResponse or CIProductsResponse.
+type CIProductResponseIncluded included
+
+// ListCIProductsQuery are query options for ListCIProducts
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_xcode_cloud_products
+type ListCIProductsQuery struct {
+	FieldsApps               []string `url:"fields[apps],omitempty"`
+	FieldsBundleIDs          []string `url:"fields[bundleIds],omitempty"`
+	FieldsCIProducts         []string `url:"fields[ciProducts],omitempty"`
+	FieldsSCMRepositories    []string `url:"fields[scmRepositories],omitempty"`
+	FilterApp                []string `url:"filter[app],omitempty"`
+	FilterProductType        []string `url:"filter[productType],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	Limit                    int      `url:"limit,omitempty"`
+	LimitPrimaryRepositories int      `url:"limit[primaryRepositories],omitempty"`
+	Cursor                   string   `url:"cursor,omitempty"`
+}
+
+// GetCIProductQuery are query options for GetCIProduct and GetCIProductForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_product_information
+type GetCIProductQuery struct {
+	FieldsApps               []string `url:"fields[apps],omitempty"`
+	FieldsBundleIDs          []string `url:"fields[bundleIds],omitempty"`
+	FieldsCIProducts         []string `url:"fields[ciProducts],omitempty"`
+	FieldsSCMRepositories    []string `url:"fields[scmRepositories],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	LimitPrimaryRepositories int      `url:"limit[primaryRepositories],omitempty"`
+}
+
+// ListCIProducts lists the apps and frameworks that are set up with Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_xcode_cloud_products
+func (s *CIService) ListCIProducts(ctx context.Context, params *ListCIProductsQuery) (*CIProductsResponse, *Response, error) {
+	res := new(CIProductsResponse)
+	resp, err := s.client.get(ctx, "ciProducts", params, res)
+
+	return res, resp, err
+}
+
+// GetCIProduct gets information about a specific Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_product_information
+func (s *CIService) GetCIProduct(ctx context.Context, id string, params *GetCIProductQuery) (*CIProductResponse, *Response, error) {
+	url := fmt.Sprintf("ciProducts/%s", id)
+	res := new(CIProductResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCIProductForApp gets the Xcode Cloud product of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_xcode_cloud_product_for_an_app
+func (s *CIService) GetCIProductForApp(ctx context.Context, id string, params *GetCIProductQuery) (*CIProductResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/ciProduct", id)
+	res := new(CIProductResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// DeleteCIProduct removes Xcode Cloud from an app or framework, along with its workflows and build data.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_xcode_cloud_product
+func (s *CIService) DeleteCIProduct(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("ciProducts/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListPrimaryRepositoriesForCIProduct lists the source code repositories that contain the product's workflows.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_primary_repositories_for_an_xcode_cloud_product
+func (s *CIService) ListPrimaryRepositoriesForCIProduct(ctx context.Context, id string, params *ListSCMRepositoriesQuery) (*SCMRepositoriesResponse, *Response, error) {
+	url := fmt.Sprintf("ciProducts/%s/primaryRepositories", id)
+	res := new(SCMRepositoriesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CIProductResponseIncluded.
+func (i *CIProductResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *CIProductResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// BundleID returns the BundleID stored within, if one is present.
+func (i *CIProductResponseIncluded) BundleID() *BundleID {
+	return extractIncludedBundleID(i.inner)
+}
+
+// SCMRepository returns the SCMRepository stored within, if one is present.
+func (i *CIProductResponseIncluded) SCMRepository() *SCMRepository {
+	return extractIncludedSCMRepository(i.inner)
+}
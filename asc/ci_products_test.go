@@ -0,0 +1,86 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCIProducts(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIProductsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListCIProducts(ctx, &ListCIProductsQuery{})
+	})
+}
+
+func TestGetCIProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIProductResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIProduct(ctx, "10", &GetCIProductQuery{})
+	})
+}
+
+func TestGetCIProductForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIProductResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIProductForApp(ctx, "10", &GetCIProductQuery{})
+	})
+}
+
+func TestDeleteCIProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.CI.DeleteCIProduct(ctx, "10")
+	})
+}
+
+func TestListPrimaryRepositoriesForCIProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListPrimaryRepositoriesForCIProduct(ctx, "10", &ListSCMRepositoriesQuery{})
+	})
+}
+
+func TestGetCIProductIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"bundleIds"},{"type":"scmRepositories"}]}`, func(ctx context.Context, client *Client) {
+		product, _, err := client.CI.GetCIProduct(ctx, "10", &GetCIProductQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, product.Included)
+
+		assert.NotNil(t, product.Included[0].App())
+		assert.NotNil(t, product.Included[1].BundleID())
+		assert.NotNil(t, product.Included[2].SCMRepository())
+
+		assert.Nil(t, product.Included[0].BundleID())
+		assert.Nil(t, product.Included[1].SCMRepository())
+		assert.Nil(t, product.Included[2].App())
+	})
+}
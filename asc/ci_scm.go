@@ -0,0 +1,438 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SCMProviderType defines model for ScmProviderType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovidertype
+type SCMProviderType struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	IsOnPremise *bool   `json:"isOnPremise,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+}
+
+// SCMGitReferenceKind defines model for CiGitRefKind.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cigitrefkind
+type SCMGitReferenceKind string
+
+const (
+	// SCMGitReferenceKindBranch is a git reference kind for Branch.
+	SCMGitReferenceKindBranch SCMGitReferenceKind = "BRANCH"
+	// SCMGitReferenceKindTag is a git reference kind for Tag.
+	SCMGitReferenceKindTag SCMGitReferenceKind = "TAG"
+)
+
+// SCMProvider defines model for ScmProvider.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovider
+type SCMProvider struct {
+	Attributes    *SCMProviderAttributes    `json:"attributes,omitempty"`
+	ID            string                    `json:"id"`
+	Links         ResourceLinks             `json:"links"`
+	Relationships *SCMProviderRelationships `json:"relationships,omitempty"`
+	Type          string                    `json:"type"`
+}
+
+// SCMProviderAttributes defines model for ScmProvider.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovider/attributes
+type SCMProviderAttributes struct {
+	SCMProviderType *SCMProviderType `json:"scmProviderType,omitempty"`
+	URL             *string          `json:"url,omitempty"`
+}
+
+// SCMProviderRelationships defines model for ScmProvider.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovider/relationships
+type SCMProviderRelationships struct {
+	Repositories *PagedRelationship `json:"repositories,omitempty"`
+}
+
+// SCMProviderResponse defines model for ScmProviderResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmproviderresponse
+type SCMProviderResponse struct {
+	Data  SCMProvider   `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// SCMProvidersResponse defines model for ScmProvidersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovidersresponse
+type SCMProvidersResponse struct {
+	Data  []SCMProvider      `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// SCMRepository defines model for ScmRepository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepository
+type SCMRepository struct {
+	Attributes    *SCMRepositoryAttributes    `json:"attributes,omitempty"`
+	ID            string                      `json:"id"`
+	Links         ResourceLinks               `json:"links"`
+	Relationships *SCMRepositoryRelationships `json:"relationships,omitempty"`
+	Type          string                      `json:"type"`
+}
+
+// SCMRepositoryAttributes defines model for ScmRepository.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepository/attributes
+type SCMRepositoryAttributes struct {
+	HTTPCloneURL     *string   `json:"httpCloneUrl,omitempty"`
+	LastAccessedDate *DateTime `json:"lastAccessedDate,omitempty"`
+	OwnerName        *string   `json:"ownerName,omitempty"`
+	RepositoryName   *string   `json:"repositoryName,omitempty"`
+	SSHCloneURL      *string   `json:"sshCloneUrl,omitempty"`
+}
+
+// SCMRepositoryRelationships defines model for ScmRepository.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepository/relationships
+type SCMRepositoryRelationships struct {
+	DefaultBranch *Relationship      `json:"defaultBranch,omitempty"`
+	GitReferences *PagedRelationship `json:"gitReferences,omitempty"`
+	PullRequests  *PagedRelationship `json:"pullRequests,omitempty"`
+	SCMProvider   *Relationship      `json:"scmProvider,omitempty"`
+}
+
+// SCMRepositoryResponse defines model for ScmRepositoryResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepositoryresponse
+type SCMRepositoryResponse struct {
+	Data     SCMRepository                   `json:"data"`
+	Included []SCMRepositoryResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                   `json:"links"`
+}
+
+// SCMRepositoriesResponse defines model for ScmRepositoriesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepositoriesresponse
+type SCMRepositoriesResponse struct {
+	Data     []SCMRepository                 `json:"data"`
+	Included []SCMRepositoryResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks              `json:"links"`
+	Meta     *PagingInformation              `json:"meta,omitempty"`
+}
+
+// SCMRepositoryResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SCMRepositoryResponse or SCMRepositoriesResponse.
+type SCMRepositoryResponseIncluded included
+
+// SCMGitReference defines model for ScmGitReference.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreference
+type SCMGitReference struct {
+	Attributes    *SCMGitReferenceAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *SCMGitReferenceRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// SCMGitReferenceAttributes defines model for ScmGitReference.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreference/attributes
+type SCMGitReferenceAttributes struct {
+	CanonicalName *string              `json:"canonicalName,omitempty"`
+	IsDeleted     *bool                `json:"isDeleted,omitempty"`
+	Kind          *SCMGitReferenceKind `json:"kind,omitempty"`
+	Name          *string              `json:"name,omitempty"`
+}
+
+// SCMGitReferenceRelationships defines model for ScmGitReference.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreference/relationships
+type SCMGitReferenceRelationships struct {
+	Repository *Relationship `json:"repository,omitempty"`
+}
+
+// SCMGitReferenceResponse defines model for ScmGitReferenceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreferenceresponse
+type SCMGitReferenceResponse struct {
+	Data     SCMGitReference `json:"data"`
+	Included []SCMRepository `json:"included,omitempty"`
+	Links    DocumentLinks   `json:"links"`
+}
+
+// SCMGitReferencesResponse defines model for ScmGitReferencesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreferencesresponse
+type SCMGitReferencesResponse struct {
+	Data     []SCMGitReference  `json:"data"`
+	Included []SCMRepository    `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// SCMPullRequest defines model for ScmPullRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequest
+type SCMPullRequest struct {
+	Attributes    *SCMPullRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *SCMPullRequestRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// SCMPullRequestAttributes defines model for ScmPullRequest.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequest/attributes
+type SCMPullRequestAttributes struct {
+	DestinationBranchName      *string `json:"destinationBranchName,omitempty"`
+	DestinationRepositoryName  *string `json:"destinationRepositoryName,omitempty"`
+	DestinationRepositoryOwner *string `json:"destinationRepositoryOwner,omitempty"`
+	IsClosed                   *bool   `json:"isClosed,omitempty"`
+	IsCrossRepository          *bool   `json:"isCrossRepository,omitempty"`
+	Number                     *int    `json:"number,omitempty"`
+	SourceBranchName           *string `json:"sourceBranchName,omitempty"`
+	SourceRepositoryName       *string `json:"sourceRepositoryName,omitempty"`
+	SourceRepositoryOwner      *string `json:"sourceRepositoryOwner,omitempty"`
+	Title                      *string `json:"title,omitempty"`
+	WebURL                     *string `json:"webUrl,omitempty"`
+}
+
+// SCMPullRequestRelationships defines model for ScmPullRequest.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequest/relationships
+type SCMPullRequestRelationships struct {
+	Repository *Relationship `json:"repository,omitempty"`
+}
+
+// SCMPullRequestResponse defines model for ScmPullRequestResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequestresponse
+type SCMPullRequestResponse struct {
+	Data     SCMPullRequest  `json:"data"`
+	Included []SCMRepository `json:"included,omitempty"`
+	Links    DocumentLinks   `json:"links"`
+}
+
+// SCMPullRequestsResponse defines model for ScmPullRequestsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequestsresponse
+type SCMPullRequestsResponse struct {
+	Data     []SCMPullRequest   `json:"data"`
+	Included []SCMRepository    `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListSCMProvidersQuery are query options for ListSCMProviders
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_source_code_management_providers
+type ListSCMProvidersQuery struct {
+	FieldsSCMProviders []string `url:"fields[scmProviders],omitempty"`
+	Limit              int      `url:"limit,omitempty"`
+	Cursor             string   `url:"cursor,omitempty"`
+}
+
+// GetSCMProviderQuery are query options for GetSCMProvider
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_source_code_management_provider_information
+type GetSCMProviderQuery struct {
+	FieldsSCMProviders []string `url:"fields[scmProviders],omitempty"`
+}
+
+// ListSCMRepositoriesQuery are query options for ListSCMRepositories, ListRepositoriesForSCMProvider
+// and ListPrimaryRepositoriesForCIProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_repositories
+type ListSCMRepositoriesQuery struct {
+	FieldsSCMGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsSCMProviders     []string `url:"fields[scmProviders],omitempty"`
+	FieldsSCMRepositories  []string `url:"fields[scmRepositories],omitempty"`
+	FilterID               []string `url:"filter[id],omitempty"`
+	Include                []string `url:"include,omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// GetSCMRepositoryQuery are query options for GetSCMRepository and GetRepositoryForCIWorkflow
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_repository_information
+type GetSCMRepositoryQuery struct {
+	FieldsSCMGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsSCMProviders     []string `url:"fields[scmProviders],omitempty"`
+	FieldsSCMRepositories  []string `url:"fields[scmRepositories],omitempty"`
+	Include                []string `url:"include,omitempty"`
+}
+
+// ListGitReferencesForSCMRepositoryQuery are query options for ListGitReferencesForSCMRepository
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_git_references_for_a_repository
+type ListGitReferencesForSCMRepositoryQuery struct {
+	FieldsSCMGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// ListPullRequestsForSCMRepositoryQuery are query options for ListPullRequestsForSCMRepository
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_pull_requests_for_a_repository
+type ListPullRequestsForSCMRepositoryQuery struct {
+	FieldsSCMPullRequests []string `url:"fields[scmPullRequests],omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// GetSCMGitReferenceQuery are query options for GetSCMGitReference
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_git_reference_information
+type GetSCMGitReferenceQuery struct {
+	FieldsSCMGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsSCMRepositories  []string `url:"fields[scmRepositories],omitempty"`
+	Include                []string `url:"include,omitempty"`
+}
+
+// GetSCMPullRequestQuery are query options for GetSCMPullRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_pull_request_information
+type GetSCMPullRequestQuery struct {
+	FieldsSCMPullRequests []string `url:"fields[scmPullRequests],omitempty"`
+	FieldsSCMRepositories []string `url:"fields[scmRepositories],omitempty"`
+	Include               []string `url:"include,omitempty"`
+}
+
+// ListSCMProviders lists the source code management providers connected to Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_source_code_management_providers
+func (s *CIService) ListSCMProviders(ctx context.Context, params *ListSCMProvidersQuery) (*SCMProvidersResponse, *Response, error) {
+	res := new(SCMProvidersResponse)
+	resp, err := s.client.get(ctx, "scmProviders", params, res)
+
+	return res, resp, err
+}
+
+// GetSCMProvider gets information about a specific source code management provider.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_source_code_management_provider_information
+func (s *CIService) GetSCMProvider(ctx context.Context, id string, params *GetSCMProviderQuery) (*SCMProviderResponse, *Response, error) {
+	url := fmt.Sprintf("scmProviders/%s", id)
+	res := new(SCMProviderResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListRepositoriesForSCMProvider lists the repositories of a source code management provider.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_repositories_for_a_source_code_management_provider
+func (s *CIService) ListRepositoriesForSCMProvider(ctx context.Context, id string, params *ListSCMRepositoriesQuery) (*SCMRepositoriesResponse, *Response, error) {
+	url := fmt.Sprintf("scmProviders/%s/repositories", id)
+	res := new(SCMRepositoriesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListSCMRepositories lists all repositories connected to Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_repositories
+func (s *CIService) ListSCMRepositories(ctx context.Context, params *ListSCMRepositoriesQuery) (*SCMRepositoriesResponse, *Response, error) {
+	res := new(SCMRepositoriesResponse)
+	resp, err := s.client.get(ctx, "scmRepositories", params, res)
+
+	return res, resp, err
+}
+
+// GetSCMRepository gets information about a specific repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_repository_information
+func (s *CIService) GetSCMRepository(ctx context.Context, id string, params *GetSCMRepositoryQuery) (*SCMRepositoryResponse, *Response, error) {
+	url := fmt.Sprintf("scmRepositories/%s", id)
+	res := new(SCMRepositoryResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListGitReferencesForSCMRepository lists the branches and tags of a repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_git_references_for_a_repository
+func (s *CIService) ListGitReferencesForSCMRepository(ctx context.Context, id string, params *ListGitReferencesForSCMRepositoryQuery) (*SCMGitReferencesResponse, *Response, error) {
+	url := fmt.Sprintf("scmRepositories/%s/gitReferences", id)
+	res := new(SCMGitReferencesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListPullRequestsForSCMRepository lists the pull requests of a repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_pull_requests_for_a_repository
+func (s *CIService) ListPullRequestsForSCMRepository(ctx context.Context, id string, params *ListPullRequestsForSCMRepositoryQuery) (*SCMPullRequestsResponse, *Response, error) {
+	url := fmt.Sprintf("scmRepositories/%s/pullRequests", id)
+	res := new(SCMPullRequestsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSCMGitReference gets information about a specific branch or tag.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_git_reference_information
+func (s *CIService) GetSCMGitReference(ctx context.Context, id string, params *GetSCMGitReferenceQuery) (*SCMGitReferenceResponse, *Response, error) {
+	url := fmt.Sprintf("scmGitReferences/%s", id)
+	res := new(SCMGitReferenceResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSCMPullRequest gets information about a specific pull request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_pull_request_information
+func (s *CIService) GetSCMPullRequest(ctx context.Context, id string, params *GetSCMPullRequestQuery) (*SCMPullRequestResponse, *Response, error) {
+	url := fmt.Sprintf("scmPullRequests/%s", id)
+	res := new(SCMPullRequestResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SCMRepositoryResponseIncluded.
+func (i *SCMRepositoryResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// SCMGitReference returns the SCMGitReference stored within, if one is present.
+func (i *SCMRepositoryResponseIncluded) SCMGitReference() *SCMGitReference {
+	return extractIncludedSCMGitReference(i.inner)
+}
+
+// SCMProvider returns the SCMProvider stored within, if one is present.
+func (i *SCMRepositoryResponseIncluded) SCMProvider() *SCMProvider {
+	return extractIncludedSCMProvider(i.inner)
+}
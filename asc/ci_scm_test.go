@@ -0,0 +1,116 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSCMProviders(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMProvidersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListSCMProviders(ctx, &ListSCMProvidersQuery{})
+	})
+}
+
+func TestGetSCMProvider(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMProviderResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetSCMProvider(ctx, "10", &GetSCMProviderQuery{})
+	})
+}
+
+func TestListRepositoriesForSCMProvider(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListRepositoriesForSCMProvider(ctx, "10", &ListSCMRepositoriesQuery{})
+	})
+}
+
+func TestListSCMRepositories(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListSCMRepositories(ctx, &ListSCMRepositoriesQuery{})
+	})
+}
+
+func TestGetSCMRepository(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMRepositoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetSCMRepository(ctx, "10", &GetSCMRepositoryQuery{})
+	})
+}
+
+func TestListGitReferencesForSCMRepository(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMGitReferencesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListGitReferencesForSCMRepository(ctx, "10", &ListGitReferencesForSCMRepositoryQuery{})
+	})
+}
+
+func TestListPullRequestsForSCMRepository(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMPullRequestsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListPullRequestsForSCMRepository(ctx, "10", &ListPullRequestsForSCMRepositoryQuery{})
+	})
+}
+
+func TestGetSCMGitReference(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMGitReferenceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetSCMGitReference(ctx, "10", &GetSCMGitReferenceQuery{})
+	})
+}
+
+func TestGetSCMPullRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMPullRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetSCMPullRequest(ctx, "10", &GetSCMPullRequestQuery{})
+	})
+}
+
+func TestGetSCMRepositoryIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"scmGitReferences"},{"type":"scmProviders"}]}`, func(ctx context.Context, client *Client) {
+		repository, _, err := client.CI.GetSCMRepository(ctx, "10", &GetSCMRepositoryQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, repository.Included)
+
+		assert.NotNil(t, repository.Included[0].SCMGitReference())
+		assert.NotNil(t, repository.Included[1].SCMProvider())
+
+		assert.Nil(t, repository.Included[0].SCMProvider())
+		assert.Nil(t, repository.Included[1].SCMGitReference())
+	})
+}
@@ -0,0 +1,132 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CITestStatus defines model for CiTestStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citeststatus
+type CITestStatus string
+
+const (
+	// CITestStatusSuccess is a test status for Success.
+	CITestStatusSuccess CITestStatus = "SUCCESS"
+	// CITestStatusFailure is a test status for Failure.
+	CITestStatusFailure CITestStatus = "FAILURE"
+	// CITestStatusMixed is a test status for Mixed.
+	CITestStatusMixed CITestStatus = "MIXED"
+	// CITestStatusSkipped is a test status for Skipped.
+	CITestStatusSkipped CITestStatus = "SKIPPED"
+	// CITestStatusExpectedFailure is a test status for ExpectedFailure.
+	CITestStatusExpectedFailure CITestStatus = "EXPECTED_FAILURE"
+)
+
+// CITestResult defines model for CiTestResult.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresult
+type CITestResult struct {
+	Attributes *CITestResultAttributes `json:"attributes,omitempty"`
+	ID         string                  `json:"id"`
+	Links      ResourceLinks           `json:"links"`
+	Type       string                  `json:"type"`
+}
+
+// CITestResultAttributes defines model for CiTestResult.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresult/attributes
+type CITestResultAttributes struct {
+	ClassName              *string                   `json:"className,omitempty"`
+	DestinationTestResults []CITestDestinationResult `json:"destinationTestResults,omitempty"`
+	FileSource             *FileLocation             `json:"fileSource,omitempty"`
+	Message                *string                   `json:"message,omitempty"`
+	Name                   *string                   `json:"name,omitempty"`
+	Status                 *CITestStatus             `json:"status,omitempty"`
+}
+
+// CITestDestinationResult defines model for CiTestResult.Attributes.DestinationTestResults
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresult/attributes/destinationtestresults
+type CITestDestinationResult struct {
+	DeviceName *string       `json:"deviceName,omitempty"`
+	Duration   *float64      `json:"duration,omitempty"`
+	OSVersion  *string       `json:"osVersion,omitempty"`
+	Status     *CITestStatus `json:"status,omitempty"`
+	UUID       *string       `json:"uuid,omitempty"`
+}
+
+// CITestResultResponse defines model for CiTestResultResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresultresponse
+type CITestResultResponse struct {
+	Data  CITestResult  `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// CITestResultsResponse defines model for CiTestResultsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresultsresponse
+type CITestResultsResponse struct {
+	Data  []CITestResult     `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// GetCITestResultQuery are query options for GetCITestResult
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_test_result_information
+type GetCITestResultQuery struct {
+	FieldsCITestResults []string `url:"fields[ciTestResults],omitempty"`
+}
+
+// ListTestResultsForCIBuildActionQuery are query options for ListTestResultsForCIBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_test_results_for_a_build_action
+type ListTestResultsForCIBuildActionQuery struct {
+	FieldsCITestResults []string `url:"fields[ciTestResults],omitempty"`
+	Limit               int      `url:"limit,omitempty"`
+	Cursor              string   `url:"cursor,omitempty"`
+}
+
+// ListTestResultsForCIBuildAction lists the test results a build action produced.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_test_results_for_a_build_action
+func (s *CIService) ListTestResultsForCIBuildAction(ctx context.Context, id string, params *ListTestResultsForCIBuildActionQuery) (*CITestResultsResponse, *Response, error) {
+	url := fmt.Sprintf("ciBuildActions/%s/testResults", id)
+	res := new(CITestResultsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCITestResult gets information about a specific test result.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_test_result_information
+func (s *CIService) GetCITestResult(ctx context.Context, id string, params *GetCITestResultQuery) (*CITestResultResponse, *Response, error) {
+	url := fmt.Sprintf("ciTestResults/%s", id)
+	res := new(CITestResultResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,243 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CIXcodeVersion defines model for CiXcodeVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion
+type CIXcodeVersion struct {
+	Attributes    *CIXcodeVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *CIXcodeVersionRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// CIXcodeVersionAttributes defines model for CiXcodeVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/attributes
+type CIXcodeVersionAttributes struct {
+	Name             *string                         `json:"name,omitempty"`
+	TestDestinations []CIXcodeVersionTestDestination `json:"testDestinations,omitempty"`
+	Version          *string                         `json:"version,omitempty"`
+}
+
+// CIXcodeVersionTestDestination defines model for CiXcodeVersion.Attributes.TestDestinations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/attributes/testdestinations
+type CIXcodeVersionTestDestination struct {
+	AvailableRuntimes    []CITestDestinationRuntime `json:"availableRuntimes,omitempty"`
+	DeviceTypeIdentifier *string                    `json:"deviceTypeIdentifier,omitempty"`
+	DeviceTypeName       *string                    `json:"deviceTypeName,omitempty"`
+	Kind                 *string                    `json:"kind,omitempty"`
+}
+
+// CITestDestinationRuntime defines model for CiXcodeVersion.Attributes.TestDestinations.AvailableRuntimes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/attributes/testdestinations/availableruntimes
+type CITestDestinationRuntime struct {
+	RuntimeIdentifier *string `json:"runtimeIdentifier,omitempty"`
+	RuntimeName       *string `json:"runtimeName,omitempty"`
+}
+
+// CIXcodeVersionRelationships defines model for CiXcodeVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/relationships
+type CIXcodeVersionRelationships struct {
+	MacOSVersions *PagedRelationship `json:"macOsVersions,omitempty"`
+}
+
+// CIXcodeVersionResponse defines model for CiXcodeVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversionresponse
+type CIXcodeVersionResponse struct {
+	Data     CIXcodeVersion   `json:"data"`
+	Included []CIMacOSVersion `json:"included,omitempty"`
+	Links    DocumentLinks    `json:"links"`
+}
+
+// CIXcodeVersionsResponse defines model for CiXcodeVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversionsresponse
+type CIXcodeVersionsResponse struct {
+	Data     []CIXcodeVersion   `json:"data"`
+	Included []CIMacOSVersion   `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// CIMacOSVersion defines model for CiMacOsVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversion
+type CIMacOSVersion struct {
+	Attributes    *CIMacOSVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *CIMacOSVersionRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// CIMacOSVersionAttributes defines model for CiMacOsVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversion/attributes
+type CIMacOSVersionAttributes struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// CIMacOSVersionRelationships defines model for CiMacOsVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversion/relationships
+type CIMacOSVersionRelationships struct {
+	XcodeVersions *PagedRelationship `json:"xcodeVersions,omitempty"`
+}
+
+// CIMacOSVersionResponse defines model for CiMacOsVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversionresponse
+type CIMacOSVersionResponse struct {
+	Data     CIMacOSVersion   `json:"data"`
+	Included []CIXcodeVersion `json:"included,omitempty"`
+	Links    DocumentLinks    `json:"links"`
+}
+
+// CIMacOSVersionsResponse defines model for CiMacOsVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversionsresponse
+type CIMacOSVersionsResponse struct {
+	Data     []CIMacOSVersion   `json:"data"`
+	Included []CIXcodeVersion   `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListCIXcodeVersionsQuery are query options for ListCIXcodeVersions and ListXcodeVersionsForCIMacOSVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_available_in_xcode_cloud
+type ListCIXcodeVersionsQuery struct {
+	FieldsCIMacOSVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCIXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	LimitMacOSVersions    int      `url:"limit[macOsVersions],omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// GetCIXcodeVersionQuery are query options for GetCIXcodeVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_version_information
+type GetCIXcodeVersionQuery struct {
+	FieldsCIMacOSVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCIXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	LimitMacOSVersions    int      `url:"limit[macOsVersions],omitempty"`
+}
+
+// ListCIMacOSVersionsQuery are query options for ListCIMacOSVersions and ListMacOSVersionsForCIXcodeVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_in_xcode_cloud
+type ListCIMacOSVersionsQuery struct {
+	FieldsCIMacOSVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCIXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	LimitXcodeVersions    int      `url:"limit[xcodeVersions],omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// GetCIMacOSVersionQuery are query options for GetCIMacOSVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_macos_version_information
+type GetCIMacOSVersionQuery struct {
+	FieldsCIMacOSVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCIXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	LimitXcodeVersions    int      `url:"limit[xcodeVersions],omitempty"`
+}
+
+// ListCIXcodeVersions lists the Xcode versions available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_available_in_xcode_cloud
+func (s *CIService) ListCIXcodeVersions(ctx context.Context, params *ListCIXcodeVersionsQuery) (*CIXcodeVersionsResponse, *Response, error) {
+	res := new(CIXcodeVersionsResponse)
+	resp, err := s.client.get(ctx, "ciXcodeVersions", params, res)
+
+	return res, resp, err
+}
+
+// GetCIXcodeVersion gets information about a specific Xcode version available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_version_information
+func (s *CIService) GetCIXcodeVersion(ctx context.Context, id string, params *GetCIXcodeVersionQuery) (*CIXcodeVersionResponse, *Response, error) {
+	url := fmt.Sprintf("ciXcodeVersions/%s", id)
+	res := new(CIXcodeVersionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListMacOSVersionsForCIXcodeVersion lists the macOS versions an Xcode version can run on in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_for_an_xcode_version
+func (s *CIService) ListMacOSVersionsForCIXcodeVersion(ctx context.Context, id string, params *ListCIMacOSVersionsQuery) (*CIMacOSVersionsResponse, *Response, error) {
+	url := fmt.Sprintf("ciXcodeVersions/%s/macOsVersions", id)
+	res := new(CIMacOSVersionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListCIMacOSVersions lists the macOS versions available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_in_xcode_cloud
+func (s *CIService) ListCIMacOSVersions(ctx context.Context, params *ListCIMacOSVersionsQuery) (*CIMacOSVersionsResponse, *Response, error) {
+	res := new(CIMacOSVersionsResponse)
+	resp, err := s.client.get(ctx, "ciMacOsVersions", params, res)
+
+	return res, resp, err
+}
+
+// GetCIMacOSVersion gets information about a specific macOS version available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_macos_version_information
+func (s *CIService) GetCIMacOSVersion(ctx context.Context, id string, params *GetCIMacOSVersionQuery) (*CIMacOSVersionResponse, *Response, error) {
+	url := fmt.Sprintf("ciMacOsVersions/%s", id)
+	res := new(CIMacOSVersionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListXcodeVersionsForCIMacOSVersion lists the Xcode versions available on a macOS version in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_for_a_macos_version
+func (s *CIService) ListXcodeVersionsForCIMacOSVersion(ctx context.Context, id string, params *ListCIXcodeVersionsQuery) (*CIXcodeVersionsResponse, *Response, error) {
+	url := fmt.Sprintf("ciMacOsVersions/%s/xcodeVersions", id)
+	res := new(CIXcodeVersionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,74 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListCIXcodeVersions(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIXcodeVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListCIXcodeVersions(ctx, &ListCIXcodeVersionsQuery{})
+	})
+}
+
+func TestGetCIXcodeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIXcodeVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIXcodeVersion(ctx, "10", &GetCIXcodeVersionQuery{})
+	})
+}
+
+func TestListMacOSVersionsForCIXcodeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIMacOSVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListMacOSVersionsForCIXcodeVersion(ctx, "10", &ListCIMacOSVersionsQuery{})
+	})
+}
+
+func TestListCIMacOSVersions(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIMacOSVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListCIMacOSVersions(ctx, &ListCIMacOSVersionsQuery{})
+	})
+}
+
+func TestGetCIMacOSVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIMacOSVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIMacOSVersion(ctx, "10", &GetCIMacOSVersionQuery{})
+	})
+}
+
+func TestListXcodeVersionsForCIMacOSVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIXcodeVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListXcodeVersionsForCIMacOSVersion(ctx, "10", &ListCIXcodeVersionsQuery{})
+	})
+}
@@ -0,0 +1,450 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CIAction defines model for CiAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciaction
+type CIAction struct {
+	ActionType                *CIActionType        `json:"actionType,omitempty"`
+	BuildDistributionAudience *string              `json:"buildDistributionAudience,omitempty"`
+	Destination               *string              `json:"destination,omitempty"`
+	IsRequiredToPass          *bool                `json:"isRequiredToPass,omitempty"`
+	Name                      *string              `json:"name,omitempty"`
+	Platform                  *string              `json:"platform,omitempty"`
+	Scheme                    *string              `json:"scheme,omitempty"`
+	TestConfiguration         *CITestConfiguration `json:"testConfiguration,omitempty"`
+}
+
+// CITestConfiguration defines model for CiAction.TestConfiguration
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciaction/testconfiguration
+type CITestConfiguration struct {
+	Kind             *string             `json:"kind,omitempty"`
+	TestDestinations []CITestDestination `json:"testDestinations,omitempty"`
+	TestPlanName     *string             `json:"testPlanName,omitempty"`
+}
+
+// CITestDestination defines model for CiTestDestination.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestdestination
+type CITestDestination struct {
+	DeviceTypeIdentifier *string `json:"deviceTypeIdentifier,omitempty"`
+	DeviceTypeName       *string `json:"deviceTypeName,omitempty"`
+	Kind                 *string `json:"kind,omitempty"`
+	RuntimeIdentifier    *string `json:"runtimeIdentifier,omitempty"`
+	RuntimeName          *string `json:"runtimeName,omitempty"`
+}
+
+// CIBranchPatterns defines model for CiBranchPatterns.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibranchpatterns
+type CIBranchPatterns struct {
+	IsAllMatch *bool            `json:"isAllMatch,omitempty"`
+	Patterns   []CIStartPattern `json:"patterns,omitempty"`
+}
+
+// CITagPatterns defines model for CiTagPatterns.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citagpatterns
+type CITagPatterns struct {
+	IsAllMatch *bool            `json:"isAllMatch,omitempty"`
+	Patterns   []CIStartPattern `json:"patterns,omitempty"`
+}
+
+// CIStartPattern defines model for CiBranchPatterns.Patterns and CiTagPatterns.Patterns
+type CIStartPattern struct {
+	IsPrefix *bool   `json:"isPrefix,omitempty"`
+	Pattern  *string `json:"pattern,omitempty"`
+}
+
+// CIFilesAndFoldersRule defines model for CiFilesAndFoldersRule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cifilesandfoldersrule
+type CIFilesAndFoldersRule struct {
+	Matchers []CIFilesAndFoldersMatcher `json:"matchers,omitempty"`
+	Mode     *string                    `json:"mode,omitempty"`
+}
+
+// CIFilesAndFoldersMatcher defines model for CiFilesAndFoldersRule.Matchers
+type CIFilesAndFoldersMatcher struct {
+	Directory     *string `json:"directory,omitempty"`
+	FileExtension *string `json:"fileExtension,omitempty"`
+	FileName      *string `json:"fileName,omitempty"`
+}
+
+// CIStartConditionSchedule defines model for CiScheduledStartCondition.Schedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cischeduledstartcondition/schedule
+type CIStartConditionSchedule struct {
+	Days      []string `json:"days,omitempty"`
+	Frequency *string  `json:"frequency,omitempty"`
+	Hour      *int     `json:"hour,omitempty"`
+	Minute    *int     `json:"minute,omitempty"`
+	Timezone  *string  `json:"timezone,omitempty"`
+}
+
+// CIBranchStartCondition defines model for CiBranchStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibranchstartcondition
+type CIBranchStartCondition struct {
+	AutoCancel          *bool                  `json:"autoCancel,omitempty"`
+	FilesAndFoldersRule *CIFilesAndFoldersRule `json:"filesAndFoldersRule,omitempty"`
+	Source              *CIBranchPatterns      `json:"source,omitempty"`
+}
+
+// CITagStartCondition defines model for CiTagStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citagstartcondition
+type CITagStartCondition struct {
+	AutoCancel          *bool                  `json:"autoCancel,omitempty"`
+	FilesAndFoldersRule *CIFilesAndFoldersRule `json:"filesAndFoldersRule,omitempty"`
+	Source              *CITagPatterns         `json:"source,omitempty"`
+}
+
+// CIPullRequestStartCondition defines model for CiPullRequestStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cipullrequeststartcondition
+type CIPullRequestStartCondition struct {
+	AutoCancel          *bool                  `json:"autoCancel,omitempty"`
+	Destination         *CIBranchPatterns      `json:"destination,omitempty"`
+	FilesAndFoldersRule *CIFilesAndFoldersRule `json:"filesAndFoldersRule,omitempty"`
+	Source              *CIBranchPatterns      `json:"source,omitempty"`
+}
+
+// CIScheduledStartCondition defines model for CiScheduledStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cischeduledstartcondition
+type CIScheduledStartCondition struct {
+	Schedule *CIStartConditionSchedule `json:"schedule,omitempty"`
+	Source   *CIBranchPatterns         `json:"source,omitempty"`
+}
+
+// CIManualBranchStartCondition defines model for CiManualBranchStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimanualbranchstartcondition
+type CIManualBranchStartCondition struct {
+	Source *CIBranchPatterns `json:"source,omitempty"`
+}
+
+// CIManualTagStartCondition defines model for CiManualTagStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimanualtagstartcondition
+type CIManualTagStartCondition struct {
+	Source *CITagPatterns `json:"source,omitempty"`
+}
+
+// CIManualPullRequestStartCondition defines model for CiManualPullRequestStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimanualpullrequeststartcondition
+type CIManualPullRequestStartCondition struct {
+	Destination *CIBranchPatterns `json:"destination,omitempty"`
+	Source      *CIBranchPatterns `json:"source,omitempty"`
+}
+
+// CIWorkflow defines model for CiWorkflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflow
+type CIWorkflow struct {
+	Attributes    *CIWorkflowAttributes    `json:"attributes,omitempty"`
+	ID            string                   `json:"id"`
+	Links         ResourceLinks            `json:"links"`
+	Relationships *CIWorkflowRelationships `json:"relationships,omitempty"`
+	Type          string                   `json:"type"`
+}
+
+// CIWorkflowAttributes defines model for CiWorkflow.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflow/attributes
+type CIWorkflowAttributes struct {
+	Actions                         []CIAction                         `json:"actions,omitempty"`
+	BranchStartCondition            *CIBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	Clean                           *bool                              `json:"clean,omitempty"`
+	ContainerFilePath               *string                            `json:"containerFilePath,omitempty"`
+	Description                     *string                            `json:"description,omitempty"`
+	IsEnabled                       *bool                              `json:"isEnabled,omitempty"`
+	IsLockedForEditing              *bool                              `json:"isLockedForEditing,omitempty"`
+	LastModifiedDate                *DateTime                          `json:"lastModifiedDate,omitempty"`
+	ManualBranchStartCondition      *CIManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CIManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+	ManualTagStartCondition         *CIManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	Name                            *string                            `json:"name,omitempty"`
+	PullRequestStartCondition       *CIPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CIScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	TagStartCondition               *CITagStartCondition               `json:"tagStartCondition,omitempty"`
+}
+
+// CIWorkflowRelationships defines model for CiWorkflow.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflow/relationships
+type CIWorkflowRelationships struct {
+	BuildRuns    *PagedRelationship `json:"buildRuns,omitempty"`
+	MacOSVersion *Relationship      `json:"macOsVersion,omitempty"`
+	Product      *Relationship      `json:"product,omitempty"`
+	Repository   *Relationship      `json:"repository,omitempty"`
+	XcodeVersion *Relationship      `json:"xcodeVersion,omitempty"`
+}
+
+// CIWorkflowCreateRequestAttributes are attributes for CIWorkflowCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowcreaterequest/data/attributes
+type CIWorkflowCreateRequestAttributes struct {
+	Actions                         []CIAction                         `json:"actions"`
+	BranchStartCondition            *CIBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	Clean                           bool                               `json:"clean"`
+	ContainerFilePath               string                             `json:"containerFilePath"`
+	Description                     string                             `json:"description"`
+	IsEnabled                       bool                               `json:"isEnabled"`
+	IsLockedForEditing              *bool                              `json:"isLockedForEditing,omitempty"`
+	ManualBranchStartCondition      *CIManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CIManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+	ManualTagStartCondition         *CIManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	Name                            string                             `json:"name"`
+	PullRequestStartCondition       *CIPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CIScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	TagStartCondition               *CITagStartCondition               `json:"tagStartCondition,omitempty"`
+}
+
+// ciWorkflowCreateRequest defines model for CiWorkflowCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowcreaterequest/data
+type ciWorkflowCreateRequest struct {
+	Attributes    CIWorkflowCreateRequestAttributes    `json:"attributes"`
+	Relationships ciWorkflowCreateRequestRelationships `json:"relationships"`
+	Type          string                               `json:"type"`
+}
+
+// ciWorkflowCreateRequestRelationships are relationships for CIWorkflowCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowcreaterequest/data/relationships
+type ciWorkflowCreateRequestRelationships struct {
+	MacOSVersion relationshipDeclaration `json:"macOsVersion"`
+	Product      relationshipDeclaration `json:"product"`
+	Repository   relationshipDeclaration `json:"repository"`
+	XcodeVersion relationshipDeclaration `json:"xcodeVersion"`
+}
+
+// CIWorkflowUpdateRequestAttributes are attributes for CIWorkflowUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowupdaterequest/data/attributes
+type CIWorkflowUpdateRequestAttributes struct {
+	Actions                         []CIAction                         `json:"actions,omitempty"`
+	BranchStartCondition            *CIBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	Clean                           *bool                              `json:"clean,omitempty"`
+	ContainerFilePath               *string                            `json:"containerFilePath,omitempty"`
+	Description                     *string                            `json:"description,omitempty"`
+	IsEnabled                       *bool                              `json:"isEnabled,omitempty"`
+	IsLockedForEditing              *bool                              `json:"isLockedForEditing,omitempty"`
+	ManualBranchStartCondition      *CIManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CIManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+	ManualTagStartCondition         *CIManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	Name                            *string                            `json:"name,omitempty"`
+	PullRequestStartCondition       *CIPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CIScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	TagStartCondition               *CITagStartCondition               `json:"tagStartCondition,omitempty"`
+}
+
+// ciWorkflowUpdateRequest defines model for CiWorkflowUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowupdaterequest/data
+type ciWorkflowUpdateRequest struct {
+	Attributes    *CIWorkflowUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Relationships *ciWorkflowUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// ciWorkflowUpdateRequestRelationships are relationships for CIWorkflowUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowupdaterequest/data/relationships
+type ciWorkflowUpdateRequestRelationships struct {
+	MacOSVersion *relationshipDeclaration `json:"macOsVersion,omitempty"`
+	XcodeVersion *relationshipDeclaration `json:"xcodeVersion,omitempty"`
+}
+
+// CIWorkflowResponse defines model for CiWorkflowResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowresponse
+type CIWorkflowResponse struct {
+	Data     CIWorkflow                   `json:"data"`
+	Included []CIWorkflowResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                `json:"links"`
+}
+
+// CIWorkflowsResponse defines model for CiWorkflowsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowsresponse
+type CIWorkflowsResponse struct {
+	Data     []CIWorkflow                 `json:"data"`
+	Included []CIWorkflowResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks           `json:"links"`
+	Meta     *PagingInformation           `json:"meta,omitempty"`
+}
+
+// CIWorkflowResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CIWorkflowResponse or CIWorkflowsResponse.
+type CIWorkflowResponseIncluded included
+
+// GetCIWorkflowQuery are query options for GetCIWorkflow
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_workflow_information
+type GetCIWorkflowQuery struct {
+	FieldsCIMacOSVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCIProducts      []string `url:"fields[ciProducts],omitempty"`
+	FieldsCIWorkflows     []string `url:"fields[ciWorkflows],omitempty"`
+	FieldsCIXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	FieldsSCMRepositories []string `url:"fields[scmRepositories],omitempty"`
+	Include               []string `url:"include,omitempty"`
+}
+
+// ListCIWorkflowsForProductQuery are query options for ListCIWorkflowsForProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_workflows_for_an_xcode_cloud_product
+type ListCIWorkflowsForProductQuery struct {
+	FieldsCIMacOSVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCIProducts      []string `url:"fields[ciProducts],omitempty"`
+	FieldsCIWorkflows     []string `url:"fields[ciWorkflows],omitempty"`
+	FieldsCIXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	FieldsSCMRepositories []string `url:"fields[scmRepositories],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// ListCIWorkflowsForProduct lists the workflows of an Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_workflows_for_an_xcode_cloud_product
+func (s *CIService) ListCIWorkflowsForProduct(ctx context.Context, id string, params *ListCIWorkflowsForProductQuery) (*CIWorkflowsResponse, *Response, error) {
+	url := fmt.Sprintf("ciProducts/%s/workflows", id)
+	res := new(CIWorkflowsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetCIWorkflow gets information about a specific Xcode Cloud workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_workflow_information
+func (s *CIService) GetCIWorkflow(ctx context.Context, id string, params *GetCIWorkflowQuery) (*CIWorkflowResponse, *Response, error) {
+	url := fmt.Sprintf("ciWorkflows/%s", id)
+	res := new(CIWorkflowResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateCIWorkflow creates a new Xcode Cloud workflow for a product and repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_workflow
+func (s *CIService) CreateCIWorkflow(ctx context.Context, attributes CIWorkflowCreateRequestAttributes, productID string, repositoryID string, xcodeVersionID string, macOSVersionID string) (*CIWorkflowResponse, *Response, error) {
+	req := ciWorkflowCreateRequest{
+		Attributes: attributes,
+		Relationships: ciWorkflowCreateRequestRelationships{
+			MacOSVersion: *newRelationshipDeclaration(&macOSVersionID, "ciMacOsVersions"),
+			Product:      *newRelationshipDeclaration(&productID, "ciProducts"),
+			Repository:   *newRelationshipDeclaration(&repositoryID, "scmRepositories"),
+			XcodeVersion: *newRelationshipDeclaration(&xcodeVersionID, "ciXcodeVersions"),
+		},
+		Type: "ciWorkflows",
+	}
+	res := new(CIWorkflowResponse)
+	resp, err := s.client.post(ctx, "ciWorkflows", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateCIWorkflow updates the configuration of an Xcode Cloud workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_workflow
+func (s *CIService) UpdateCIWorkflow(ctx context.Context, id string, attributes *CIWorkflowUpdateRequestAttributes, xcodeVersionID *string, macOSVersionID *string) (*CIWorkflowResponse, *Response, error) {
+	req := ciWorkflowUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "ciWorkflows",
+	}
+
+	if xcodeVersionID != nil || macOSVersionID != nil {
+		req.Relationships = &ciWorkflowUpdateRequestRelationships{
+			MacOSVersion: newRelationshipDeclaration(macOSVersionID, "ciMacOsVersions"),
+			XcodeVersion: newRelationshipDeclaration(xcodeVersionID, "ciXcodeVersions"),
+		}
+	}
+
+	url := fmt.Sprintf("ciWorkflows/%s", id)
+	res := new(CIWorkflowResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteCIWorkflow deletes an Xcode Cloud workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_workflow
+func (s *CIService) DeleteCIWorkflow(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("ciWorkflows/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetRepositoryForCIWorkflow gets the repository that contains the source code of a workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_repository_information_of_an_xcode_cloud_workflow
+func (s *CIService) GetRepositoryForCIWorkflow(ctx context.Context, id string, params *GetSCMRepositoryQuery) (*SCMRepositoryResponse, *Response, error) {
+	url := fmt.Sprintf("ciWorkflows/%s/repository", id)
+	res := new(SCMRepositoryResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CIWorkflowResponseIncluded.
+func (i *CIWorkflowResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// CIMacOSVersion returns the CIMacOSVersion stored within, if one is present.
+func (i *CIWorkflowResponseIncluded) CIMacOSVersion() *CIMacOSVersion {
+	return extractIncludedCIMacOSVersion(i.inner)
+}
+
+// CIProduct returns the CIProduct stored within, if one is present.
+func (i *CIWorkflowResponseIncluded) CIProduct() *CIProduct {
+	return extractIncludedCIProduct(i.inner)
+}
+
+// CIXcodeVersion returns the CIXcodeVersion stored within, if one is present.
+func (i *CIWorkflowResponseIncluded) CIXcodeVersion() *CIXcodeVersion {
+	return extractIncludedCIXcodeVersion(i.inner)
+}
+
+// SCMRepository returns the SCMRepository stored within, if one is present.
+func (i *CIWorkflowResponseIncluded) SCMRepository() *SCMRepository {
+	return extractIncludedSCMRepository(i.inner)
+}
@@ -0,0 +1,96 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCIWorkflowsForProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIWorkflowsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.ListCIWorkflowsForProduct(ctx, "10", &ListCIWorkflowsForProductQuery{})
+	})
+}
+
+func TestGetCIWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetCIWorkflow(ctx, "10", &GetCIWorkflowQuery{})
+	})
+}
+
+func TestCreateCIWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.CreateCIWorkflow(ctx, CIWorkflowCreateRequestAttributes{}, "10", "20", "30", "40")
+	})
+}
+
+func TestUpdateCIWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CIWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.UpdateCIWorkflow(ctx, "10", &CIWorkflowUpdateRequestAttributes{}, String("30"), nil)
+	})
+}
+
+func TestDeleteCIWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.CI.DeleteCIWorkflow(ctx, "10")
+	})
+}
+
+func TestGetRepositoryForCIWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SCMRepositoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.CI.GetRepositoryForCIWorkflow(ctx, "10", &GetSCMRepositoryQuery{})
+	})
+}
+
+func TestGetCIWorkflowIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"ciMacOsVersions"},{"type":"ciProducts"},{"type":"ciXcodeVersions"},{"type":"scmRepositories"}]}`, func(ctx context.Context, client *Client) {
+		workflow, _, err := client.CI.GetCIWorkflow(ctx, "10", &GetCIWorkflowQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, workflow.Included)
+
+		assert.NotNil(t, workflow.Included[0].CIMacOSVersion())
+		assert.NotNil(t, workflow.Included[1].CIProduct())
+		assert.NotNil(t, workflow.Included[2].CIXcodeVersion())
+		assert.NotNil(t, workflow.Included[3].SCMRepository())
+
+		assert.Nil(t, workflow.Included[0].CIProduct())
+		assert.Nil(t, workflow.Included[1].CIXcodeVersion())
+		assert.Nil(t, workflow.Included[2].SCMRepository())
+		assert.Nil(t, workflow.Included[3].CIMacOSVersion())
+	})
+}
@@ -0,0 +1,284 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterService handles communication with Game Center-related methods of the App Store Connect API
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/game_center
+type GameCenterService service
+
+// GameCenterDetail defines model for GameCenterDetail.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail
+type GameCenterDetail struct {
+	Attributes    *GameCenterDetailAttributes    `json:"attributes,omitempty"`
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *GameCenterDetailRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// GameCenterDetailAttributes defines model for GameCenterDetail.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail/attributes
+type GameCenterDetailAttributes struct {
+	ArcadeEnabled    *bool `json:"arcadeEnabled,omitempty"`
+	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+}
+
+// GameCenterDetailRelationships defines model for GameCenterDetail.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail/relationships
+type GameCenterDetailRelationships struct {
+	AchievementReleases       *PagedRelationship `json:"achievementReleases,omitempty"`
+	App                       *Relationship      `json:"app,omitempty"`
+	DefaultLeaderboard        *Relationship      `json:"defaultLeaderboard,omitempty"`
+	GameCenterAchievements    *PagedRelationship `json:"gameCenterAchievements,omitempty"`
+	GameCenterGroup           *Relationship      `json:"gameCenterGroup,omitempty"`
+	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
+	GameCenterLeaderboards    *PagedRelationship `json:"gameCenterLeaderboards,omitempty"`
+	LeaderboardReleases       *PagedRelationship `json:"leaderboardReleases,omitempty"`
+	LeaderboardSetReleases    *PagedRelationship `json:"leaderboardSetReleases,omitempty"`
+}
+
+// gameCenterDetailCreateRequest defines model for GameCenterDetailCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailcreaterequest/data
+type gameCenterDetailCreateRequest struct {
+	Attributes    *gameCenterDetailCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships gameCenterDetailCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// gameCenterDetailCreateRequestAttributes are attributes for GameCenterDetailCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailcreaterequest/data/attributes
+type gameCenterDetailCreateRequestAttributes struct {
+	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+}
+
+// gameCenterDetailCreateRequestRelationships are relationships for GameCenterDetailCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailcreaterequest/data/relationships
+type gameCenterDetailCreateRequestRelationships struct {
+	App             relationshipDeclaration  `json:"app"`
+	GameCenterGroup *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// gameCenterDetailUpdateRequest defines model for GameCenterDetailUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data
+type gameCenterDetailUpdateRequest struct {
+	Attributes    *gameCenterDetailUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Relationships *gameCenterDetailUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// gameCenterDetailUpdateRequestAttributes are attributes for GameCenterDetailUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data/attributes
+type gameCenterDetailUpdateRequestAttributes struct {
+	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+}
+
+// gameCenterDetailUpdateRequestRelationships are relationships for GameCenterDetailUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data/relationships
+type gameCenterDetailUpdateRequestRelationships struct {
+	DefaultLeaderboard *relationshipDeclaration `json:"defaultLeaderboard,omitempty"`
+	GameCenterGroup    *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// GameCenterDetailResponse defines model for GameCenterDetailResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailresponse
+type GameCenterDetailResponse struct {
+	Data     GameCenterDetail                   `json:"data"`
+	Included []GameCenterDetailResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// GameCenterDetailsResponse defines model for GameCenterDetailsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailsresponse
+type GameCenterDetailsResponse struct {
+	Data     []GameCenterDetail                 `json:"data"`
+	Included []GameCenterDetailResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                 `json:"links"`
+	Meta     *PagingInformation                 `json:"meta,omitempty"`
+}
+
+// GameCenterDetailResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterDetailResponse or GameCenterDetailsResponse.
+type GameCenterDetailResponseIncluded included
+
+// GetGameCenterDetailQuery are query options for GetGameCenterDetail and GetGameCenterDetailForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_details_information
+type GetGameCenterDetailQuery struct {
+	FieldsApps                             []string `url:"fields[apps],omitempty"`
+	FieldsGameCenterAchievementReleases    []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements           []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails                []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                 []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardReleases    []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets        []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards           []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	LimitAchievementReleases               int      `url:"limit[achievementReleases],omitempty"`
+	LimitGameCenterAchievements            int      `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterLeaderboardSets         int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboards            int      `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitLeaderboardReleases               int      `url:"limit[leaderboardReleases],omitempty"`
+	LimitLeaderboardSetReleases            int      `url:"limit[leaderboardSetReleases],omitempty"`
+}
+
+// GetGameCenterDetail gets the Game Center configuration of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_details_information
+func (s *GameCenterService) GetGameCenterDetail(ctx context.Context, id string, params *GetGameCenterDetailQuery) (*GameCenterDetailResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s", id)
+	res := new(GameCenterDetailResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterDetailForApp gets the Game Center configuration of an app given the app's ID.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_game_center_details_of_an_app
+func (s *GameCenterService) GetGameCenterDetailForApp(ctx context.Context, id string, params *GetGameCenterDetailQuery) (*GameCenterDetailResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/gameCenterDetail", id)
+	res := new(GameCenterDetailResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterDetail enables Game Center for an app, optionally placing it in a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_game_center_details
+func (s *GameCenterService) CreateGameCenterDetail(ctx context.Context, challengeEnabled *bool, appID string, gameCenterGroupID *string) (*GameCenterDetailResponse, *Response, error) {
+	req := gameCenterDetailCreateRequest{
+		Relationships: gameCenterDetailCreateRequestRelationships{
+			App:             *newRelationshipDeclaration(&appID, "apps"),
+			GameCenterGroup: newRelationshipDeclaration(gameCenterGroupID, "gameCenterGroups"),
+		},
+		Type: "gameCenterDetails",
+	}
+
+	if challengeEnabled != nil {
+		req.Attributes = &gameCenterDetailCreateRequestAttributes{
+			ChallengeEnabled: challengeEnabled,
+		}
+	}
+
+	res := new(GameCenterDetailResponse)
+	resp, err := s.client.post(ctx, "gameCenterDetails", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterDetail changes whether challenges are enabled for an app, the Game Center group it belongs to,
+// or its default leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_game_center_details
+func (s *GameCenterService) UpdateGameCenterDetail(ctx context.Context, id string, challengeEnabled *bool, gameCenterGroupID *string, defaultLeaderboardID *string) (*GameCenterDetailResponse, *Response, error) {
+	req := gameCenterDetailUpdateRequest{
+		ID:   id,
+		Type: "gameCenterDetails",
+	}
+
+	if challengeEnabled != nil {
+		req.Attributes = &gameCenterDetailUpdateRequestAttributes{
+			ChallengeEnabled: challengeEnabled,
+		}
+	}
+
+	if gameCenterGroupID != nil || defaultLeaderboardID != nil {
+		req.Relationships = &gameCenterDetailUpdateRequestRelationships{
+			DefaultLeaderboard: newRelationshipDeclaration(defaultLeaderboardID, "gameCenterLeaderboards"),
+			GameCenterGroup:    newRelationshipDeclaration(gameCenterGroupID, "gameCenterGroups"),
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterDetails/%s", id)
+	res := new(GameCenterDetailResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterDetailResponseIncluded.
+func (i *GameCenterDetailResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterAchievementRelease returns the GameCenterAchievementRelease stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterAchievementRelease() *GameCenterAchievementRelease {
+	return extractIncludedGameCenterAchievementRelease(i.inner)
+}
+
+// GameCenterGroup returns the GameCenterGroup stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterGroup() *GameCenterGroup {
+	return extractIncludedGameCenterGroup(i.inner)
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// GameCenterLeaderboardRelease returns the GameCenterLeaderboardRelease stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterLeaderboardRelease() *GameCenterLeaderboardRelease {
+	return extractIncludedGameCenterLeaderboardRelease(i.inner)
+}
+
+// GameCenterLeaderboardSet returns the GameCenterLeaderboardSet stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterLeaderboardSet() *GameCenterLeaderboardSet {
+	return extractIncludedGameCenterLeaderboardSet(i.inner)
+}
+
+// GameCenterLeaderboardSetRelease returns the GameCenterLeaderboardSetRelease stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterLeaderboardSetRelease() *GameCenterLeaderboardSetRelease {
+	return extractIncludedGameCenterLeaderboardSetRelease(i.inner)
+}
@@ -0,0 +1,284 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterAchievement defines model for GameCenterAchievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement
+type GameCenterAchievement struct {
+	Attributes    *GameCenterAchievementAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *GameCenterAchievementRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// GameCenterAchievementAttributes defines model for GameCenterAchievement.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement/attributes
+type GameCenterAchievementAttributes struct {
+	Archived         *bool   `json:"archived,omitempty"`
+	Points           *int    `json:"points,omitempty"`
+	ReferenceName    *string `json:"referenceName,omitempty"`
+	Repeatable       *bool   `json:"repeatable,omitempty"`
+	ShowBeforeEarned *bool   `json:"showBeforeEarned,omitempty"`
+	VendorIdentifier *string `json:"vendorIdentifier,omitempty"`
+}
+
+// GameCenterAchievementRelationships defines model for GameCenterAchievement.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement/relationships
+type GameCenterAchievementRelationships struct {
+	GameCenterDetail *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *Relationship      `json:"gameCenterGroup,omitempty"`
+	GroupAchievement *Relationship      `json:"groupAchievement,omitempty"`
+	Localizations    *PagedRelationship `json:"localizations,omitempty"`
+	Releases         *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterAchievementCreateRequest defines model for GameCenterAchievementCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementcreaterequest/data
+type gameCenterAchievementCreateRequest struct {
+	Attributes    GameCenterAchievementCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterAchievementCreateRequestRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterAchievementCreateRequestAttributes are attributes for GameCenterAchievementCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementcreaterequest/data/attributes
+type GameCenterAchievementCreateRequestAttributes struct {
+	Points           int    `json:"points"`
+	ReferenceName    string `json:"referenceName"`
+	Repeatable       bool   `json:"repeatable"`
+	ShowBeforeEarned bool   `json:"showBeforeEarned"`
+	VendorIdentifier string `json:"vendorIdentifier"`
+}
+
+// gameCenterAchievementCreateRequestRelationships are relationships for GameCenterAchievementCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementcreaterequest/data/relationships
+type gameCenterAchievementCreateRequestRelationships struct {
+	GameCenterDetail *relationshipDeclaration `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// gameCenterAchievementUpdateRequest defines model for GameCenterAchievementUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementupdaterequest/data
+type gameCenterAchievementUpdateRequest struct {
+	Attributes *GameCenterAchievementUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// GameCenterAchievementUpdateRequestAttributes are attributes for GameCenterAchievementUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementupdaterequest/data/attributes
+type GameCenterAchievementUpdateRequestAttributes struct {
+	Archived         *bool   `json:"archived,omitempty"`
+	Points           *int    `json:"points,omitempty"`
+	ReferenceName    *string `json:"referenceName,omitempty"`
+	Repeatable       *bool   `json:"repeatable,omitempty"`
+	ShowBeforeEarned *bool   `json:"showBeforeEarned,omitempty"`
+}
+
+// GameCenterAchievementResponse defines model for GameCenterAchievementResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementresponse
+type GameCenterAchievementResponse struct {
+	Data     GameCenterAchievement                   `json:"data"`
+	Included []GameCenterAchievementResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                           `json:"links"`
+}
+
+// GameCenterAchievementsResponse defines model for GameCenterAchievementsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementsresponse
+type GameCenterAchievementsResponse struct {
+	Data     []GameCenterAchievement                 `json:"data"`
+	Included []GameCenterAchievementResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                      `json:"links"`
+	Meta     *PagingInformation                      `json:"meta,omitempty"`
+}
+
+// GameCenterAchievementResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterAchievementResponse or GameCenterAchievementsResponse.
+type GameCenterAchievementResponseIncluded included
+
+// ListGameCenterAchievementsQuery are query options for ListGameCenterAchievementsForGameCenterDetail and
+// ListGameCenterAchievementsForGameCenterGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements_for_game_center_details
+type ListGameCenterAchievementsQuery struct {
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievementReleases      []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails                  []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                   []string `url:"fields[gameCenterGroups],omitempty"`
+	FilterArchived                           []string `url:"filter[archived],omitempty"`
+	FilterID                                 []string `url:"filter[id],omitempty"`
+	FilterReferenceName                      []string `url:"filter[referenceName],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+	LimitReleases                            int      `url:"limit[releases],omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterAchievementQuery are query options for GetGameCenterAchievement
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_information
+type GetGameCenterAchievementQuery struct {
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievementReleases      []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails                  []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                   []string `url:"fields[gameCenterGroups],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+	LimitReleases                            int      `url:"limit[releases],omitempty"`
+}
+
+// ListGameCenterAchievementsForGameCenterDetail lists the achievements defined for an app's Game Center configuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements_for_game_center_details
+func (s *GameCenterService) ListGameCenterAchievementsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterAchievementsQuery) (*GameCenterAchievementsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/gameCenterAchievements", id)
+	res := new(GameCenterAchievementsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListGameCenterAchievementsForGameCenterGroup lists the achievements shared through a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterAchievementsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterAchievementsQuery) (*GameCenterAchievementsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s/gameCenterAchievements", id)
+	res := new(GameCenterAchievementsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterAchievement gets a specific Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_information
+func (s *GameCenterService) GetGameCenterAchievement(ctx context.Context, id string, params *GetGameCenterAchievementQuery) (*GameCenterAchievementResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterAchievements/%s", id)
+	res := new(GameCenterAchievementResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterAchievement creates an achievement for either an app's Game Center configuration or a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_achievement
+func (s *GameCenterService) CreateGameCenterAchievement(ctx context.Context, attributes GameCenterAchievementCreateRequestAttributes, gameCenterDetailID *string, gameCenterGroupID *string) (*GameCenterAchievementResponse, *Response, error) {
+	req := gameCenterAchievementCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterAchievementCreateRequestRelationships{
+			GameCenterDetail: newRelationshipDeclaration(gameCenterDetailID, "gameCenterDetails"),
+			GameCenterGroup:  newRelationshipDeclaration(gameCenterGroupID, "gameCenterGroups"),
+		},
+		Type: "gameCenterAchievements",
+	}
+	res := new(GameCenterAchievementResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievements", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterAchievement modifies the reference name, point value, visibility or archival state of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_achievement
+func (s *GameCenterService) UpdateGameCenterAchievement(ctx context.Context, id string, attributes *GameCenterAchievementUpdateRequestAttributes) (*GameCenterAchievementResponse, *Response, error) {
+	req := gameCenterAchievementUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterAchievements",
+	}
+	url := fmt.Sprintf("gameCenterAchievements/%s", id)
+	res := new(GameCenterAchievementResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ArchiveGameCenterAchievement archives a Game Center achievement so players can no longer earn it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_achievement
+func (s *GameCenterService) ArchiveGameCenterAchievement(ctx context.Context, id string) (*GameCenterAchievementResponse, *Response, error) {
+	return s.UpdateGameCenterAchievement(ctx, id, &GameCenterAchievementUpdateRequestAttributes{
+		Archived: Bool(true),
+	})
+}
+
+// DeleteGameCenterAchievement deletes a Game Center achievement that has not been released.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_achievement
+func (s *GameCenterService) DeleteGameCenterAchievement(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievements/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterAchievementResponseIncluded.
+func (i *GameCenterAchievementResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterAchievementLocalization returns the GameCenterAchievementLocalization stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterAchievementLocalization() *GameCenterAchievementLocalization {
+	return extractIncludedGameCenterAchievementLocalization(i.inner)
+}
+
+// GameCenterAchievementRelease returns the GameCenterAchievementRelease stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterAchievementRelease() *GameCenterAchievementRelease {
+	return extractIncludedGameCenterAchievementRelease(i.inner)
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// GameCenterGroup returns the GameCenterGroup stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterGroup() *GameCenterGroup {
+	return extractIncludedGameCenterGroup(i.inner)
+}
@@ -0,0 +1,388 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterAchievementLocalization defines model for GameCenterAchievementLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization
+type GameCenterAchievementLocalization struct {
+	Attributes    *GameCenterAchievementLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                          `json:"id"`
+	Links         ResourceLinks                                   `json:"links"`
+	Relationships *GameCenterAchievementLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterAchievementLocalizationAttributes defines model for GameCenterAchievementLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization/attributes
+type GameCenterAchievementLocalizationAttributes struct {
+	AfterEarnedDescription  *string `json:"afterEarnedDescription,omitempty"`
+	BeforeEarnedDescription *string `json:"beforeEarnedDescription,omitempty"`
+	Locale                  *string `json:"locale,omitempty"`
+	Name                    *string `json:"name,omitempty"`
+}
+
+// GameCenterAchievementLocalizationRelationships defines model for GameCenterAchievementLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization/relationships
+type GameCenterAchievementLocalizationRelationships struct {
+	GameCenterAchievement      *Relationship `json:"gameCenterAchievement,omitempty"`
+	GameCenterAchievementImage *Relationship `json:"gameCenterAchievementImage,omitempty"`
+}
+
+// gameCenterAchievementLocalizationCreateRequest defines model for GameCenterAchievementLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationcreaterequest/data
+type gameCenterAchievementLocalizationCreateRequest struct {
+	Attributes    GameCenterAchievementLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterAchievementLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                      `json:"type"`
+}
+
+// GameCenterAchievementLocalizationCreateRequestAttributes are attributes for GameCenterAchievementLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationcreaterequest/data/attributes
+type GameCenterAchievementLocalizationCreateRequestAttributes struct {
+	AfterEarnedDescription  string `json:"afterEarnedDescription"`
+	BeforeEarnedDescription string `json:"beforeEarnedDescription"`
+	Locale                  string `json:"locale"`
+	Name                    string `json:"name"`
+}
+
+// gameCenterAchievementLocalizationCreateRequestRelationships are relationships for GameCenterAchievementLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationcreaterequest/data/relationships
+type gameCenterAchievementLocalizationCreateRequestRelationships struct {
+	GameCenterAchievement relationshipDeclaration `json:"gameCenterAchievement"`
+}
+
+// gameCenterAchievementLocalizationUpdateRequest defines model for GameCenterAchievementLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationupdaterequest/data
+type gameCenterAchievementLocalizationUpdateRequest struct {
+	Attributes *GameCenterAchievementLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                    `json:"id"`
+	Type       string                                                    `json:"type"`
+}
+
+// GameCenterAchievementLocalizationUpdateRequestAttributes are attributes for GameCenterAchievementLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationupdaterequest/data/attributes
+type GameCenterAchievementLocalizationUpdateRequestAttributes struct {
+	AfterEarnedDescription  *string `json:"afterEarnedDescription,omitempty"`
+	BeforeEarnedDescription *string `json:"beforeEarnedDescription,omitempty"`
+	Name                    *string `json:"name,omitempty"`
+}
+
+// GameCenterAchievementLocalizationResponse defines model for GameCenterAchievementLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationresponse
+type GameCenterAchievementLocalizationResponse struct {
+	Data     GameCenterAchievementLocalization                   `json:"data"`
+	Included []GameCenterAchievementLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                       `json:"links"`
+}
+
+// GameCenterAchievementLocalizationsResponse defines model for GameCenterAchievementLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationsresponse
+type GameCenterAchievementLocalizationsResponse struct {
+	Data     []GameCenterAchievementLocalization                 `json:"data"`
+	Included []GameCenterAchievementLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                  `json:"links"`
+	Meta     *PagingInformation                                  `json:"meta,omitempty"`
+}
+
+// GameCenterAchievementLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterAchievementLocalizationResponse or GameCenterAchievementLocalizationsResponse.
+type GameCenterAchievementLocalizationResponseIncluded included
+
+// GameCenterAchievementImage defines model for GameCenterAchievementImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage
+type GameCenterAchievementImage struct {
+	Attributes    *GameCenterAchievementImageAttributes    `json:"attributes,omitempty"`
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *GameCenterAchievementImageRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// GameCenterAchievementImageAttributes defines model for GameCenterAchievementImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage/attributes
+type GameCenterAchievementImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// GameCenterAchievementImageRelationships defines model for GameCenterAchievementImage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage/relationships
+type GameCenterAchievementImageRelationships struct {
+	GameCenterAchievementLocalization *Relationship `json:"gameCenterAchievementLocalization,omitempty"`
+}
+
+// gameCenterAchievementImageCreateRequest defines model for GameCenterAchievementImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimagecreaterequest/data
+type gameCenterAchievementImageCreateRequest struct {
+	Attributes    gameCenterAchievementImageCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterAchievementImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+// gameCenterAchievementImageCreateRequestAttributes are attributes for GameCenterAchievementImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimagecreaterequest/data/attributes
+type gameCenterAchievementImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// gameCenterAchievementImageCreateRequestRelationships are relationships for GameCenterAchievementImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimagecreaterequest/data/relationships
+type gameCenterAchievementImageCreateRequestRelationships struct {
+	GameCenterAchievementLocalization relationshipDeclaration `json:"gameCenterAchievementLocalization"`
+}
+
+// gameCenterAchievementImageUpdateRequest defines model for GameCenterAchievementImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageupdaterequest/data
+type gameCenterAchievementImageUpdateRequest struct {
+	Attributes *gameCenterAchievementImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                             `json:"id"`
+	Type       string                                             `json:"type"`
+}
+
+// gameCenterAchievementImageUpdateRequestAttributes are attributes for GameCenterAchievementImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageupdaterequest/data/attributes
+type gameCenterAchievementImageUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// GameCenterAchievementImageResponse defines model for GameCenterAchievementImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageresponse
+type GameCenterAchievementImageResponse struct {
+	Data     GameCenterAchievementImage          `json:"data"`
+	Included []GameCenterAchievementLocalization `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// ListGameCenterAchievementLocalizationsQuery are query options for ListLocalizationsForGameCenterAchievement
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_game_center_achievement
+type ListGameCenterAchievementLocalizationsQuery struct {
+	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterAchievementLocalizationQuery are query options for GetGameCenterAchievementLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_localization_information
+type GetGameCenterAchievementLocalizationQuery struct {
+	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+}
+
+// GetGameCenterAchievementImageQuery are query options for GetGameCenterAchievementImage and GetGameCenterAchievementImageForLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_image_information
+type GetGameCenterAchievementImageQuery struct {
+	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+}
+
+// ListLocalizationsForGameCenterAchievement lists the localizations of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_game_center_achievement
+func (s *GameCenterService) ListLocalizationsForGameCenterAchievement(ctx context.Context, id string, params *ListGameCenterAchievementLocalizationsQuery) (*GameCenterAchievementLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterAchievements/%s/localizations", id)
+	res := new(GameCenterAchievementLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterAchievementLocalization gets a specific localization of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_localization_information
+func (s *GameCenterService) GetGameCenterAchievementLocalization(ctx context.Context, id string, params *GetGameCenterAchievementLocalizationQuery) (*GameCenterAchievementLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementLocalizations/%s", id)
+	res := new(GameCenterAchievementLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterAchievementLocalization adds a localization to a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_achievement_localization
+func (s *GameCenterService) CreateGameCenterAchievementLocalization(ctx context.Context, attributes GameCenterAchievementLocalizationCreateRequestAttributes, gameCenterAchievementID string) (*GameCenterAchievementLocalizationResponse, *Response, error) {
+	req := gameCenterAchievementLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterAchievementLocalizationCreateRequestRelationships{
+			GameCenterAchievement: *newRelationshipDeclaration(&gameCenterAchievementID, "gameCenterAchievements"),
+		},
+		Type: "gameCenterAchievementLocalizations",
+	}
+	res := new(GameCenterAchievementLocalizationResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievementLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterAchievementLocalization modifies a localization of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_achievement_localization
+func (s *GameCenterService) UpdateGameCenterAchievementLocalization(ctx context.Context, id string, attributes *GameCenterAchievementLocalizationUpdateRequestAttributes) (*GameCenterAchievementLocalizationResponse, *Response, error) {
+	req := gameCenterAchievementLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterAchievementLocalizations",
+	}
+	url := fmt.Sprintf("gameCenterAchievementLocalizations/%s", id)
+	res := new(GameCenterAchievementLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterAchievementLocalization deletes a localization of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_achievement_localization
+func (s *GameCenterService) DeleteGameCenterAchievementLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetGameCenterAchievementImage gets information about a Game Center achievement image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_image_information
+func (s *GameCenterService) GetGameCenterAchievementImage(ctx context.Context, id string, params *GetGameCenterAchievementImageQuery) (*GameCenterAchievementImageResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementImages/%s", id)
+	res := new(GameCenterAchievementImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterAchievementImageForLocalization gets the image attached to a localization of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_image_for_a_game_center_achievement_localization
+func (s *GameCenterService) GetGameCenterAchievementImageForLocalization(ctx context.Context, id string, params *GetGameCenterAchievementImageQuery) (*GameCenterAchievementImageResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementLocalizations/%s/gameCenterAchievementImage", id)
+	res := new(GameCenterAchievementImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterAchievementImage reserves an image for a localization of a Game Center achievement. Upload the file with the
+// returned upload operations using Client.Upload, then commit it with CommitGameCenterAchievementImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_achievement_image
+func (s *GameCenterService) CreateGameCenterAchievementImage(ctx context.Context, fileName string, fileSize int64, gameCenterAchievementLocalizationID string) (*GameCenterAchievementImageResponse, *Response, error) {
+	req := gameCenterAchievementImageCreateRequest{
+		Attributes: gameCenterAchievementImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: gameCenterAchievementImageCreateRequestRelationships{
+			GameCenterAchievementLocalization: *newRelationshipDeclaration(&gameCenterAchievementLocalizationID, "gameCenterAchievementLocalizations"),
+		},
+		Type: "gameCenterAchievementImages",
+	}
+	res := new(GameCenterAchievementImageResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievementImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitGameCenterAchievementImage commits a Game Center achievement image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_achievement_image
+func (s *GameCenterService) CommitGameCenterAchievementImage(ctx context.Context, id string, uploaded *bool) (*GameCenterAchievementImageResponse, *Response, error) {
+	req := gameCenterAchievementImageUpdateRequest{
+		ID:   id,
+		Type: "gameCenterAchievementImages",
+	}
+
+	if uploaded != nil {
+		req.Attributes = &gameCenterAchievementImageUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterAchievementImages/%s", id)
+	res := new(GameCenterAchievementImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterAchievementImage deletes a Game Center achievement image.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_achievement_image
+func (s *GameCenterService) DeleteGameCenterAchievementImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterAchievementLocalizationResponseIncluded.
+func (i *GameCenterAchievementLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterAchievementLocalizationResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterAchievementImage returns the GameCenterAchievementImage stored within, if one is present.
+func (i *GameCenterAchievementLocalizationResponseIncluded) GameCenterAchievementImage() *GameCenterAchievementImage {
+	return extractIncludedGameCenterAchievementImage(i.inner)
+}
@@ -0,0 +1,124 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLocalizationsForGameCenterAchievement(ctx, "10", &ListGameCenterAchievementLocalizationsQuery{})
+	})
+}
+
+func TestGetGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementLocalization(ctx, "10", &GetGameCenterAchievementLocalizationQuery{})
+	})
+}
+
+func TestCreateGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievementLocalization(ctx, GameCenterAchievementLocalizationCreateRequestAttributes{Locale: "en-US", Name: "First Win"}, "10")
+	})
+}
+
+func TestUpdateGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterAchievementLocalization(ctx, "10", &GameCenterAchievementLocalizationUpdateRequestAttributes{Name: String("First Win")})
+	})
+}
+
+func TestDeleteGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievementLocalization(ctx, "10")
+	})
+}
+
+func TestGetGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementImage(ctx, "10", &GetGameCenterAchievementImageQuery{})
+	})
+}
+
+func TestGetGameCenterAchievementImageForLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementImageForLocalization(ctx, "10", &GetGameCenterAchievementImageQuery{})
+	})
+}
+
+func TestCreateGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievementImage(ctx, "image.png", 1024, "10")
+	})
+}
+
+func TestCommitGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CommitGameCenterAchievementImage(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievementImage(ctx, "10")
+	})
+}
+
+func TestGetGameCenterAchievementLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterAchievements"},{"type":"gameCenterAchievementImages"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.GameCenter.GetGameCenterAchievementLocalization(ctx, "10", &GetGameCenterAchievementLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].GameCenterAchievement())
+		assert.NotNil(t, localization.Included[1].GameCenterAchievementImage())
+
+		assert.Nil(t, localization.Included[0].GameCenterAchievementImage())
+		assert.Nil(t, localization.Included[1].GameCenterAchievement())
+	})
+}
@@ -0,0 +1,106 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterAchievementsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterAchievementsForGameCenterDetail(ctx, "10", &ListGameCenterAchievementsQuery{})
+	})
+}
+
+func TestListGameCenterAchievementsForGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterAchievementsForGameCenterGroup(ctx, "10", &ListGameCenterAchievementsQuery{})
+	})
+}
+
+func TestGetGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievement(ctx, "10", &GetGameCenterAchievementQuery{})
+	})
+}
+
+func TestCreateGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievement(ctx, GameCenterAchievementCreateRequestAttributes{Points: 10, ReferenceName: "First Win", VendorIdentifier: "first_win"}, String("10"), nil)
+	})
+}
+
+func TestUpdateGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterAchievement(ctx, "10", &GameCenterAchievementUpdateRequestAttributes{Points: Int(20)})
+	})
+}
+
+func TestArchiveGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ArchiveGameCenterAchievement(ctx, "10")
+	})
+}
+
+func TestDeleteGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievement(ctx, "10")
+	})
+}
+
+func TestGetGameCenterAchievementIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterAchievements"},{"type":"gameCenterAchievementLocalizations"},{"type":"gameCenterAchievementReleases"},{"type":"gameCenterDetails"},{"type":"gameCenterGroups"}]}`, func(ctx context.Context, client *Client) {
+		achievement, _, err := client.GameCenter.GetGameCenterAchievement(ctx, "10", &GetGameCenterAchievementQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, achievement.Included)
+
+		assert.NotNil(t, achievement.Included[0].GameCenterAchievement())
+		assert.NotNil(t, achievement.Included[1].GameCenterAchievementLocalization())
+		assert.NotNil(t, achievement.Included[2].GameCenterAchievementRelease())
+		assert.NotNil(t, achievement.Included[3].GameCenterDetail())
+		assert.NotNil(t, achievement.Included[4].GameCenterGroup())
+
+		assert.Nil(t, achievement.Included[0].GameCenterAchievementLocalization())
+		assert.Nil(t, achievement.Included[1].GameCenterAchievementRelease())
+		assert.Nil(t, achievement.Included[2].GameCenterDetail())
+		assert.Nil(t, achievement.Included[3].GameCenterGroup())
+		assert.Nil(t, achievement.Included[4].GameCenterAchievement())
+	})
+}
@@ -0,0 +1,278 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterGroup defines model for GameCenterGroup.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup
+type GameCenterGroup struct {
+	Attributes    *GameCenterGroupAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *GameCenterGroupRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// GameCenterGroupAttributes defines model for GameCenterGroup.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup/attributes
+type GameCenterGroupAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// GameCenterGroupRelationships defines model for GameCenterGroup.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup/relationships
+type GameCenterGroupRelationships struct {
+	GameCenterAchievements    *PagedRelationship `json:"gameCenterAchievements,omitempty"`
+	GameCenterDetails         *PagedRelationship `json:"gameCenterDetails,omitempty"`
+	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
+	GameCenterLeaderboards    *PagedRelationship `json:"gameCenterLeaderboards,omitempty"`
+}
+
+// gameCenterGroupCreateRequest defines model for GameCenterGroupCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupcreaterequest/data
+type gameCenterGroupCreateRequest struct {
+	Attributes *gameCenterGroupCreateRequestAttributes `json:"attributes,omitempty"`
+	Type       string                                  `json:"type"`
+}
+
+// gameCenterGroupCreateRequestAttributes are attributes for GameCenterGroupCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupcreaterequest/data/attributes
+type gameCenterGroupCreateRequestAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// gameCenterGroupUpdateRequest defines model for GameCenterGroupUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupupdaterequest/data
+type gameCenterGroupUpdateRequest struct {
+	Attributes *gameCenterGroupUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                  `json:"id"`
+	Type       string                                  `json:"type"`
+}
+
+// gameCenterGroupUpdateRequestAttributes are attributes for GameCenterGroupUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupupdaterequest/data/attributes
+type gameCenterGroupUpdateRequestAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// GameCenterGroupResponse defines model for GameCenterGroupResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupresponse
+type GameCenterGroupResponse struct {
+	Data     GameCenterGroup                   `json:"data"`
+	Included []GameCenterGroupResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                     `json:"links"`
+}
+
+// GameCenterGroupsResponse defines model for GameCenterGroupsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupsresponse
+type GameCenterGroupsResponse struct {
+	Data     []GameCenterGroup                 `json:"data"`
+	Included []GameCenterGroupResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                `json:"links"`
+	Meta     *PagingInformation                `json:"meta,omitempty"`
+}
+
+// GameCenterGroupResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterGroupResponse or GameCenterGroupsResponse.
+type GameCenterGroupResponseIncluded included
+
+// ListGameCenterGroupsQuery are query options for ListGameCenterGroups
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_game_center_groups
+type ListGameCenterGroupsQuery struct {
+	FieldsGameCenterAchievements    []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails         []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups          []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardSets []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards    []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterGameCenterDetails         []string `url:"filter[gameCenterDetails],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+	Limit                           int      `url:"limit,omitempty"`
+	LimitGameCenterAchievements     int      `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterDetails          int      `url:"limit[gameCenterDetails],omitempty"`
+	LimitGameCenterLeaderboardSets  int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboards     int      `url:"limit[gameCenterLeaderboards],omitempty"`
+	Cursor                          string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterGroupQuery are query options for GetGameCenterGroup and GetGameCenterGroupForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_group_information
+type GetGameCenterGroupQuery struct {
+	FieldsGameCenterAchievements    []string `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails         []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups          []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardSets []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards    []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+	LimitGameCenterAchievements     int      `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterDetails          int      `url:"limit[gameCenterDetails],omitempty"`
+	LimitGameCenterLeaderboardSets  int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboards     int      `url:"limit[gameCenterLeaderboards],omitempty"`
+}
+
+// ListGameCenterDetailsForGameCenterGroupQuery are query options for ListGameCenterDetailsForGameCenterGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_details_for_a_game_center_group
+type ListGameCenterDetailsForGameCenterGroupQuery struct {
+	FieldsApps              []string `url:"fields[apps],omitempty"`
+	FieldsGameCenterDetails []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups  []string `url:"fields[gameCenterGroups],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+	Limit                   int      `url:"limit,omitempty"`
+	Cursor                  string   `url:"cursor,omitempty"`
+}
+
+// ListGameCenterGroups lists the Game Center groups shared between your apps.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_game_center_groups
+func (s *GameCenterService) ListGameCenterGroups(ctx context.Context, params *ListGameCenterGroupsQuery) (*GameCenterGroupsResponse, *Response, error) {
+	res := new(GameCenterGroupsResponse)
+	resp, err := s.client.get(ctx, "gameCenterGroups", params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterGroup gets a specific Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_group_information
+func (s *GameCenterService) GetGameCenterGroup(ctx context.Context, id string, params *GetGameCenterGroupQuery) (*GameCenterGroupResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s", id)
+	res := new(GameCenterGroupResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterGroupForGameCenterDetail gets the Game Center group an app's Game Center configuration belongs to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_game_center_group_of_game_center_details
+func (s *GameCenterService) GetGameCenterGroupForGameCenterDetail(ctx context.Context, id string, params *GetGameCenterGroupQuery) (*GameCenterGroupResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/gameCenterGroup", id)
+	res := new(GameCenterGroupResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListGameCenterDetailsForGameCenterGroup lists the Game Center configurations of the apps in a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_details_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterDetailsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterDetailsForGameCenterGroupQuery) (*GameCenterDetailsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s/gameCenterDetails", id)
+	res := new(GameCenterDetailsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterGroup creates a Game Center group that apps can share achievements and leaderboards through.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_group
+func (s *GameCenterService) CreateGameCenterGroup(ctx context.Context, referenceName *string) (*GameCenterGroupResponse, *Response, error) {
+	req := gameCenterGroupCreateRequest{
+		Type: "gameCenterGroups",
+	}
+
+	if referenceName != nil {
+		req.Attributes = &gameCenterGroupCreateRequestAttributes{
+			ReferenceName: referenceName,
+		}
+	}
+
+	res := new(GameCenterGroupResponse)
+	resp, err := s.client.post(ctx, "gameCenterGroups", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterGroup changes the reference name of a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_group
+func (s *GameCenterService) UpdateGameCenterGroup(ctx context.Context, id string, referenceName *string) (*GameCenterGroupResponse, *Response, error) {
+	req := gameCenterGroupUpdateRequest{
+		ID:   id,
+		Type: "gameCenterGroups",
+	}
+
+	if referenceName != nil {
+		req.Attributes = &gameCenterGroupUpdateRequestAttributes{
+			ReferenceName: referenceName,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterGroups/%s", id)
+	res := new(GameCenterGroupResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterGroup deletes a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_group
+func (s *GameCenterService) DeleteGameCenterGroup(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterGroupResponseIncluded.
+func (i *GameCenterGroupResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterGroupResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterGroupResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterGroupResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// GameCenterLeaderboardSet returns the GameCenterLeaderboardSet stored within, if one is present.
+func (i *GameCenterGroupResponseIncluded) GameCenterLeaderboardSet() *GameCenterLeaderboardSet {
+	return extractIncludedGameCenterLeaderboardSet(i.inner)
+}
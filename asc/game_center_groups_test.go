@@ -0,0 +1,104 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterGroups(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterGroups(ctx, &ListGameCenterGroupsQuery{})
+	})
+}
+
+func TestGetGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterGroup(ctx, "10", &GetGameCenterGroupQuery{})
+	})
+}
+
+func TestGetGameCenterGroupForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterGroupForGameCenterDetail(ctx, "10", &GetGameCenterGroupQuery{})
+	})
+}
+
+func TestListGameCenterDetailsForGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterDetailsForGameCenterGroup(ctx, "10", &ListGameCenterDetailsForGameCenterGroupQuery{})
+	})
+}
+
+func TestCreateGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterGroup(ctx, String("Shared"))
+	})
+}
+
+func TestUpdateGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterGroup(ctx, "10", String("Shared"))
+	})
+}
+
+func TestDeleteGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterGroup(ctx, "10")
+	})
+}
+
+func TestGetGameCenterGroupIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterAchievements"},{"type":"gameCenterDetails"},{"type":"gameCenterLeaderboards"},{"type":"gameCenterLeaderboardSets"}]}`, func(ctx context.Context, client *Client) {
+		group, _, err := client.GameCenter.GetGameCenterGroup(ctx, "10", &GetGameCenterGroupQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, group.Included)
+
+		assert.NotNil(t, group.Included[0].GameCenterAchievement())
+		assert.NotNil(t, group.Included[1].GameCenterDetail())
+		assert.NotNil(t, group.Included[2].GameCenterLeaderboard())
+		assert.NotNil(t, group.Included[3].GameCenterLeaderboardSet())
+
+		assert.Nil(t, group.Included[0].GameCenterDetail())
+		assert.Nil(t, group.Included[1].GameCenterLeaderboard())
+		assert.Nil(t, group.Included[2].GameCenterLeaderboardSet())
+		assert.Nil(t, group.Included[3].GameCenterAchievement())
+	})
+}
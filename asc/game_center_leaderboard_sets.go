@@ -0,0 +1,352 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterLeaderboardSet defines model for GameCenterLeaderboardSet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardset
+type GameCenterLeaderboardSet struct {
+	Attributes    *GameCenterLeaderboardSetAttributes    `json:"attributes,omitempty"`
+	ID            string                                 `json:"id"`
+	Links         ResourceLinks                          `json:"links"`
+	Relationships *GameCenterLeaderboardSetRelationships `json:"relationships,omitempty"`
+	Type          string                                 `json:"type"`
+}
+
+// GameCenterLeaderboardSetAttributes defines model for GameCenterLeaderboardSet.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardset/attributes
+type GameCenterLeaderboardSetAttributes struct {
+	ReferenceName    *string `json:"referenceName,omitempty"`
+	VendorIdentifier *string `json:"vendorIdentifier,omitempty"`
+}
+
+// GameCenterLeaderboardSetRelationships defines model for GameCenterLeaderboardSet.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardset/relationships
+type GameCenterLeaderboardSetRelationships struct {
+	GameCenterDetail       *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup        *Relationship      `json:"gameCenterGroup,omitempty"`
+	GameCenterLeaderboards *PagedRelationship `json:"gameCenterLeaderboards,omitempty"`
+	GroupLeaderboardSet    *Relationship      `json:"groupLeaderboardSet,omitempty"`
+	Localizations          *PagedRelationship `json:"localizations,omitempty"`
+	Releases               *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterLeaderboardSetCreateRequest defines model for GameCenterLeaderboardSetCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetcreaterequest/data
+type gameCenterLeaderboardSetCreateRequest struct {
+	Attributes    gameCenterLeaderboardSetCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterLeaderboardSetCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// gameCenterLeaderboardSetCreateRequestAttributes are attributes for GameCenterLeaderboardSetCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetcreaterequest/data/attributes
+type gameCenterLeaderboardSetCreateRequestAttributes struct {
+	ReferenceName    string `json:"referenceName"`
+	VendorIdentifier string `json:"vendorIdentifier"`
+}
+
+// gameCenterLeaderboardSetCreateRequestRelationships are relationships for GameCenterLeaderboardSetCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetcreaterequest/data/relationships
+type gameCenterLeaderboardSetCreateRequestRelationships struct {
+	GameCenterDetail *relationshipDeclaration `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// gameCenterLeaderboardSetUpdateRequest defines model for GameCenterLeaderboardSetUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetupdaterequest/data
+type gameCenterLeaderboardSetUpdateRequest struct {
+	Attributes *gameCenterLeaderboardSetUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Type       string                                           `json:"type"`
+}
+
+// gameCenterLeaderboardSetUpdateRequestAttributes are attributes for GameCenterLeaderboardSetUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetupdaterequest/data/attributes
+type gameCenterLeaderboardSetUpdateRequestAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// GameCenterLeaderboardSetResponse defines model for GameCenterLeaderboardSetResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetresponse
+type GameCenterLeaderboardSetResponse struct {
+	Data     GameCenterLeaderboardSet                   `json:"data"`
+	Included []GameCenterLeaderboardSetResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                              `json:"links"`
+}
+
+// GameCenterLeaderboardSetsResponse defines model for GameCenterLeaderboardSetsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetsresponse
+type GameCenterLeaderboardSetsResponse struct {
+	Data     []GameCenterLeaderboardSet                 `json:"data"`
+	Included []GameCenterLeaderboardSetResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                         `json:"links"`
+	Meta     *PagingInformation                         `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardSetResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterLeaderboardSetResponse or GameCenterLeaderboardSetsResponse.
+type GameCenterLeaderboardSetResponseIncluded included
+
+// GameCenterLeaderboardSetGameCenterLeaderboardsLinkagesResponse defines model for GameCenterLeaderboardSetGameCenterLeaderboardsLinkagesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetgamecenterleaderboardslinkagesresponse
+type GameCenterLeaderboardSetGameCenterLeaderboardsLinkagesResponse struct {
+	Data  []RelationshipData `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListGameCenterLeaderboardSetsQuery are query options for ListGameCenterLeaderboardSetsForGameCenterDetail and
+// ListGameCenterLeaderboardSetsForGameCenterGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_sets_for_game_center_details
+type ListGameCenterLeaderboardSetsQuery struct {
+	FieldsGameCenterDetails                     []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                      []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardSetLocalizations []string `url:"fields[gameCenterLeaderboardSetLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases      []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets             []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards                []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterID                                    []string `url:"filter[id],omitempty"`
+	FilterReferenceName                         []string `url:"filter[referenceName],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	LimitGameCenterLeaderboards                 int      `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitLocalizations                          int      `url:"limit[localizations],omitempty"`
+	LimitReleases                               int      `url:"limit[releases],omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardSetQuery are query options for GetGameCenterLeaderboardSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_information
+type GetGameCenterLeaderboardSetQuery struct {
+	FieldsGameCenterDetails                     []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                      []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardSetLocalizations []string `url:"fields[gameCenterLeaderboardSetLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases      []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets             []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards                []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	LimitGameCenterLeaderboards                 int      `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitLocalizations                          int      `url:"limit[localizations],omitempty"`
+	LimitReleases                               int      `url:"limit[releases],omitempty"`
+}
+
+// ListGameCenterLeaderboardIDsForGameCenterLeaderboardSetQuery are query options for ListGameCenterLeaderboardIDsForGameCenterLeaderboardSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_all_leaderboard_ids_for_a_game_center_leaderboard_set
+type ListGameCenterLeaderboardIDsForGameCenterLeaderboardSetQuery struct {
+	Limit  int    `url:"limit,omitempty"`
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// ListGameCenterLeaderboardSetsForGameCenterDetail lists the leaderboard sets defined for an app's Game Center configuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_sets_for_game_center_details
+func (s *GameCenterService) ListGameCenterLeaderboardSetsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardSetsQuery) (*GameCenterLeaderboardSetsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/gameCenterLeaderboardSets", id)
+	res := new(GameCenterLeaderboardSetsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListGameCenterLeaderboardSetsForGameCenterGroup lists the leaderboard sets shared through a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_sets_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterLeaderboardSetsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterLeaderboardSetsQuery) (*GameCenterLeaderboardSetsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s/gameCenterLeaderboardSets", id)
+	res := new(GameCenterLeaderboardSetsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardSet gets a specific Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_information
+func (s *GameCenterService) GetGameCenterLeaderboardSet(ctx context.Context, id string, params *GetGameCenterLeaderboardSetQuery) (*GameCenterLeaderboardSetResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s", id)
+	res := new(GameCenterLeaderboardSetResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardSet creates a leaderboard set for either an app's Game Center configuration or a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_set
+func (s *GameCenterService) CreateGameCenterLeaderboardSet(ctx context.Context, referenceName string, vendorIdentifier string, gameCenterDetailID *string, gameCenterGroupID *string) (*GameCenterLeaderboardSetResponse, *Response, error) {
+	req := gameCenterLeaderboardSetCreateRequest{
+		Attributes: gameCenterLeaderboardSetCreateRequestAttributes{
+			ReferenceName:    referenceName,
+			VendorIdentifier: vendorIdentifier,
+		},
+		Relationships: gameCenterLeaderboardSetCreateRequestRelationships{
+			GameCenterDetail: newRelationshipDeclaration(gameCenterDetailID, "gameCenterDetails"),
+			GameCenterGroup:  newRelationshipDeclaration(gameCenterGroupID, "gameCenterGroups"),
+		},
+		Type: "gameCenterLeaderboardSets",
+	}
+	res := new(GameCenterLeaderboardSetResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterLeaderboardSet changes the reference name of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard_set
+func (s *GameCenterService) UpdateGameCenterLeaderboardSet(ctx context.Context, id string, referenceName *string) (*GameCenterLeaderboardSetResponse, *Response, error) {
+	req := gameCenterLeaderboardSetUpdateRequest{
+		ID:   id,
+		Type: "gameCenterLeaderboardSets",
+	}
+
+	if referenceName != nil {
+		req.Attributes = &gameCenterLeaderboardSetUpdateRequestAttributes{
+			ReferenceName: referenceName,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s", id)
+	res := new(GameCenterLeaderboardSetResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardSet deletes a Game Center leaderboard set that has not been released.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_set
+func (s *GameCenterService) DeleteGameCenterLeaderboardSet(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListGameCenterLeaderboardsForGameCenterLeaderboardSet lists the leaderboards that belong to a leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_a_game_center_leaderboard_set
+func (s *GameCenterService) ListGameCenterLeaderboardsForGameCenterLeaderboardSet(ctx context.Context, id string, params *ListGameCenterLeaderboardsQuery) (*GameCenterLeaderboardsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s/gameCenterLeaderboards", id)
+	res := new(GameCenterLeaderboardsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListGameCenterLeaderboardIDsForGameCenterLeaderboardSet gets the ordered leaderboard IDs in a leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_all_leaderboard_ids_for_a_game_center_leaderboard_set
+func (s *GameCenterService) ListGameCenterLeaderboardIDsForGameCenterLeaderboardSet(ctx context.Context, id string, params *ListGameCenterLeaderboardIDsForGameCenterLeaderboardSetQuery) (*GameCenterLeaderboardSetGameCenterLeaderboardsLinkagesResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", id)
+	res := new(GameCenterLeaderboardSetGameCenterLeaderboardsLinkagesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// AddGameCenterLeaderboardsToGameCenterLeaderboardSet adds one or more leaderboards to a leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/add_leaderboards_to_a_game_center_leaderboard_set
+func (s *GameCenterService) AddGameCenterLeaderboardsToGameCenterLeaderboardSet(ctx context.Context, id string, gameCenterLeaderboardIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterLeaderboardIDs, "gameCenterLeaderboards")
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", id)
+
+	return s.client.post(ctx, url, newRequestBody(linkages.Data), nil)
+}
+
+// ReplaceGameCenterLeaderboardsForGameCenterLeaderboardSet replaces the leaderboards in a leaderboard set, which
+// also changes the order they appear in.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/replace_all_leaderboards_for_a_game_center_leaderboard_set
+func (s *GameCenterService) ReplaceGameCenterLeaderboardsForGameCenterLeaderboardSet(ctx context.Context, id string, gameCenterLeaderboardIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterLeaderboardIDs, "gameCenterLeaderboards")
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", id)
+
+	return s.client.patch(ctx, url, newRequestBody(linkages.Data), nil)
+}
+
+// RemoveGameCenterLeaderboardsFromGameCenterLeaderboardSet removes one or more leaderboards from a leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/remove_leaderboards_from_a_game_center_leaderboard_set
+func (s *GameCenterService) RemoveGameCenterLeaderboardsFromGameCenterLeaderboardSet(ctx context.Context, id string, gameCenterLeaderboardIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterLeaderboardIDs, "gameCenterLeaderboards")
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s/relationships/gameCenterLeaderboards", id)
+
+	return s.client.delete(ctx, url, newRequestBody(linkages.Data))
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterLeaderboardSetResponseIncluded.
+func (i *GameCenterLeaderboardSetResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterLeaderboardSetResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// GameCenterGroup returns the GameCenterGroup stored within, if one is present.
+func (i *GameCenterLeaderboardSetResponseIncluded) GameCenterGroup() *GameCenterGroup {
+	return extractIncludedGameCenterGroup(i.inner)
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterLeaderboardSetResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// GameCenterLeaderboardSet returns the GameCenterLeaderboardSet stored within, if one is present.
+func (i *GameCenterLeaderboardSetResponseIncluded) GameCenterLeaderboardSet() *GameCenterLeaderboardSet {
+	return extractIncludedGameCenterLeaderboardSet(i.inner)
+}
+
+// GameCenterLeaderboardSetLocalization returns the GameCenterLeaderboardSetLocalization stored within, if one is present.
+func (i *GameCenterLeaderboardSetResponseIncluded) GameCenterLeaderboardSetLocalization() *GameCenterLeaderboardSetLocalization {
+	return extractIncludedGameCenterLeaderboardSetLocalization(i.inner)
+}
+
+// GameCenterLeaderboardSetRelease returns the GameCenterLeaderboardSetRelease stored within, if one is present.
+func (i *GameCenterLeaderboardSetResponseIncluded) GameCenterLeaderboardSetRelease() *GameCenterLeaderboardSetRelease {
+	return extractIncludedGameCenterLeaderboardSetRelease(i.inner)
+}
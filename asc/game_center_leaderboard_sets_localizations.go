@@ -0,0 +1,391 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterLeaderboardSetLocalization defines model for GameCenterLeaderboardSetLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalization
+type GameCenterLeaderboardSetLocalization struct {
+	Attributes    *GameCenterLeaderboardSetLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                             `json:"id"`
+	Links         ResourceLinks                                      `json:"links"`
+	Relationships *GameCenterLeaderboardSetLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                             `json:"type"`
+}
+
+// GameCenterLeaderboardSetLocalizationAttributes defines model for GameCenterLeaderboardSetLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalization/attributes
+type GameCenterLeaderboardSetLocalizationAttributes struct {
+	Locale *string `json:"locale,omitempty"`
+	Name   *string `json:"name,omitempty"`
+}
+
+// GameCenterLeaderboardSetLocalizationRelationships defines model for GameCenterLeaderboardSetLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalization/relationships
+type GameCenterLeaderboardSetLocalizationRelationships struct {
+	GameCenterLeaderboardSet      *Relationship `json:"gameCenterLeaderboardSet,omitempty"`
+	GameCenterLeaderboardSetImage *Relationship `json:"gameCenterLeaderboardSetImage,omitempty"`
+}
+
+// gameCenterLeaderboardSetLocalizationCreateRequest defines model for GameCenterLeaderboardSetLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationcreaterequest/data
+type gameCenterLeaderboardSetLocalizationCreateRequest struct {
+	Attributes    gameCenterLeaderboardSetLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterLeaderboardSetLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                         `json:"type"`
+}
+
+// gameCenterLeaderboardSetLocalizationCreateRequestAttributes are attributes for GameCenterLeaderboardSetLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationcreaterequest/data/attributes
+type gameCenterLeaderboardSetLocalizationCreateRequestAttributes struct {
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+}
+
+// gameCenterLeaderboardSetLocalizationCreateRequestRelationships are relationships for GameCenterLeaderboardSetLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationcreaterequest/data/relationships
+type gameCenterLeaderboardSetLocalizationCreateRequestRelationships struct {
+	GameCenterLeaderboardSet relationshipDeclaration `json:"gameCenterLeaderboardSet"`
+}
+
+// gameCenterLeaderboardSetLocalizationUpdateRequest defines model for GameCenterLeaderboardSetLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationupdaterequest/data
+type gameCenterLeaderboardSetLocalizationUpdateRequest struct {
+	Attributes *gameCenterLeaderboardSetLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                       `json:"id"`
+	Type       string                                                       `json:"type"`
+}
+
+// gameCenterLeaderboardSetLocalizationUpdateRequestAttributes are attributes for GameCenterLeaderboardSetLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationupdaterequest/data/attributes
+type gameCenterLeaderboardSetLocalizationUpdateRequestAttributes struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// GameCenterLeaderboardSetLocalizationResponse defines model for GameCenterLeaderboardSetLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationresponse
+type GameCenterLeaderboardSetLocalizationResponse struct {
+	Data     GameCenterLeaderboardSetLocalization                   `json:"data"`
+	Included []GameCenterLeaderboardSetLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                          `json:"links"`
+}
+
+// GameCenterLeaderboardSetLocalizationsResponse defines model for GameCenterLeaderboardSetLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalizationsresponse
+type GameCenterLeaderboardSetLocalizationsResponse struct {
+	Data     []GameCenterLeaderboardSetLocalization                 `json:"data"`
+	Included []GameCenterLeaderboardSetLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                     `json:"links"`
+	Meta     *PagingInformation                                     `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardSetLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterLeaderboardSetLocalizationResponse or GameCenterLeaderboardSetLocalizationsResponse.
+type GameCenterLeaderboardSetLocalizationResponseIncluded included
+
+// GameCenterLeaderboardSetImage defines model for GameCenterLeaderboardSetImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimage
+type GameCenterLeaderboardSetImage struct {
+	Attributes    *GameCenterLeaderboardSetImageAttributes    `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Links         ResourceLinks                               `json:"links"`
+	Relationships *GameCenterLeaderboardSetImageRelationships `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// GameCenterLeaderboardSetImageAttributes defines model for GameCenterLeaderboardSetImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimage/attributes
+type GameCenterLeaderboardSetImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// GameCenterLeaderboardSetImageRelationships defines model for GameCenterLeaderboardSetImage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimage/relationships
+type GameCenterLeaderboardSetImageRelationships struct {
+	GameCenterLeaderboardSetLocalization *Relationship `json:"gameCenterLeaderboardSetLocalization,omitempty"`
+}
+
+// gameCenterLeaderboardSetImageCreateRequest defines model for GameCenterLeaderboardSetImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimagecreaterequest/data
+type gameCenterLeaderboardSetImageCreateRequest struct {
+	Attributes    gameCenterLeaderboardSetImageCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterLeaderboardSetImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                                  `json:"type"`
+}
+
+// gameCenterLeaderboardSetImageCreateRequestAttributes are attributes for GameCenterLeaderboardSetImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimagecreaterequest/data/attributes
+type gameCenterLeaderboardSetImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// gameCenterLeaderboardSetImageCreateRequestRelationships are relationships for GameCenterLeaderboardSetImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimagecreaterequest/data/relationships
+type gameCenterLeaderboardSetImageCreateRequestRelationships struct {
+	GameCenterLeaderboardSetLocalization relationshipDeclaration `json:"gameCenterLeaderboardSetLocalization"`
+}
+
+// gameCenterLeaderboardSetImageUpdateRequest defines model for GameCenterLeaderboardSetImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimageupdaterequest/data
+type gameCenterLeaderboardSetImageUpdateRequest struct {
+	Attributes *gameCenterLeaderboardSetImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                `json:"id"`
+	Type       string                                                `json:"type"`
+}
+
+// gameCenterLeaderboardSetImageUpdateRequestAttributes are attributes for GameCenterLeaderboardSetImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimageupdaterequest/data/attributes
+type gameCenterLeaderboardSetImageUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// GameCenterLeaderboardSetImageResponse defines model for GameCenterLeaderboardSetImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetimageresponse
+type GameCenterLeaderboardSetImageResponse struct {
+	Data     GameCenterLeaderboardSetImage          `json:"data"`
+	Included []GameCenterLeaderboardSetLocalization `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// ListGameCenterLeaderboardSetLocalizationsQuery are query options for ListLocalizationsForGameCenterLeaderboardSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_game_center_leaderboard_set
+type ListGameCenterLeaderboardSetLocalizationsQuery struct {
+	FieldsGameCenterLeaderboardSets             []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboardSetImages        []string `url:"fields[gameCenterLeaderboardSetImages],omitempty"`
+	FieldsGameCenterLeaderboardSetLocalizations []string `url:"fields[gameCenterLeaderboardSetLocalizations],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardSetLocalizationQuery are query options for GetGameCenterLeaderboardSetLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_localization_information
+type GetGameCenterLeaderboardSetLocalizationQuery struct {
+	FieldsGameCenterLeaderboardSets             []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboardSetImages        []string `url:"fields[gameCenterLeaderboardSetImages],omitempty"`
+	FieldsGameCenterLeaderboardSetLocalizations []string `url:"fields[gameCenterLeaderboardSetLocalizations],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+}
+
+// GetGameCenterLeaderboardSetImageQuery are query options for GetGameCenterLeaderboardSetImage and GetGameCenterLeaderboardSetImageForLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_image_information
+type GetGameCenterLeaderboardSetImageQuery struct {
+	FieldsGameCenterLeaderboardSetImages        []string `url:"fields[gameCenterLeaderboardSetImages],omitempty"`
+	FieldsGameCenterLeaderboardSetLocalizations []string `url:"fields[gameCenterLeaderboardSetLocalizations],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+}
+
+// ListLocalizationsForGameCenterLeaderboardSet lists the localizations of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_game_center_leaderboard_set
+func (s *GameCenterService) ListLocalizationsForGameCenterLeaderboardSet(ctx context.Context, id string, params *ListGameCenterLeaderboardSetLocalizationsQuery) (*GameCenterLeaderboardSetLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSets/%s/localizations", id)
+	res := new(GameCenterLeaderboardSetLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardSetLocalization gets a specific localization of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_localization_information
+func (s *GameCenterService) GetGameCenterLeaderboardSetLocalization(ctx context.Context, id string, params *GetGameCenterLeaderboardSetLocalizationQuery) (*GameCenterLeaderboardSetLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetLocalizations/%s", id)
+	res := new(GameCenterLeaderboardSetLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardSetLocalization adds a localized name to a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_set_localization
+func (s *GameCenterService) CreateGameCenterLeaderboardSetLocalization(ctx context.Context, locale string, name string, gameCenterLeaderboardSetID string) (*GameCenterLeaderboardSetLocalizationResponse, *Response, error) {
+	req := gameCenterLeaderboardSetLocalizationCreateRequest{
+		Attributes: gameCenterLeaderboardSetLocalizationCreateRequestAttributes{
+			Locale: locale,
+			Name:   name,
+		},
+		Relationships: gameCenterLeaderboardSetLocalizationCreateRequestRelationships{
+			GameCenterLeaderboardSet: *newRelationshipDeclaration(&gameCenterLeaderboardSetID, "gameCenterLeaderboardSets"),
+		},
+		Type: "gameCenterLeaderboardSetLocalizations",
+	}
+	res := new(GameCenterLeaderboardSetLocalizationResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardSetLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterLeaderboardSetLocalization changes the localized name of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard_set_localization
+func (s *GameCenterService) UpdateGameCenterLeaderboardSetLocalization(ctx context.Context, id string, name *string) (*GameCenterLeaderboardSetLocalizationResponse, *Response, error) {
+	req := gameCenterLeaderboardSetLocalizationUpdateRequest{
+		ID:   id,
+		Type: "gameCenterLeaderboardSetLocalizations",
+	}
+
+	if name != nil {
+		req.Attributes = &gameCenterLeaderboardSetLocalizationUpdateRequestAttributes{
+			Name: name,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterLeaderboardSetLocalizations/%s", id)
+	res := new(GameCenterLeaderboardSetLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardSetLocalization deletes a localization of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_set_localization
+func (s *GameCenterService) DeleteGameCenterLeaderboardSetLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetGameCenterLeaderboardSetImage gets information about a Game Center leaderboard set image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_image_information
+func (s *GameCenterService) GetGameCenterLeaderboardSetImage(ctx context.Context, id string, params *GetGameCenterLeaderboardSetImageQuery) (*GameCenterLeaderboardSetImageResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetImages/%s", id)
+	res := new(GameCenterLeaderboardSetImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardSetImageForLocalization gets the image attached to a localization of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_image_for_a_game_center_leaderboard_set_localization
+func (s *GameCenterService) GetGameCenterLeaderboardSetImageForLocalization(ctx context.Context, id string, params *GetGameCenterLeaderboardSetImageQuery) (*GameCenterLeaderboardSetImageResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetLocalizations/%s/gameCenterLeaderboardSetImage", id)
+	res := new(GameCenterLeaderboardSetImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardSetImage reserves an image for a localization of a Game Center leaderboard set. Upload the file with the
+// returned upload operations using Client.Upload, then commit it with CommitGameCenterLeaderboardSetImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_set_image
+func (s *GameCenterService) CreateGameCenterLeaderboardSetImage(ctx context.Context, fileName string, fileSize int64, gameCenterLeaderboardSetLocalizationID string) (*GameCenterLeaderboardSetImageResponse, *Response, error) {
+	req := gameCenterLeaderboardSetImageCreateRequest{
+		Attributes: gameCenterLeaderboardSetImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: gameCenterLeaderboardSetImageCreateRequestRelationships{
+			GameCenterLeaderboardSetLocalization: *newRelationshipDeclaration(&gameCenterLeaderboardSetLocalizationID, "gameCenterLeaderboardSetLocalizations"),
+		},
+		Type: "gameCenterLeaderboardSetImages",
+	}
+	res := new(GameCenterLeaderboardSetImageResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardSetImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitGameCenterLeaderboardSetImage commits a Game Center leaderboard set image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard_set_image
+func (s *GameCenterService) CommitGameCenterLeaderboardSetImage(ctx context.Context, id string, uploaded *bool) (*GameCenterLeaderboardSetImageResponse, *Response, error) {
+	req := gameCenterLeaderboardSetImageUpdateRequest{
+		ID:   id,
+		Type: "gameCenterLeaderboardSetImages",
+	}
+
+	if uploaded != nil {
+		req.Attributes = &gameCenterLeaderboardSetImageUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterLeaderboardSetImages/%s", id)
+	res := new(GameCenterLeaderboardSetImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardSetImage deletes a Game Center leaderboard set image.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_set_image
+func (s *GameCenterService) DeleteGameCenterLeaderboardSetImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterLeaderboardSetLocalizationResponseIncluded.
+func (i *GameCenterLeaderboardSetLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterLeaderboardSet returns the GameCenterLeaderboardSet stored within, if one is present.
+func (i *GameCenterLeaderboardSetLocalizationResponseIncluded) GameCenterLeaderboardSet() *GameCenterLeaderboardSet {
+	return extractIncludedGameCenterLeaderboardSet(i.inner)
+}
+
+// GameCenterLeaderboardSetImage returns the GameCenterLeaderboardSetImage stored within, if one is present.
+func (i *GameCenterLeaderboardSetLocalizationResponseIncluded) GameCenterLeaderboardSetImage() *GameCenterLeaderboardSetImage {
+	return extractIncludedGameCenterLeaderboardSetImage(i.inner)
+}
@@ -0,0 +1,124 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLocalizationsForGameCenterLeaderboardSet(ctx, "10", &ListGameCenterLeaderboardSetLocalizationsQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardSetLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardSetLocalization(ctx, "10", &GetGameCenterLeaderboardSetLocalizationQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardSetLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardSetLocalization(ctx, "en-US", "Seasons", "10")
+	})
+}
+
+func TestUpdateGameCenterLeaderboardSetLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterLeaderboardSetLocalization(ctx, "10", String("Seasons"))
+	})
+}
+
+func TestDeleteGameCenterLeaderboardSetLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardSetLocalization(ctx, "10")
+	})
+}
+
+func TestGetGameCenterLeaderboardSetImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardSetImage(ctx, "10", &GetGameCenterLeaderboardSetImageQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardSetImageForLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardSetImageForLocalization(ctx, "10", &GetGameCenterLeaderboardSetImageQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardSetImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardSetImage(ctx, "image.png", 1024, "10")
+	})
+}
+
+func TestCommitGameCenterLeaderboardSetImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CommitGameCenterLeaderboardSetImage(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteGameCenterLeaderboardSetImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardSetImage(ctx, "10")
+	})
+}
+
+func TestGetGameCenterLeaderboardSetLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterLeaderboardSets"},{"type":"gameCenterLeaderboardSetImages"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.GameCenter.GetGameCenterLeaderboardSetLocalization(ctx, "10", &GetGameCenterLeaderboardSetLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].GameCenterLeaderboardSet())
+		assert.NotNil(t, localization.Included[1].GameCenterLeaderboardSetImage())
+
+		assert.Nil(t, localization.Included[0].GameCenterLeaderboardSetImage())
+		assert.Nil(t, localization.Included[1].GameCenterLeaderboardSet())
+	})
+}
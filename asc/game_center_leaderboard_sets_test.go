@@ -0,0 +1,140 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterLeaderboardSetsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardSetsForGameCenterDetail(ctx, "10", &ListGameCenterLeaderboardSetsQuery{})
+	})
+}
+
+func TestListGameCenterLeaderboardSetsForGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardSetsForGameCenterGroup(ctx, "10", &ListGameCenterLeaderboardSetsQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardSet(ctx, "10", &GetGameCenterLeaderboardSetQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardSet(ctx, "Seasons", "seasons", nil, String("10"))
+	})
+}
+
+func TestUpdateGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterLeaderboardSet(ctx, "10", String("Seasons"))
+	})
+}
+
+func TestDeleteGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardSet(ctx, "10")
+	})
+}
+
+func TestListGameCenterLeaderboardsForGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardsForGameCenterLeaderboardSet(ctx, "10", &ListGameCenterLeaderboardsQuery{})
+	})
+}
+
+func TestListGameCenterLeaderboardIDsForGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetGameCenterLeaderboardsLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardIDsForGameCenterLeaderboardSet(ctx, "10", &ListGameCenterLeaderboardIDsForGameCenterLeaderboardSetQuery{})
+	})
+}
+
+func TestAddGameCenterLeaderboardsToGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.AddGameCenterLeaderboardsToGameCenterLeaderboardSet(ctx, "10", []string{"10"})
+	})
+}
+
+func TestReplaceGameCenterLeaderboardsForGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.ReplaceGameCenterLeaderboardsForGameCenterLeaderboardSet(ctx, "10", []string{"10"})
+	})
+}
+
+func TestRemoveGameCenterLeaderboardsFromGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.RemoveGameCenterLeaderboardsFromGameCenterLeaderboardSet(ctx, "10", []string{"10"})
+	})
+}
+
+func TestGetGameCenterLeaderboardSetIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterDetails"},{"type":"gameCenterGroups"},{"type":"gameCenterLeaderboards"},{"type":"gameCenterLeaderboardSets"},{"type":"gameCenterLeaderboardSetLocalizations"},{"type":"gameCenterLeaderboardSetReleases"}]}`, func(ctx context.Context, client *Client) {
+		set, _, err := client.GameCenter.GetGameCenterLeaderboardSet(ctx, "10", &GetGameCenterLeaderboardSetQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, set.Included)
+
+		assert.NotNil(t, set.Included[0].GameCenterDetail())
+		assert.NotNil(t, set.Included[1].GameCenterGroup())
+		assert.NotNil(t, set.Included[2].GameCenterLeaderboard())
+		assert.NotNil(t, set.Included[3].GameCenterLeaderboardSet())
+		assert.NotNil(t, set.Included[4].GameCenterLeaderboardSetLocalization())
+		assert.NotNil(t, set.Included[5].GameCenterLeaderboardSetRelease())
+
+		assert.Nil(t, set.Included[0].GameCenterGroup())
+		assert.Nil(t, set.Included[1].GameCenterLeaderboard())
+		assert.Nil(t, set.Included[2].GameCenterLeaderboardSet())
+		assert.Nil(t, set.Included[3].GameCenterLeaderboardSetLocalization())
+		assert.Nil(t, set.Included[4].GameCenterLeaderboardSetRelease())
+		assert.Nil(t, set.Included[5].GameCenterDetail())
+	})
+}
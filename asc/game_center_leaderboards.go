@@ -0,0 +1,381 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterLeaderboardFormatter defines model for GameCenterLeaderboardFormatter.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardformatter
+type GameCenterLeaderboardFormatter string
+
+const (
+	// GameCenterLeaderboardFormatterInteger is a leaderboard formatter for Integer.
+	GameCenterLeaderboardFormatterInteger GameCenterLeaderboardFormatter = "INTEGER"
+	// GameCenterLeaderboardFormatterDecimalPoint1Place is a leaderboard formatter for DecimalPoint1Place.
+	GameCenterLeaderboardFormatterDecimalPoint1Place GameCenterLeaderboardFormatter = "DECIMAL_POINT_1_PLACE"
+	// GameCenterLeaderboardFormatterDecimalPoint2Place is a leaderboard formatter for DecimalPoint2Place.
+	GameCenterLeaderboardFormatterDecimalPoint2Place GameCenterLeaderboardFormatter = "DECIMAL_POINT_2_PLACE"
+	// GameCenterLeaderboardFormatterDecimalPoint3Place is a leaderboard formatter for DecimalPoint3Place.
+	GameCenterLeaderboardFormatterDecimalPoint3Place GameCenterLeaderboardFormatter = "DECIMAL_POINT_3_PLACE"
+	// GameCenterLeaderboardFormatterElapsedTimeCentisecond is a leaderboard formatter for ElapsedTimeCentisecond.
+	GameCenterLeaderboardFormatterElapsedTimeCentisecond GameCenterLeaderboardFormatter = "ELAPSED_TIME_CENTISECOND"
+	// GameCenterLeaderboardFormatterElapsedTimeMinute is a leaderboard formatter for ElapsedTimeMinute.
+	GameCenterLeaderboardFormatterElapsedTimeMinute GameCenterLeaderboardFormatter = "ELAPSED_TIME_MINUTE"
+	// GameCenterLeaderboardFormatterElapsedTimeSecond is a leaderboard formatter for ElapsedTimeSecond.
+	GameCenterLeaderboardFormatterElapsedTimeSecond GameCenterLeaderboardFormatter = "ELAPSED_TIME_SECOND"
+	// GameCenterLeaderboardFormatterMoneyDollar is a leaderboard formatter for MoneyDollar.
+	GameCenterLeaderboardFormatterMoneyDollar GameCenterLeaderboardFormatter = "MONEY_DOLLAR"
+	// GameCenterLeaderboardFormatterMoneyDollarDecimal is a leaderboard formatter for MoneyDollarDecimal.
+	GameCenterLeaderboardFormatterMoneyDollarDecimal GameCenterLeaderboardFormatter = "MONEY_DOLLAR_DECIMAL"
+	// GameCenterLeaderboardFormatterMoneyEuro is a leaderboard formatter for MoneyEuro.
+	GameCenterLeaderboardFormatterMoneyEuro GameCenterLeaderboardFormatter = "MONEY_EURO"
+	// GameCenterLeaderboardFormatterMoneyEuroDecimal is a leaderboard formatter for MoneyEuroDecimal.
+	GameCenterLeaderboardFormatterMoneyEuroDecimal GameCenterLeaderboardFormatter = "MONEY_EURO_DECIMAL"
+	// GameCenterLeaderboardFormatterMoneyFranc is a leaderboard formatter for MoneyFranc.
+	GameCenterLeaderboardFormatterMoneyFranc GameCenterLeaderboardFormatter = "MONEY_FRANC"
+	// GameCenterLeaderboardFormatterMoneyFrancDecimal is a leaderboard formatter for MoneyFrancDecimal.
+	GameCenterLeaderboardFormatterMoneyFrancDecimal GameCenterLeaderboardFormatter = "MONEY_FRANC_DECIMAL"
+	// GameCenterLeaderboardFormatterMoneyKroner is a leaderboard formatter for MoneyKroner.
+	GameCenterLeaderboardFormatterMoneyKroner GameCenterLeaderboardFormatter = "MONEY_KRONER"
+	// GameCenterLeaderboardFormatterMoneyKronerDecimal is a leaderboard formatter for MoneyKronerDecimal.
+	GameCenterLeaderboardFormatterMoneyKronerDecimal GameCenterLeaderboardFormatter = "MONEY_KRONER_DECIMAL"
+	// GameCenterLeaderboardFormatterMoneyPound is a leaderboard formatter for MoneyPound.
+	GameCenterLeaderboardFormatterMoneyPound GameCenterLeaderboardFormatter = "MONEY_POUND"
+	// GameCenterLeaderboardFormatterMoneyPoundDecimal is a leaderboard formatter for MoneyPoundDecimal.
+	GameCenterLeaderboardFormatterMoneyPoundDecimal GameCenterLeaderboardFormatter = "MONEY_POUND_DECIMAL"
+	// GameCenterLeaderboardFormatterMoneyYen is a leaderboard formatter for MoneyYen.
+	GameCenterLeaderboardFormatterMoneyYen GameCenterLeaderboardFormatter = "MONEY_YEN"
+)
+
+// GameCenterLeaderboardSubmissionType defines model for GameCenterLeaderboard.Attributes.SubmissionType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/attributes
+type GameCenterLeaderboardSubmissionType string
+
+const (
+	// GameCenterLeaderboardSubmissionTypeBestScore is a leaderboard submission type for BestScore.
+	GameCenterLeaderboardSubmissionTypeBestScore GameCenterLeaderboardSubmissionType = "BEST_SCORE"
+	// GameCenterLeaderboardSubmissionTypeMostRecentScore is a leaderboard submission type for MostRecentScore.
+	GameCenterLeaderboardSubmissionTypeMostRecentScore GameCenterLeaderboardSubmissionType = "MOST_RECENT_SCORE"
+)
+
+// GameCenterLeaderboardScoreSortType defines model for GameCenterLeaderboard.Attributes.ScoreSortType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/attributes
+type GameCenterLeaderboardScoreSortType string
+
+const (
+	// GameCenterLeaderboardScoreSortTypeAscending is a leaderboard score sort type for Ascending.
+	GameCenterLeaderboardScoreSortTypeAscending GameCenterLeaderboardScoreSortType = "ASC"
+	// GameCenterLeaderboardScoreSortTypeDescending is a leaderboard score sort type for Descending.
+	GameCenterLeaderboardScoreSortTypeDescending GameCenterLeaderboardScoreSortType = "DESC"
+)
+
+// GameCenterLeaderboard defines model for GameCenterLeaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard
+type GameCenterLeaderboard struct {
+	Attributes    *GameCenterLeaderboardAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *GameCenterLeaderboardRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// GameCenterLeaderboardAttributes defines model for GameCenterLeaderboard.Attributes
+//
+// Scores are submitted as 64-bit integers, so ScoreRangeStart and ScoreRangeEnd are
+// carried as strings to preserve their precision.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/attributes
+type GameCenterLeaderboardAttributes struct {
+	Archived            *bool                                `json:"archived,omitempty"`
+	DefaultFormatter    *GameCenterLeaderboardFormatter      `json:"defaultFormatter,omitempty"`
+	RecurrenceDuration  *string                              `json:"recurrenceDuration,omitempty"`
+	RecurrenceRule      *string                              `json:"recurrenceRule,omitempty"`
+	RecurrenceStartDate *DateTime                            `json:"recurrenceStartDate,omitempty"`
+	ReferenceName       *string                              `json:"referenceName,omitempty"`
+	ScoreRangeEnd       *string                              `json:"scoreRangeEnd,omitempty"`
+	ScoreRangeStart     *string                              `json:"scoreRangeStart,omitempty"`
+	ScoreSortType       *GameCenterLeaderboardScoreSortType  `json:"scoreSortType,omitempty"`
+	SubmissionType      *GameCenterLeaderboardSubmissionType `json:"submissionType,omitempty"`
+	VendorIdentifier    *string                              `json:"vendorIdentifier,omitempty"`
+}
+
+// GameCenterLeaderboardRelationships defines model for GameCenterLeaderboard.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/relationships
+type GameCenterLeaderboardRelationships struct {
+	GameCenterDetail          *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup           *Relationship      `json:"gameCenterGroup,omitempty"`
+	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
+	GroupLeaderboard          *Relationship      `json:"groupLeaderboard,omitempty"`
+	Localizations             *PagedRelationship `json:"localizations,omitempty"`
+	Releases                  *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterLeaderboardCreateRequest defines model for GameCenterLeaderboardCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardcreaterequest/data
+type gameCenterLeaderboardCreateRequest struct {
+	Attributes    GameCenterLeaderboardCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterLeaderboardCreateRequestRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterLeaderboardCreateRequestAttributes are attributes for GameCenterLeaderboardCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardcreaterequest/data/attributes
+type GameCenterLeaderboardCreateRequestAttributes struct {
+	DefaultFormatter    GameCenterLeaderboardFormatter      `json:"defaultFormatter"`
+	RecurrenceDuration  *string                             `json:"recurrenceDuration,omitempty"`
+	RecurrenceRule      *string                             `json:"recurrenceRule,omitempty"`
+	RecurrenceStartDate *DateTime                           `json:"recurrenceStartDate,omitempty"`
+	ReferenceName       string                              `json:"referenceName"`
+	ScoreRangeEnd       *string                             `json:"scoreRangeEnd,omitempty"`
+	ScoreRangeStart     *string                             `json:"scoreRangeStart,omitempty"`
+	ScoreSortType       GameCenterLeaderboardScoreSortType  `json:"scoreSortType"`
+	SubmissionType      GameCenterLeaderboardSubmissionType `json:"submissionType"`
+	VendorIdentifier    string                              `json:"vendorIdentifier"`
+}
+
+// gameCenterLeaderboardCreateRequestRelationships are relationships for GameCenterLeaderboardCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardcreaterequest/data/relationships
+type gameCenterLeaderboardCreateRequestRelationships struct {
+	GameCenterDetail *relationshipDeclaration `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// gameCenterLeaderboardUpdateRequest defines model for GameCenterLeaderboardUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardupdaterequest/data
+type gameCenterLeaderboardUpdateRequest struct {
+	Attributes *GameCenterLeaderboardUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// GameCenterLeaderboardUpdateRequestAttributes are attributes for GameCenterLeaderboardUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardupdaterequest/data/attributes
+type GameCenterLeaderboardUpdateRequestAttributes struct {
+	Archived            *bool                                `json:"archived,omitempty"`
+	DefaultFormatter    *GameCenterLeaderboardFormatter      `json:"defaultFormatter,omitempty"`
+	RecurrenceDuration  *string                              `json:"recurrenceDuration,omitempty"`
+	RecurrenceRule      *string                              `json:"recurrenceRule,omitempty"`
+	RecurrenceStartDate *DateTime                            `json:"recurrenceStartDate,omitempty"`
+	ReferenceName       *string                              `json:"referenceName,omitempty"`
+	ScoreRangeEnd       *string                              `json:"scoreRangeEnd,omitempty"`
+	ScoreRangeStart     *string                              `json:"scoreRangeStart,omitempty"`
+	ScoreSortType       *GameCenterLeaderboardScoreSortType  `json:"scoreSortType,omitempty"`
+	SubmissionType      *GameCenterLeaderboardSubmissionType `json:"submissionType,omitempty"`
+}
+
+// GameCenterLeaderboardResponse defines model for GameCenterLeaderboardResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardresponse
+type GameCenterLeaderboardResponse struct {
+	Data     GameCenterLeaderboard                   `json:"data"`
+	Included []GameCenterLeaderboardResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                           `json:"links"`
+}
+
+// GameCenterLeaderboardsResponse defines model for GameCenterLeaderboardsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsresponse
+type GameCenterLeaderboardsResponse struct {
+	Data     []GameCenterLeaderboard                 `json:"data"`
+	Included []GameCenterLeaderboardResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                      `json:"links"`
+	Meta     *PagingInformation                      `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterLeaderboardResponse or GameCenterLeaderboardsResponse.
+type GameCenterLeaderboardResponseIncluded included
+
+// ListGameCenterLeaderboardsQuery are query options for ListGameCenterLeaderboardsForGameCenterDetail,
+// ListGameCenterLeaderboardsForGameCenterGroup and ListGameCenterLeaderboardsForGameCenterLeaderboardSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_game_center_details
+type ListGameCenterLeaderboardsQuery struct {
+	FieldsGameCenterDetails                  []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                   []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardReleases      []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets          []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards             []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterArchived                           []string `url:"filter[archived],omitempty"`
+	FilterID                                 []string `url:"filter[id],omitempty"`
+	FilterReferenceName                      []string `url:"filter[referenceName],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	LimitGameCenterLeaderboardSets           int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+	LimitReleases                            int      `url:"limit[releases],omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardQuery are query options for GetGameCenterLeaderboard
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_information
+type GetGameCenterLeaderboardQuery struct {
+	FieldsGameCenterDetails                  []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                   []string `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardReleases      []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets          []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards             []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	LimitGameCenterLeaderboardSets           int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+	LimitReleases                            int      `url:"limit[releases],omitempty"`
+}
+
+// ListGameCenterLeaderboardsForGameCenterDetail lists the leaderboards defined for an app's Game Center configuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_game_center_details
+func (s *GameCenterService) ListGameCenterLeaderboardsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardsQuery) (*GameCenterLeaderboardsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/gameCenterLeaderboards", id)
+	res := new(GameCenterLeaderboardsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListGameCenterLeaderboardsForGameCenterGroup lists the leaderboards shared through a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterLeaderboardsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterLeaderboardsQuery) (*GameCenterLeaderboardsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s/gameCenterLeaderboards", id)
+	res := new(GameCenterLeaderboardsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboard gets a specific Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_information
+func (s *GameCenterService) GetGameCenterLeaderboard(ctx context.Context, id string, params *GetGameCenterLeaderboardQuery) (*GameCenterLeaderboardResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboards/%s", id)
+	res := new(GameCenterLeaderboardResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboard creates a classic or recurring leaderboard for either an app's Game Center configuration
+// or a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard
+func (s *GameCenterService) CreateGameCenterLeaderboard(ctx context.Context, attributes GameCenterLeaderboardCreateRequestAttributes, gameCenterDetailID *string, gameCenterGroupID *string) (*GameCenterLeaderboardResponse, *Response, error) {
+	req := gameCenterLeaderboardCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterLeaderboardCreateRequestRelationships{
+			GameCenterDetail: newRelationshipDeclaration(gameCenterDetailID, "gameCenterDetails"),
+			GameCenterGroup:  newRelationshipDeclaration(gameCenterGroupID, "gameCenterGroups"),
+		},
+		Type: "gameCenterLeaderboards",
+	}
+	res := new(GameCenterLeaderboardResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboards", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterLeaderboard modifies the formatting, score range, sorting, recurrence or archival state of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard
+func (s *GameCenterService) UpdateGameCenterLeaderboard(ctx context.Context, id string, attributes *GameCenterLeaderboardUpdateRequestAttributes) (*GameCenterLeaderboardResponse, *Response, error) {
+	req := gameCenterLeaderboardUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterLeaderboards",
+	}
+	url := fmt.Sprintf("gameCenterLeaderboards/%s", id)
+	res := new(GameCenterLeaderboardResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ArchiveGameCenterLeaderboard archives a Game Center leaderboard so players can no longer submit scores to it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard
+func (s *GameCenterService) ArchiveGameCenterLeaderboard(ctx context.Context, id string) (*GameCenterLeaderboardResponse, *Response, error) {
+	return s.UpdateGameCenterLeaderboard(ctx, id, &GameCenterLeaderboardUpdateRequestAttributes{
+		Archived: Bool(true),
+	})
+}
+
+// DeleteGameCenterLeaderboard deletes a Game Center leaderboard that has not been released.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard
+func (s *GameCenterService) DeleteGameCenterLeaderboard(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboards/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterLeaderboardResponseIncluded.
+func (i *GameCenterLeaderboardResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterLeaderboardResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// GameCenterGroup returns the GameCenterGroup stored within, if one is present.
+func (i *GameCenterLeaderboardResponseIncluded) GameCenterGroup() *GameCenterGroup {
+	return extractIncludedGameCenterGroup(i.inner)
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterLeaderboardResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// GameCenterLeaderboardLocalization returns the GameCenterLeaderboardLocalization stored within, if one is present.
+func (i *GameCenterLeaderboardResponseIncluded) GameCenterLeaderboardLocalization() *GameCenterLeaderboardLocalization {
+	return extractIncludedGameCenterLeaderboardLocalization(i.inner)
+}
+
+// GameCenterLeaderboardRelease returns the GameCenterLeaderboardRelease stored within, if one is present.
+func (i *GameCenterLeaderboardResponseIncluded) GameCenterLeaderboardRelease() *GameCenterLeaderboardRelease {
+	return extractIncludedGameCenterLeaderboardRelease(i.inner)
+}
+
+// GameCenterLeaderboardSet returns the GameCenterLeaderboardSet stored within, if one is present.
+func (i *GameCenterLeaderboardResponseIncluded) GameCenterLeaderboardSet() *GameCenterLeaderboardSet {
+	return extractIncludedGameCenterLeaderboardSet(i.inner)
+}
@@ -0,0 +1,391 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterLeaderboardLocalization defines model for GameCenterLeaderboardLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalization
+type GameCenterLeaderboardLocalization struct {
+	Attributes    *GameCenterLeaderboardLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                          `json:"id"`
+	Links         ResourceLinks                                   `json:"links"`
+	Relationships *GameCenterLeaderboardLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterLeaderboardLocalizationAttributes defines model for GameCenterLeaderboardLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalization/attributes
+type GameCenterLeaderboardLocalizationAttributes struct {
+	FormatterOverride       *GameCenterLeaderboardFormatter `json:"formatterOverride,omitempty"`
+	FormatterSuffix         *string                         `json:"formatterSuffix,omitempty"`
+	FormatterSuffixSingular *string                         `json:"formatterSuffixSingular,omitempty"`
+	Locale                  *string                         `json:"locale,omitempty"`
+	Name                    *string                         `json:"name,omitempty"`
+}
+
+// GameCenterLeaderboardLocalizationRelationships defines model for GameCenterLeaderboardLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalization/relationships
+type GameCenterLeaderboardLocalizationRelationships struct {
+	GameCenterLeaderboard      *Relationship `json:"gameCenterLeaderboard,omitempty"`
+	GameCenterLeaderboardImage *Relationship `json:"gameCenterLeaderboardImage,omitempty"`
+}
+
+// gameCenterLeaderboardLocalizationCreateRequest defines model for GameCenterLeaderboardLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationcreaterequest/data
+type gameCenterLeaderboardLocalizationCreateRequest struct {
+	Attributes    GameCenterLeaderboardLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterLeaderboardLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                      `json:"type"`
+}
+
+// GameCenterLeaderboardLocalizationCreateRequestAttributes are attributes for GameCenterLeaderboardLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationcreaterequest/data/attributes
+type GameCenterLeaderboardLocalizationCreateRequestAttributes struct {
+	FormatterOverride       *GameCenterLeaderboardFormatter `json:"formatterOverride,omitempty"`
+	FormatterSuffix         *string                         `json:"formatterSuffix,omitempty"`
+	FormatterSuffixSingular *string                         `json:"formatterSuffixSingular,omitempty"`
+	Locale                  string                          `json:"locale"`
+	Name                    string                          `json:"name"`
+}
+
+// gameCenterLeaderboardLocalizationCreateRequestRelationships are relationships for GameCenterLeaderboardLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationcreaterequest/data/relationships
+type gameCenterLeaderboardLocalizationCreateRequestRelationships struct {
+	GameCenterLeaderboard relationshipDeclaration `json:"gameCenterLeaderboard"`
+}
+
+// gameCenterLeaderboardLocalizationUpdateRequest defines model for GameCenterLeaderboardLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationupdaterequest/data
+type gameCenterLeaderboardLocalizationUpdateRequest struct {
+	Attributes *GameCenterLeaderboardLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                    `json:"id"`
+	Type       string                                                    `json:"type"`
+}
+
+// GameCenterLeaderboardLocalizationUpdateRequestAttributes are attributes for GameCenterLeaderboardLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationupdaterequest/data/attributes
+type GameCenterLeaderboardLocalizationUpdateRequestAttributes struct {
+	FormatterOverride       *GameCenterLeaderboardFormatter `json:"formatterOverride,omitempty"`
+	FormatterSuffix         *string                         `json:"formatterSuffix,omitempty"`
+	FormatterSuffixSingular *string                         `json:"formatterSuffixSingular,omitempty"`
+	Name                    *string                         `json:"name,omitempty"`
+}
+
+// GameCenterLeaderboardLocalizationResponse defines model for GameCenterLeaderboardLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationresponse
+type GameCenterLeaderboardLocalizationResponse struct {
+	Data     GameCenterLeaderboardLocalization                   `json:"data"`
+	Included []GameCenterLeaderboardLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                       `json:"links"`
+}
+
+// GameCenterLeaderboardLocalizationsResponse defines model for GameCenterLeaderboardLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalizationsresponse
+type GameCenterLeaderboardLocalizationsResponse struct {
+	Data     []GameCenterLeaderboardLocalization                 `json:"data"`
+	Included []GameCenterLeaderboardLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                  `json:"links"`
+	Meta     *PagingInformation                                  `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterLeaderboardLocalizationResponse or GameCenterLeaderboardLocalizationsResponse.
+type GameCenterLeaderboardLocalizationResponseIncluded included
+
+// GameCenterLeaderboardImage defines model for GameCenterLeaderboardImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimage
+type GameCenterLeaderboardImage struct {
+	Attributes    *GameCenterLeaderboardImageAttributes    `json:"attributes,omitempty"`
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *GameCenterLeaderboardImageRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// GameCenterLeaderboardImageAttributes defines model for GameCenterLeaderboardImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimage/attributes
+type GameCenterLeaderboardImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// GameCenterLeaderboardImageRelationships defines model for GameCenterLeaderboardImage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimage/relationships
+type GameCenterLeaderboardImageRelationships struct {
+	GameCenterLeaderboardLocalization *Relationship `json:"gameCenterLeaderboardLocalization,omitempty"`
+}
+
+// gameCenterLeaderboardImageCreateRequest defines model for GameCenterLeaderboardImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimagecreaterequest/data
+type gameCenterLeaderboardImageCreateRequest struct {
+	Attributes    gameCenterLeaderboardImageCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterLeaderboardImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+// gameCenterLeaderboardImageCreateRequestAttributes are attributes for GameCenterLeaderboardImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimagecreaterequest/data/attributes
+type gameCenterLeaderboardImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// gameCenterLeaderboardImageCreateRequestRelationships are relationships for GameCenterLeaderboardImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimagecreaterequest/data/relationships
+type gameCenterLeaderboardImageCreateRequestRelationships struct {
+	GameCenterLeaderboardLocalization relationshipDeclaration `json:"gameCenterLeaderboardLocalization"`
+}
+
+// gameCenterLeaderboardImageUpdateRequest defines model for GameCenterLeaderboardImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimageupdaterequest/data
+type gameCenterLeaderboardImageUpdateRequest struct {
+	Attributes *gameCenterLeaderboardImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                             `json:"id"`
+	Type       string                                             `json:"type"`
+}
+
+// gameCenterLeaderboardImageUpdateRequestAttributes are attributes for GameCenterLeaderboardImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimageupdaterequest/data/attributes
+type gameCenterLeaderboardImageUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// GameCenterLeaderboardImageResponse defines model for GameCenterLeaderboardImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimageresponse
+type GameCenterLeaderboardImageResponse struct {
+	Data     GameCenterLeaderboardImage          `json:"data"`
+	Included []GameCenterLeaderboardLocalization `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// ListGameCenterLeaderboardLocalizationsQuery are query options for ListLocalizationsForGameCenterLeaderboard
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_game_center_leaderboard
+type ListGameCenterLeaderboardLocalizationsQuery struct {
+	FieldsGameCenterLeaderboards             []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FieldsGameCenterLeaderboardImages        []string `url:"fields[gameCenterLeaderboardImages],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardLocalizationQuery are query options for GetGameCenterLeaderboardLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_localization_information
+type GetGameCenterLeaderboardLocalizationQuery struct {
+	FieldsGameCenterLeaderboards             []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FieldsGameCenterLeaderboardImages        []string `url:"fields[gameCenterLeaderboardImages],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+}
+
+// GetGameCenterLeaderboardImageQuery are query options for GetGameCenterLeaderboardImage and GetGameCenterLeaderboardImageForLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_image_information
+type GetGameCenterLeaderboardImageQuery struct {
+	FieldsGameCenterLeaderboardImages        []string `url:"fields[gameCenterLeaderboardImages],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+}
+
+// ListLocalizationsForGameCenterLeaderboard lists the localizations of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_game_center_leaderboard
+func (s *GameCenterService) ListLocalizationsForGameCenterLeaderboard(ctx context.Context, id string, params *ListGameCenterLeaderboardLocalizationsQuery) (*GameCenterLeaderboardLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboards/%s/localizations", id)
+	res := new(GameCenterLeaderboardLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardLocalization gets a specific localization of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_localization_information
+func (s *GameCenterService) GetGameCenterLeaderboardLocalization(ctx context.Context, id string, params *GetGameCenterLeaderboardLocalizationQuery) (*GameCenterLeaderboardLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardLocalizations/%s", id)
+	res := new(GameCenterLeaderboardLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardLocalization adds a localization to a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_localization
+func (s *GameCenterService) CreateGameCenterLeaderboardLocalization(ctx context.Context, attributes GameCenterLeaderboardLocalizationCreateRequestAttributes, gameCenterLeaderboardID string) (*GameCenterLeaderboardLocalizationResponse, *Response, error) {
+	req := gameCenterLeaderboardLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterLeaderboardLocalizationCreateRequestRelationships{
+			GameCenterLeaderboard: *newRelationshipDeclaration(&gameCenterLeaderboardID, "gameCenterLeaderboards"),
+		},
+		Type: "gameCenterLeaderboardLocalizations",
+	}
+	res := new(GameCenterLeaderboardLocalizationResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterLeaderboardLocalization modifies a localization of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard_localization
+func (s *GameCenterService) UpdateGameCenterLeaderboardLocalization(ctx context.Context, id string, attributes *GameCenterLeaderboardLocalizationUpdateRequestAttributes) (*GameCenterLeaderboardLocalizationResponse, *Response, error) {
+	req := gameCenterLeaderboardLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterLeaderboardLocalizations",
+	}
+	url := fmt.Sprintf("gameCenterLeaderboardLocalizations/%s", id)
+	res := new(GameCenterLeaderboardLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardLocalization deletes a localization of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_localization
+func (s *GameCenterService) DeleteGameCenterLeaderboardLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetGameCenterLeaderboardImage gets information about a Game Center leaderboard image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_image_information
+func (s *GameCenterService) GetGameCenterLeaderboardImage(ctx context.Context, id string, params *GetGameCenterLeaderboardImageQuery) (*GameCenterLeaderboardImageResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardImages/%s", id)
+	res := new(GameCenterLeaderboardImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardImageForLocalization gets the image attached to a localization of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_image_for_a_game_center_leaderboard_localization
+func (s *GameCenterService) GetGameCenterLeaderboardImageForLocalization(ctx context.Context, id string, params *GetGameCenterLeaderboardImageQuery) (*GameCenterLeaderboardImageResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardLocalizations/%s/gameCenterLeaderboardImage", id)
+	res := new(GameCenterLeaderboardImageResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardImage reserves an image for a localization of a Game Center leaderboard. Upload the file with the
+// returned upload operations using Client.Upload, then commit it with CommitGameCenterLeaderboardImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_image
+func (s *GameCenterService) CreateGameCenterLeaderboardImage(ctx context.Context, fileName string, fileSize int64, gameCenterLeaderboardLocalizationID string) (*GameCenterLeaderboardImageResponse, *Response, error) {
+	req := gameCenterLeaderboardImageCreateRequest{
+		Attributes: gameCenterLeaderboardImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: gameCenterLeaderboardImageCreateRequestRelationships{
+			GameCenterLeaderboardLocalization: *newRelationshipDeclaration(&gameCenterLeaderboardLocalizationID, "gameCenterLeaderboardLocalizations"),
+		},
+		Type: "gameCenterLeaderboardImages",
+	}
+	res := new(GameCenterLeaderboardImageResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitGameCenterLeaderboardImage commits a Game Center leaderboard image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_leaderboard_image
+func (s *GameCenterService) CommitGameCenterLeaderboardImage(ctx context.Context, id string, uploaded *bool) (*GameCenterLeaderboardImageResponse, *Response, error) {
+	req := gameCenterLeaderboardImageUpdateRequest{
+		ID:   id,
+		Type: "gameCenterLeaderboardImages",
+	}
+
+	if uploaded != nil {
+		req.Attributes = &gameCenterLeaderboardImageUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterLeaderboardImages/%s", id)
+	res := new(GameCenterLeaderboardImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardImage deletes a Game Center leaderboard image.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_image
+func (s *GameCenterService) DeleteGameCenterLeaderboardImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterLeaderboardLocalizationResponseIncluded.
+func (i *GameCenterLeaderboardLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterLeaderboardLocalizationResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// GameCenterLeaderboardImage returns the GameCenterLeaderboardImage stored within, if one is present.
+func (i *GameCenterLeaderboardLocalizationResponseIncluded) GameCenterLeaderboardImage() *GameCenterLeaderboardImage {
+	return extractIncludedGameCenterLeaderboardImage(i.inner)
+}
@@ -0,0 +1,124 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLocalizationsForGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLocalizationsForGameCenterLeaderboard(ctx, "10", &ListGameCenterLeaderboardLocalizationsQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardLocalization(ctx, "10", &GetGameCenterLeaderboardLocalizationQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardLocalization(ctx, GameCenterLeaderboardLocalizationCreateRequestAttributes{Locale: "en-US", Name: "High Score"}, "10")
+	})
+}
+
+func TestUpdateGameCenterLeaderboardLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterLeaderboardLocalization(ctx, "10", &GameCenterLeaderboardLocalizationUpdateRequestAttributes{FormatterSuffix: String(" pts")})
+	})
+}
+
+func TestDeleteGameCenterLeaderboardLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardLocalization(ctx, "10")
+	})
+}
+
+func TestGetGameCenterLeaderboardImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardImage(ctx, "10", &GetGameCenterLeaderboardImageQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardImageForLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardImageForLocalization(ctx, "10", &GetGameCenterLeaderboardImageQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardImage(ctx, "image.png", 1024, "10")
+	})
+}
+
+func TestCommitGameCenterLeaderboardImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CommitGameCenterLeaderboardImage(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteGameCenterLeaderboardImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardImage(ctx, "10")
+	})
+}
+
+func TestGetGameCenterLeaderboardLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterLeaderboards"},{"type":"gameCenterLeaderboardImages"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.GameCenter.GetGameCenterLeaderboardLocalization(ctx, "10", &GetGameCenterLeaderboardLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].GameCenterLeaderboard())
+		assert.NotNil(t, localization.Included[1].GameCenterLeaderboardImage())
+
+		assert.Nil(t, localization.Included[0].GameCenterLeaderboardImage())
+		assert.Nil(t, localization.Included[1].GameCenterLeaderboard())
+	})
+}
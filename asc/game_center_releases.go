@@ -0,0 +1,497 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterAchievementRelease defines model for GameCenterAchievementRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease
+type GameCenterAchievementRelease struct {
+	Attributes    *GameCenterAchievementReleaseAttributes    `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *GameCenterAchievementReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// GameCenterAchievementReleaseAttributes defines model for GameCenterAchievementRelease.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease/attributes
+type GameCenterAchievementReleaseAttributes struct {
+	Live *bool `json:"live,omitempty"`
+}
+
+// GameCenterAchievementReleaseRelationships defines model for GameCenterAchievementRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease/relationships
+type GameCenterAchievementReleaseRelationships struct {
+	GameCenterAchievement *Relationship `json:"gameCenterAchievement,omitempty"`
+	GameCenterDetail      *Relationship `json:"gameCenterDetail,omitempty"`
+}
+
+// gameCenterAchievementReleaseCreateRequest defines model for GameCenterAchievementReleaseCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleasecreaterequest/data
+type gameCenterAchievementReleaseCreateRequest struct {
+	Relationships gameCenterAchievementReleaseCreateRequestRelationships `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// gameCenterAchievementReleaseCreateRequestRelationships are relationships for GameCenterAchievementReleaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleasecreaterequest/data/relationships
+type gameCenterAchievementReleaseCreateRequestRelationships struct {
+	GameCenterAchievement relationshipDeclaration `json:"gameCenterAchievement"`
+	GameCenterDetail      relationshipDeclaration `json:"gameCenterDetail"`
+}
+
+// GameCenterAchievementReleaseResponse defines model for GameCenterAchievementReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleaseresponse
+type GameCenterAchievementReleaseResponse struct {
+	Data     GameCenterAchievementRelease                   `json:"data"`
+	Included []GameCenterAchievementReleaseResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                  `json:"links"`
+}
+
+// GameCenterAchievementReleasesResponse defines model for GameCenterAchievementReleasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleasesresponse
+type GameCenterAchievementReleasesResponse struct {
+	Data     []GameCenterAchievementRelease                 `json:"data"`
+	Included []GameCenterAchievementReleaseResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                             `json:"links"`
+	Meta     *PagingInformation                             `json:"meta,omitempty"`
+}
+
+// GameCenterAchievementReleaseResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterAchievementReleaseResponse or GameCenterAchievementReleasesResponse.
+type GameCenterAchievementReleaseResponseIncluded included
+
+// ListGameCenterAchievementReleasesQuery are query options for ListGameCenterAchievementReleasesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievement_releases_for_game_center_details
+type ListGameCenterAchievementReleasesQuery struct {
+	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterAchievementReleases []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements        []string `url:"fields[gameCenterAchievements],omitempty"`
+	FilterGameCenterAchievement         []string `url:"filter[gameCenterAchievement],omitempty"`
+	FilterLive                          []string `url:"filter[live],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	Limit                               int      `url:"limit,omitempty"`
+	Cursor                              string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterAchievementReleaseQuery are query options for GetGameCenterAchievementRelease
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_release_information
+type GetGameCenterAchievementReleaseQuery struct {
+	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterAchievementReleases []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements        []string `url:"fields[gameCenterAchievements],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+}
+
+// ListGameCenterAchievementReleasesForGameCenterDetail lists the releases of achievements for an app's Game Center configuration.
+// Filter on FilterLive to see which achievements are currently live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievement_releases_for_game_center_details
+func (s *GameCenterService) ListGameCenterAchievementReleasesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterAchievementReleasesQuery) (*GameCenterAchievementReleasesResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/achievementReleases", id)
+	res := new(GameCenterAchievementReleasesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterAchievementRelease gets a specific release of a Game Center achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_achievement_release_information
+func (s *GameCenterService) GetGameCenterAchievementRelease(ctx context.Context, id string, params *GetGameCenterAchievementReleaseQuery) (*GameCenterAchievementReleaseResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementReleases/%s", id)
+	res := new(GameCenterAchievementReleaseResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterAchievementRelease releases a Game Center achievement to the live environment of an app's Game Center configuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_achievement_release
+func (s *GameCenterService) CreateGameCenterAchievementRelease(ctx context.Context, gameCenterDetailID string, gameCenterAchievementID string) (*GameCenterAchievementReleaseResponse, *Response, error) {
+	req := gameCenterAchievementReleaseCreateRequest{
+		Relationships: gameCenterAchievementReleaseCreateRequestRelationships{
+			GameCenterAchievement: *newRelationshipDeclaration(&gameCenterAchievementID, "gameCenterAchievements"),
+			GameCenterDetail:      *newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+		},
+		Type: "gameCenterAchievementReleases",
+	}
+	res := new(GameCenterAchievementReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievementReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterAchievementRelease removes a Game Center achievement from the live environment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_achievement_release
+func (s *GameCenterService) DeleteGameCenterAchievementRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GameCenterLeaderboardRelease defines model for GameCenterLeaderboardRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardrelease
+type GameCenterLeaderboardRelease struct {
+	Attributes    *GameCenterLeaderboardReleaseAttributes    `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *GameCenterLeaderboardReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// GameCenterLeaderboardReleaseAttributes defines model for GameCenterLeaderboardRelease.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardrelease/attributes
+type GameCenterLeaderboardReleaseAttributes struct {
+	Live *bool `json:"live,omitempty"`
+}
+
+// GameCenterLeaderboardReleaseRelationships defines model for GameCenterLeaderboardRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardrelease/relationships
+type GameCenterLeaderboardReleaseRelationships struct {
+	GameCenterLeaderboard *Relationship `json:"gameCenterLeaderboard,omitempty"`
+	GameCenterDetail      *Relationship `json:"gameCenterDetail,omitempty"`
+}
+
+// gameCenterLeaderboardReleaseCreateRequest defines model for GameCenterLeaderboardReleaseCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardreleasecreaterequest/data
+type gameCenterLeaderboardReleaseCreateRequest struct {
+	Relationships gameCenterLeaderboardReleaseCreateRequestRelationships `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// gameCenterLeaderboardReleaseCreateRequestRelationships are relationships for GameCenterLeaderboardReleaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardreleasecreaterequest/data/relationships
+type gameCenterLeaderboardReleaseCreateRequestRelationships struct {
+	GameCenterLeaderboard relationshipDeclaration `json:"gameCenterLeaderboard"`
+	GameCenterDetail      relationshipDeclaration `json:"gameCenterDetail"`
+}
+
+// GameCenterLeaderboardReleaseResponse defines model for GameCenterLeaderboardReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardreleaseresponse
+type GameCenterLeaderboardReleaseResponse struct {
+	Data     GameCenterLeaderboardRelease                   `json:"data"`
+	Included []GameCenterLeaderboardReleaseResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                  `json:"links"`
+}
+
+// GameCenterLeaderboardReleasesResponse defines model for GameCenterLeaderboardReleasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardreleasesresponse
+type GameCenterLeaderboardReleasesResponse struct {
+	Data     []GameCenterLeaderboardRelease                 `json:"data"`
+	Included []GameCenterLeaderboardReleaseResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                             `json:"links"`
+	Meta     *PagingInformation                             `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardReleaseResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterLeaderboardReleaseResponse or GameCenterLeaderboardReleasesResponse.
+type GameCenterLeaderboardReleaseResponseIncluded included
+
+// ListGameCenterLeaderboardReleasesQuery are query options for ListGameCenterLeaderboardReleasesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_releases_for_game_center_details
+type ListGameCenterLeaderboardReleasesQuery struct {
+	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterLeaderboardReleases []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboards        []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterGameCenterLeaderboard         []string `url:"filter[gameCenterLeaderboard],omitempty"`
+	FilterLive                          []string `url:"filter[live],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	Limit                               int      `url:"limit,omitempty"`
+	Cursor                              string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardReleaseQuery are query options for GetGameCenterLeaderboardRelease
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_release_information
+type GetGameCenterLeaderboardReleaseQuery struct {
+	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterLeaderboardReleases []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboards        []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+}
+
+// ListGameCenterLeaderboardReleasesForGameCenterDetail lists the releases of leaderboards for an app's Game Center configuration.
+// Filter on FilterLive to see which leaderboards are currently live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_releases_for_game_center_details
+func (s *GameCenterService) ListGameCenterLeaderboardReleasesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardReleasesQuery) (*GameCenterLeaderboardReleasesResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/leaderboardReleases", id)
+	res := new(GameCenterLeaderboardReleasesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardRelease gets a specific release of a Game Center leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_release_information
+func (s *GameCenterService) GetGameCenterLeaderboardRelease(ctx context.Context, id string, params *GetGameCenterLeaderboardReleaseQuery) (*GameCenterLeaderboardReleaseResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardReleases/%s", id)
+	res := new(GameCenterLeaderboardReleaseResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardRelease releases a Game Center leaderboard to the live environment of an app's Game Center configuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_release
+func (s *GameCenterService) CreateGameCenterLeaderboardRelease(ctx context.Context, gameCenterDetailID string, gameCenterLeaderboardID string) (*GameCenterLeaderboardReleaseResponse, *Response, error) {
+	req := gameCenterLeaderboardReleaseCreateRequest{
+		Relationships: gameCenterLeaderboardReleaseCreateRequestRelationships{
+			GameCenterLeaderboard: *newRelationshipDeclaration(&gameCenterLeaderboardID, "gameCenterLeaderboards"),
+			GameCenterDetail:      *newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+		},
+		Type: "gameCenterLeaderboardReleases",
+	}
+	res := new(GameCenterLeaderboardReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardRelease removes a Game Center leaderboard from the live environment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_release
+func (s *GameCenterService) DeleteGameCenterLeaderboardRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GameCenterLeaderboardSetRelease defines model for GameCenterLeaderboardSetRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetrelease
+type GameCenterLeaderboardSetRelease struct {
+	Attributes    *GameCenterLeaderboardSetReleaseAttributes    `json:"attributes,omitempty"`
+	ID            string                                        `json:"id"`
+	Links         ResourceLinks                                 `json:"links"`
+	Relationships *GameCenterLeaderboardSetReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                        `json:"type"`
+}
+
+// GameCenterLeaderboardSetReleaseAttributes defines model for GameCenterLeaderboardSetRelease.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetrelease/attributes
+type GameCenterLeaderboardSetReleaseAttributes struct {
+	Live *bool `json:"live,omitempty"`
+}
+
+// GameCenterLeaderboardSetReleaseRelationships defines model for GameCenterLeaderboardSetRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetrelease/relationships
+type GameCenterLeaderboardSetReleaseRelationships struct {
+	GameCenterLeaderboardSet *Relationship `json:"gameCenterLeaderboardSet,omitempty"`
+	GameCenterDetail         *Relationship `json:"gameCenterDetail,omitempty"`
+}
+
+// gameCenterLeaderboardSetReleaseCreateRequest defines model for GameCenterLeaderboardSetReleaseCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetreleasecreaterequest/data
+type gameCenterLeaderboardSetReleaseCreateRequest struct {
+	Relationships gameCenterLeaderboardSetReleaseCreateRequestRelationships `json:"relationships"`
+	Type          string                                                    `json:"type"`
+}
+
+// gameCenterLeaderboardSetReleaseCreateRequestRelationships are relationships for GameCenterLeaderboardSetReleaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetreleasecreaterequest/data/relationships
+type gameCenterLeaderboardSetReleaseCreateRequestRelationships struct {
+	GameCenterLeaderboardSet relationshipDeclaration `json:"gameCenterLeaderboardSet"`
+	GameCenterDetail         relationshipDeclaration `json:"gameCenterDetail"`
+}
+
+// GameCenterLeaderboardSetReleaseResponse defines model for GameCenterLeaderboardSetReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetreleaseresponse
+type GameCenterLeaderboardSetReleaseResponse struct {
+	Data     GameCenterLeaderboardSetRelease                   `json:"data"`
+	Included []GameCenterLeaderboardSetReleaseResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                     `json:"links"`
+}
+
+// GameCenterLeaderboardSetReleasesResponse defines model for GameCenterLeaderboardSetReleasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetreleasesresponse
+type GameCenterLeaderboardSetReleasesResponse struct {
+	Data     []GameCenterLeaderboardSetRelease                 `json:"data"`
+	Included []GameCenterLeaderboardSetReleaseResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                `json:"links"`
+	Meta     *PagingInformation                                `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardSetReleaseResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterLeaderboardSetReleaseResponse or GameCenterLeaderboardSetReleasesResponse.
+type GameCenterLeaderboardSetReleaseResponseIncluded included
+
+// ListGameCenterLeaderboardSetReleasesQuery are query options for ListGameCenterLeaderboardSetReleasesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_set_releases_for_game_center_details
+type ListGameCenterLeaderboardSetReleasesQuery struct {
+	FieldsGameCenterDetails                []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets        []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FilterGameCenterLeaderboardSet         []string `url:"filter[gameCenterLeaderboardSet],omitempty"`
+	FilterLive                             []string `url:"filter[live],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	Limit                                  int      `url:"limit,omitempty"`
+	Cursor                                 string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardSetReleaseQuery are query options for GetGameCenterLeaderboardSetRelease
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_release_information
+type GetGameCenterLeaderboardSetReleaseQuery struct {
+	FieldsGameCenterDetails                []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets        []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+}
+
+// ListGameCenterLeaderboardSetReleasesForGameCenterDetail lists the releases of leaderboard sets for an app's Game Center configuration.
+// Filter on FilterLive to see which leaderboard sets are currently live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_set_releases_for_game_center_details
+func (s *GameCenterService) ListGameCenterLeaderboardSetReleasesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardSetReleasesQuery) (*GameCenterLeaderboardSetReleasesResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/leaderboardSetReleases", id)
+	res := new(GameCenterLeaderboardSetReleasesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardSetRelease gets a specific release of a Game Center leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_leaderboard_set_release_information
+func (s *GameCenterService) GetGameCenterLeaderboardSetRelease(ctx context.Context, id string, params *GetGameCenterLeaderboardSetReleaseQuery) (*GameCenterLeaderboardSetReleaseResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetReleases/%s", id)
+	res := new(GameCenterLeaderboardSetReleaseResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateGameCenterLeaderboardSetRelease releases a Game Center leaderboard set to the live environment of an app's Game Center configuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_leaderboard_set_release
+func (s *GameCenterService) CreateGameCenterLeaderboardSetRelease(ctx context.Context, gameCenterDetailID string, gameCenterLeaderboardSetID string) (*GameCenterLeaderboardSetReleaseResponse, *Response, error) {
+	req := gameCenterLeaderboardSetReleaseCreateRequest{
+		Relationships: gameCenterLeaderboardSetReleaseCreateRequestRelationships{
+			GameCenterLeaderboardSet: *newRelationshipDeclaration(&gameCenterLeaderboardSetID, "gameCenterLeaderboardSets"),
+			GameCenterDetail:         *newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+		},
+		Type: "gameCenterLeaderboardSetReleases",
+	}
+	res := new(GameCenterLeaderboardSetReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardSetReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterLeaderboardSetRelease removes a Game Center leaderboard set from the live environment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_leaderboard_set_release
+func (s *GameCenterService) DeleteGameCenterLeaderboardSetRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterAchievementReleaseResponseIncluded.
+func (i *GameCenterAchievementReleaseResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterAchievementReleaseResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterAchievementReleaseResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterLeaderboardReleaseResponseIncluded.
+func (i *GameCenterLeaderboardReleaseResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterLeaderboardReleaseResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterLeaderboardReleaseResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterLeaderboardSetReleaseResponseIncluded.
+func (i *GameCenterLeaderboardSetReleaseResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterLeaderboardSet returns the GameCenterLeaderboardSet stored within, if one is present.
+func (i *GameCenterLeaderboardSetReleaseResponseIncluded) GameCenterLeaderboardSet() *GameCenterLeaderboardSet {
+	return extractIncludedGameCenterLeaderboardSet(i.inner)
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterLeaderboardSetReleaseResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
@@ -0,0 +1,172 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterAchievementReleasesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterAchievementReleasesForGameCenterDetail(ctx, "10", &ListGameCenterAchievementReleasesQuery{})
+	})
+}
+
+func TestGetGameCenterAchievementRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementRelease(ctx, "10", &GetGameCenterAchievementReleaseQuery{})
+	})
+}
+
+func TestCreateGameCenterAchievementRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievementRelease(ctx, "10", "20")
+	})
+}
+
+func TestDeleteGameCenterAchievementRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievementRelease(ctx, "10")
+	})
+}
+
+func TestListGameCenterLeaderboardReleasesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardReleasesForGameCenterDetail(ctx, "10", &ListGameCenterLeaderboardReleasesQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardRelease(ctx, "10", &GetGameCenterLeaderboardReleaseQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardRelease(ctx, "10", "20")
+	})
+}
+
+func TestDeleteGameCenterLeaderboardRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardRelease(ctx, "10")
+	})
+}
+
+func TestListGameCenterLeaderboardSetReleasesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardSetReleasesForGameCenterDetail(ctx, "10", &ListGameCenterLeaderboardSetReleasesQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboardSetRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardSetRelease(ctx, "10", &GetGameCenterLeaderboardSetReleaseQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardSetRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardSetRelease(ctx, "10", "20")
+	})
+}
+
+func TestDeleteGameCenterLeaderboardSetRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardSetRelease(ctx, "10")
+	})
+}
+
+func TestGetGameCenterAchievementReleaseIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterAchievements"},{"type":"gameCenterDetails"}]}`, func(ctx context.Context, client *Client) {
+		release, _, err := client.GameCenter.GetGameCenterAchievementRelease(ctx, "10", &GetGameCenterAchievementReleaseQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, release.Included)
+
+		assert.NotNil(t, release.Included[0].GameCenterAchievement())
+		assert.NotNil(t, release.Included[1].GameCenterDetail())
+
+		assert.Nil(t, release.Included[0].GameCenterDetail())
+		assert.Nil(t, release.Included[1].GameCenterAchievement())
+	})
+}
+
+func TestGetGameCenterLeaderboardReleaseIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterLeaderboards"},{"type":"gameCenterDetails"}]}`, func(ctx context.Context, client *Client) {
+		release, _, err := client.GameCenter.GetGameCenterLeaderboardRelease(ctx, "10", &GetGameCenterLeaderboardReleaseQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, release.Included)
+
+		assert.NotNil(t, release.Included[0].GameCenterLeaderboard())
+		assert.NotNil(t, release.Included[1].GameCenterDetail())
+
+		assert.Nil(t, release.Included[0].GameCenterDetail())
+		assert.Nil(t, release.Included[1].GameCenterLeaderboard())
+	})
+}
+
+func TestGetGameCenterLeaderboardSetReleaseIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterLeaderboardSets"},{"type":"gameCenterDetails"}]}`, func(ctx context.Context, client *Client) {
+		release, _, err := client.GameCenter.GetGameCenterLeaderboardSetRelease(ctx, "10", &GetGameCenterLeaderboardSetReleaseQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, release.Included)
+
+		assert.NotNil(t, release.Included[0].GameCenterLeaderboardSet())
+		assert.NotNil(t, release.Included[1].GameCenterDetail())
+
+		assert.Nil(t, release.Included[0].GameCenterDetail())
+		assert.Nil(t, release.Included[1].GameCenterLeaderboardSet())
+	})
+}
@@ -0,0 +1,88 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterDetail(ctx, "10", &GetGameCenterDetailQuery{})
+	})
+}
+
+func TestGetGameCenterDetailForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterDetailForApp(ctx, "10", &GetGameCenterDetailQuery{})
+	})
+}
+
+func TestCreateGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterDetail(ctx, Bool(true), "10", String("20"))
+	})
+}
+
+func TestUpdateGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterDetail(ctx, "10", Bool(false), String("20"), String("30"))
+	})
+}
+
+func TestGetGameCenterDetailIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"gameCenterAchievements"},{"type":"gameCenterAchievementReleases"},{"type":"gameCenterGroups"},{"type":"gameCenterLeaderboards"},{"type":"gameCenterLeaderboardReleases"},{"type":"gameCenterLeaderboardSets"},{"type":"gameCenterLeaderboardSetReleases"}]}`, func(ctx context.Context, client *Client) {
+		detail, _, err := client.GameCenter.GetGameCenterDetail(ctx, "10", &GetGameCenterDetailQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, detail.Included)
+
+		assert.NotNil(t, detail.Included[0].App())
+		assert.NotNil(t, detail.Included[1].GameCenterAchievement())
+		assert.NotNil(t, detail.Included[2].GameCenterAchievementRelease())
+		assert.NotNil(t, detail.Included[3].GameCenterGroup())
+		assert.NotNil(t, detail.Included[4].GameCenterLeaderboard())
+		assert.NotNil(t, detail.Included[5].GameCenterLeaderboardRelease())
+		assert.NotNil(t, detail.Included[6].GameCenterLeaderboardSet())
+		assert.NotNil(t, detail.Included[7].GameCenterLeaderboardSetRelease())
+
+		assert.Nil(t, detail.Included[0].GameCenterAchievement())
+		assert.Nil(t, detail.Included[1].GameCenterAchievementRelease())
+		assert.Nil(t, detail.Included[2].GameCenterGroup())
+		assert.Nil(t, detail.Included[3].GameCenterLeaderboard())
+		assert.Nil(t, detail.Included[4].GameCenterLeaderboardRelease())
+		assert.Nil(t, detail.Included[5].GameCenterLeaderboardSet())
+		assert.Nil(t, detail.Included[6].GameCenterLeaderboardSetRelease())
+		assert.Nil(t, detail.Included[7].App())
+	})
+}
@@ -0,0 +1,319 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchasesService handles communication with in-app purchase-related methods of the App Store Connect API
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/in-app_purchases
+type InAppPurchasesService service
+
+// InAppPurchaseType defines model for InAppPurchaseType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasetype
+type InAppPurchaseType string
+
+const (
+	// InAppPurchaseTypeConsumable is an in-app purchase type for Consumable.
+	InAppPurchaseTypeConsumable InAppPurchaseType = "CONSUMABLE"
+	// InAppPurchaseTypeNonConsumable is an in-app purchase type for NonConsumable.
+	InAppPurchaseTypeNonConsumable InAppPurchaseType = "NON_CONSUMABLE"
+	// InAppPurchaseTypeNonRenewingSubscription is an in-app purchase type for NonRenewingSubscription.
+	InAppPurchaseTypeNonRenewingSubscription InAppPurchaseType = "NON_RENEWING_SUBSCRIPTION"
+)
+
+// InAppPurchaseState defines model for InAppPurchaseState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasestate
+type InAppPurchaseState string
+
+const (
+	// InAppPurchaseStateMissingMetadata is an in-app purchase state for MissingMetadata.
+	InAppPurchaseStateMissingMetadata InAppPurchaseState = "MISSING_METADATA"
+	// InAppPurchaseStateWaitingForUpload is an in-app purchase state for WaitingForUpload.
+	InAppPurchaseStateWaitingForUpload InAppPurchaseState = "WAITING_FOR_UPLOAD"
+	// InAppPurchaseStateProcessingContent is an in-app purchase state for ProcessingContent.
+	InAppPurchaseStateProcessingContent InAppPurchaseState = "PROCESSING_CONTENT"
+	// InAppPurchaseStateReadyToSubmit is an in-app purchase state for ReadyToSubmit.
+	InAppPurchaseStateReadyToSubmit InAppPurchaseState = "READY_TO_SUBMIT"
+	// InAppPurchaseStateWaitingForReview is an in-app purchase state for WaitingForReview.
+	InAppPurchaseStateWaitingForReview InAppPurchaseState = "WAITING_FOR_REVIEW"
+	// InAppPurchaseStateInReview is an in-app purchase state for InReview.
+	InAppPurchaseStateInReview InAppPurchaseState = "IN_REVIEW"
+	// InAppPurchaseStateDeveloperActionNeeded is an in-app purchase state for DeveloperActionNeeded.
+	InAppPurchaseStateDeveloperActionNeeded InAppPurchaseState = "DEVELOPER_ACTION_NEEDED"
+	// InAppPurchaseStatePendingBinaryApproval is an in-app purchase state for PendingBinaryApproval.
+	InAppPurchaseStatePendingBinaryApproval InAppPurchaseState = "PENDING_BINARY_APPROVAL"
+	// InAppPurchaseStateApproved is an in-app purchase state for Approved.
+	InAppPurchaseStateApproved InAppPurchaseState = "APPROVED"
+	// InAppPurchaseStateDeveloperRemovedFromSale is an in-app purchase state for DeveloperRemovedFromSale.
+	InAppPurchaseStateDeveloperRemovedFromSale InAppPurchaseState = "DEVELOPER_REMOVED_FROM_SALE"
+	// InAppPurchaseStateRemovedFromSale is an in-app purchase state for RemovedFromSale.
+	InAppPurchaseStateRemovedFromSale InAppPurchaseState = "REMOVED_FROM_SALE"
+	// InAppPurchaseStateRejected is an in-app purchase state for Rejected.
+	InAppPurchaseStateRejected InAppPurchaseState = "REJECTED"
+)
+
+// InAppPurchaseV2 defines model for InAppPurchaseV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2
+type InAppPurchaseV2 struct {
+	Attributes    *InAppPurchaseV2Attributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *InAppPurchaseV2Relationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// InAppPurchaseV2Attributes defines model for InAppPurchaseV2.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2/attributes
+type InAppPurchaseV2Attributes struct {
+	ContentHosting    *bool               `json:"contentHosting,omitempty"`
+	FamilySharable    *bool               `json:"familySharable,omitempty"`
+	InAppPurchaseType *InAppPurchaseType  `json:"inAppPurchaseType,omitempty"`
+	Name              *string             `json:"name,omitempty"`
+	ProductID         *string             `json:"productId,omitempty"`
+	ReviewNote        *string             `json:"reviewNote,omitempty"`
+	State             *InAppPurchaseState `json:"state,omitempty"`
+}
+
+// InAppPurchaseV2Relationships defines model for InAppPurchaseV2.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2/relationships
+type InAppPurchaseV2Relationships struct {
+	AppStoreReviewScreenshot   *Relationship      `json:"appStoreReviewScreenshot,omitempty"`
+	Content                    *Relationship      `json:"content,omitempty"`
+	IAPPriceSchedule           *Relationship      `json:"iapPriceSchedule,omitempty"`
+	InAppPurchaseAvailability  *Relationship      `json:"inAppPurchaseAvailability,omitempty"`
+	InAppPurchaseLocalizations *PagedRelationship `json:"inAppPurchaseLocalizations,omitempty"`
+	PricePoints                *PagedRelationship `json:"pricePoints,omitempty"`
+}
+
+// inAppPurchaseV2CreateRequest defines model for InAppPurchaseV2CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2createrequest/data
+type inAppPurchaseV2CreateRequest struct {
+	Attributes    InAppPurchaseV2CreateRequestAttributes    `json:"attributes"`
+	Relationships inAppPurchaseV2CreateRequestRelationships `json:"relationships"`
+	Type          string                                    `json:"type"`
+}
+
+// InAppPurchaseV2CreateRequestAttributes are attributes for InAppPurchaseV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2createrequest/data/attributes
+type InAppPurchaseV2CreateRequestAttributes struct {
+	FamilySharable    *bool             `json:"familySharable,omitempty"`
+	InAppPurchaseType InAppPurchaseType `json:"inAppPurchaseType"`
+	Name              string            `json:"name"`
+	ProductID         string            `json:"productId"`
+	ReviewNote        *string           `json:"reviewNote,omitempty"`
+}
+
+// inAppPurchaseV2CreateRequestRelationships are relationships for InAppPurchaseV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2createrequest/data/relationships
+type inAppPurchaseV2CreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// inAppPurchaseV2UpdateRequest defines model for InAppPurchaseV2UpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2updaterequest/data
+type inAppPurchaseV2UpdateRequest struct {
+	Attributes *InAppPurchaseV2UpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                  `json:"id"`
+	Type       string                                  `json:"type"`
+}
+
+// InAppPurchaseV2UpdateRequestAttributes are attributes for InAppPurchaseV2UpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2updaterequest/data/attributes
+type InAppPurchaseV2UpdateRequestAttributes struct {
+	FamilySharable *bool   `json:"familySharable,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	ReviewNote     *string `json:"reviewNote,omitempty"`
+}
+
+// InAppPurchaseV2Response defines model for InAppPurchaseV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2response
+type InAppPurchaseV2Response struct {
+	Data     InAppPurchaseV2                 `json:"data"`
+	Included []InAppPurchaseResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                   `json:"links"`
+}
+
+// InAppPurchasesV2Response defines model for InAppPurchasesV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesv2response
+type InAppPurchasesV2Response struct {
+	Data     []InAppPurchaseV2               `json:"data"`
+	Included []InAppPurchaseResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks              `json:"links"`
+	Meta     *PagingInformation              `json:"meta,omitempty"`
+}
+
+// InAppPurchaseResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an InAppPurchaseV2Response or InAppPurchasesV2Response.
+type InAppPurchaseResponseIncluded included
+
+// ListInAppPurchasesV2ForAppQuery are query options for ListInAppPurchasesV2ForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_purchases_for_an_app
+type ListInAppPurchasesV2ForAppQuery struct {
+	FieldsInAppPurchases                         []string `url:"fields[inAppPurchases],omitempty"`
+	FieldsInAppPurchaseLocalizations             []string `url:"fields[inAppPurchaseLocalizations],omitempty"`
+	FieldsInAppPurchaseContents                  []string `url:"fields[inAppPurchaseContents],omitempty"`
+	FieldsInAppPurchaseAppStoreReviewScreenshots []string `url:"fields[inAppPurchaseAppStoreReviewScreenshots],omitempty"`
+	FieldsInAppPurchasePriceSchedules            []string `url:"fields[inAppPurchasePriceSchedules],omitempty"`
+	FieldsInAppPurchaseAvailabilities            []string `url:"fields[inAppPurchaseAvailabilities],omitempty"`
+	FilterInAppPurchaseType                      []string `url:"filter[inAppPurchaseType],omitempty"`
+	FilterName                                   []string `url:"filter[name],omitempty"`
+	FilterProductID                              []string `url:"filter[productId],omitempty"`
+	FilterState                                  []string `url:"filter[state],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	Limit                                        int      `url:"limit,omitempty"`
+	LimitInAppPurchaseLocalizations              int      `url:"limit[inAppPurchaseLocalizations],omitempty"`
+	Sort                                         []string `url:"sort,omitempty"`
+	Cursor                                       string   `url:"cursor,omitempty"`
+}
+
+// GetInAppPurchaseV2Query are query options for GetInAppPurchaseV2
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_information
+type GetInAppPurchaseV2Query struct {
+	FieldsInAppPurchases                         []string `url:"fields[inAppPurchases],omitempty"`
+	FieldsInAppPurchaseLocalizations             []string `url:"fields[inAppPurchaseLocalizations],omitempty"`
+	FieldsInAppPurchaseContents                  []string `url:"fields[inAppPurchaseContents],omitempty"`
+	FieldsInAppPurchaseAppStoreReviewScreenshots []string `url:"fields[inAppPurchaseAppStoreReviewScreenshots],omitempty"`
+	FieldsInAppPurchasePriceSchedules            []string `url:"fields[inAppPurchasePriceSchedules],omitempty"`
+	FieldsInAppPurchaseAvailabilities            []string `url:"fields[inAppPurchaseAvailabilities],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	LimitInAppPurchaseLocalizations              int      `url:"limit[inAppPurchaseLocalizations],omitempty"`
+}
+
+// ListInAppPurchasesV2ForApp lists the in-app purchases of an app using the current in-app purchase model.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_purchases_for_an_app
+func (s *InAppPurchasesService) ListInAppPurchasesV2ForApp(ctx context.Context, id string, params *ListInAppPurchasesV2ForAppQuery) (*InAppPurchasesV2Response, *Response, error) {
+	url := fmt.Sprintf("apps/%s/inAppPurchasesV2", id)
+	res := new(InAppPurchasesV2Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetInAppPurchaseV2 gets information about a specific in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_information
+func (s *InAppPurchasesService) GetInAppPurchaseV2(ctx context.Context, id string, params *GetInAppPurchaseV2Query) (*InAppPurchaseV2Response, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s", id))
+	res := new(InAppPurchaseV2Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateInAppPurchaseV2 creates a consumable, non-consumable, or non-renewing subscription in-app purchase for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_purchase
+func (s *InAppPurchasesService) CreateInAppPurchaseV2(ctx context.Context, attributes InAppPurchaseV2CreateRequestAttributes, appID string) (*InAppPurchaseV2Response, *Response, error) {
+	req := inAppPurchaseV2CreateRequest{
+		Attributes: attributes,
+		Relationships: inAppPurchaseV2CreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "inAppPurchases",
+	}
+	res := new(InAppPurchaseV2Response)
+	resp, err := s.client.post(ctx, versionedPath("v2", "inAppPurchases"), newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateInAppPurchaseV2 modifies the reference name, review note, or family sharing setting of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_purchase
+func (s *InAppPurchasesService) UpdateInAppPurchaseV2(ctx context.Context, id string, attributes *InAppPurchaseV2UpdateRequestAttributes) (*InAppPurchaseV2Response, *Response, error) {
+	req := inAppPurchaseV2UpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "inAppPurchases",
+	}
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s", id))
+	res := new(InAppPurchaseV2Response)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteInAppPurchaseV2 deletes an in-app purchase that has not yet been submitted for review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_purchase
+func (s *InAppPurchasesService) DeleteInAppPurchaseV2(ctx context.Context, id string) (*Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s", id))
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in InAppPurchaseResponseIncluded.
+func (i *InAppPurchaseResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInAppPurchaseV2Include(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// InAppPurchaseLocalization returns the InAppPurchaseLocalization stored within, if one is present.
+func (i *InAppPurchaseResponseIncluded) InAppPurchaseLocalization() *InAppPurchaseLocalization {
+	return extractIncludedInAppPurchaseLocalization(i.inner)
+}
+
+// InAppPurchaseContent returns the InAppPurchaseContent stored within, if one is present.
+func (i *InAppPurchaseResponseIncluded) InAppPurchaseContent() *InAppPurchaseContent {
+	return extractIncludedInAppPurchaseContent(i.inner)
+}
+
+// InAppPurchaseAppStoreReviewScreenshot returns the InAppPurchaseAppStoreReviewScreenshot stored within, if one is present.
+func (i *InAppPurchaseResponseIncluded) InAppPurchaseAppStoreReviewScreenshot() *InAppPurchaseAppStoreReviewScreenshot {
+	return extractIncludedInAppPurchaseAppStoreReviewScreenshot(i.inner)
+}
+
+// InAppPurchasePriceSchedule returns the InAppPurchasePriceSchedule stored within, if one is present.
+func (i *InAppPurchaseResponseIncluded) InAppPurchasePriceSchedule() *InAppPurchasePriceSchedule {
+	return extractIncludedInAppPurchasePriceSchedule(i.inner)
+}
+
+// InAppPurchaseAvailability returns the InAppPurchaseAvailability stored within, if one is present.
+func (i *InAppPurchaseResponseIncluded) InAppPurchaseAvailability() *InAppPurchaseAvailability {
+	return extractIncludedInAppPurchaseAvailability(i.inner)
+}
+
+// InAppPurchaseV2 returns the InAppPurchaseV2 stored within, if one is present.
+func (i *InAppPurchaseResponseIncluded) InAppPurchaseV2() *InAppPurchaseV2 {
+	return extractIncludedInAppPurchaseV2(i.inner)
+}
@@ -0,0 +1,147 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchaseAvailability defines model for InAppPurchaseAvailability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailability
+type InAppPurchaseAvailability struct {
+	Attributes    *InAppPurchaseAvailabilityAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *InAppPurchaseAvailabilityRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// InAppPurchaseAvailabilityAttributes defines model for InAppPurchaseAvailability.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailability/attributes
+type InAppPurchaseAvailabilityAttributes struct {
+	AvailableInNewTerritories *bool `json:"availableInNewTerritories,omitempty"`
+}
+
+// InAppPurchaseAvailabilityRelationships defines model for InAppPurchaseAvailability.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailability/relationships
+type InAppPurchaseAvailabilityRelationships struct {
+	AvailableTerritories *PagedRelationship `json:"availableTerritories,omitempty"`
+}
+
+// inAppPurchaseAvailabilityCreateRequest defines model for InAppPurchaseAvailabilityCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailabilitycreaterequest/data
+type inAppPurchaseAvailabilityCreateRequest struct {
+	Attributes    inAppPurchaseAvailabilityCreateRequestAttributes    `json:"attributes"`
+	Relationships inAppPurchaseAvailabilityCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// inAppPurchaseAvailabilityCreateRequestAttributes are attributes for InAppPurchaseAvailabilityCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailabilitycreaterequest/data/attributes
+type inAppPurchaseAvailabilityCreateRequestAttributes struct {
+	AvailableInNewTerritories bool `json:"availableInNewTerritories"`
+}
+
+// inAppPurchaseAvailabilityCreateRequestRelationships are relationships for InAppPurchaseAvailabilityCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailabilitycreaterequest/data/relationships
+type inAppPurchaseAvailabilityCreateRequestRelationships struct {
+	AvailableTerritories pagedRelationshipDeclaration `json:"availableTerritories"`
+	InAppPurchase        relationshipDeclaration      `json:"inAppPurchase"`
+}
+
+// InAppPurchaseAvailabilityResponse defines model for InAppPurchaseAvailabilityResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseavailabilityresponse
+type InAppPurchaseAvailabilityResponse struct {
+	Data     InAppPurchaseAvailability `json:"data"`
+	Included []Territory               `json:"included,omitempty"`
+	Links    DocumentLinks             `json:"links"`
+}
+
+// GetInAppPurchaseAvailabilityQuery are query options for GetInAppPurchaseAvailability
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_of_an_in-app_purchase
+type GetInAppPurchaseAvailabilityQuery struct {
+	FieldsInAppPurchaseAvailabilities []string `url:"fields[inAppPurchaseAvailabilities],omitempty"`
+	FieldsTerritories                 []string `url:"fields[territories],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	LimitAvailableTerritories         int      `url:"limit[availableTerritories],omitempty"`
+}
+
+// GetAvailabilityForInAppPurchase gets the territories in which an in-app purchase is available.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_for_an_in-app_purchase
+func (s *InAppPurchasesService) GetAvailabilityForInAppPurchase(ctx context.Context, id string, params *GetInAppPurchaseAvailabilityQuery) (*InAppPurchaseAvailabilityResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s/inAppPurchaseAvailability", id))
+	res := new(InAppPurchaseAvailabilityResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetInAppPurchaseAvailability gets a specific in-app purchase availability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_of_an_in-app_purchase
+func (s *InAppPurchasesService) GetInAppPurchaseAvailability(ctx context.Context, id string, params *GetInAppPurchaseAvailabilityQuery) (*InAppPurchaseAvailabilityResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchaseAvailabilities/%s", id)
+	res := new(InAppPurchaseAvailabilityResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateInAppPurchaseAvailability sets the territories in which an in-app purchase is available, replacing any previous availability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_the_territory_availability_of_an_in-app_purchase
+func (s *InAppPurchasesService) CreateInAppPurchaseAvailability(ctx context.Context, inAppPurchaseID string, availableInNewTerritories bool, availableTerritoryIDs []string) (*InAppPurchaseAvailabilityResponse, *Response, error) {
+	req := inAppPurchaseAvailabilityCreateRequest{
+		Attributes: inAppPurchaseAvailabilityCreateRequestAttributes{
+			AvailableInNewTerritories: availableInNewTerritories,
+		},
+		Relationships: inAppPurchaseAvailabilityCreateRequestRelationships{
+			AvailableTerritories: newPagedRelationshipDeclaration(availableTerritoryIDs, "territories"),
+			InAppPurchase:        *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+		},
+		Type: "inAppPurchaseAvailabilities",
+	}
+	res := new(InAppPurchaseAvailabilityResponse)
+	resp, err := s.client.post(ctx, "inAppPurchaseAvailabilities", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListAvailableTerritoriesForInAppPurchaseAvailability lists the territories in which an in-app purchase is available.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_the_territory_availablity_of_an_in-app_purchase
+func (s *InAppPurchasesService) ListAvailableTerritoriesForInAppPurchaseAvailability(ctx context.Context, id string, params *ListTerritoriesQuery) (*TerritoriesResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchaseAvailabilities/%s/availableTerritories", id)
+	res := new(TerritoriesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,94 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchaseContent defines model for InAppPurchaseContent.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasecontent
+type InAppPurchaseContent struct {
+	Attributes    *InAppPurchaseContentAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *InAppPurchaseContentRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// InAppPurchaseContentAttributes defines model for InAppPurchaseContent.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasecontent/attributes
+type InAppPurchaseContentAttributes struct {
+	FileName         *string   `json:"fileName,omitempty"`
+	FileSize         *int64    `json:"fileSize,omitempty"`
+	LastModifiedDate *DateTime `json:"lastModifiedDate,omitempty"`
+	URL              *string   `json:"url,omitempty"`
+}
+
+// InAppPurchaseContentRelationships defines model for InAppPurchaseContent.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasecontent/relationships
+type InAppPurchaseContentRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+}
+
+// InAppPurchaseContentResponse defines model for InAppPurchaseContentResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasecontentresponse
+type InAppPurchaseContentResponse struct {
+	Data     InAppPurchaseContent `json:"data"`
+	Included []InAppPurchaseV2    `json:"included,omitempty"`
+	Links    DocumentLinks        `json:"links"`
+}
+
+// GetInAppPurchaseContentQuery are query options for GetInAppPurchaseContent
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_content_information
+type GetInAppPurchaseContentQuery struct {
+	FieldsInAppPurchaseContents []string `url:"fields[inAppPurchaseContents],omitempty"`
+	FieldsInAppPurchases        []string `url:"fields[inAppPurchases],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+}
+
+// GetContentForInAppPurchase gets the hosted content of an in-app purchase, for in-app purchases with content hosting enabled.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_content_for_an_in-app_purchase
+func (s *InAppPurchasesService) GetContentForInAppPurchase(ctx context.Context, id string, params *GetInAppPurchaseContentQuery) (*InAppPurchaseContentResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s/content", id))
+	res := new(InAppPurchaseContentResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetInAppPurchaseContent gets information about a specific piece of hosted in-app purchase content.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_content_information
+func (s *InAppPurchasesService) GetInAppPurchaseContent(ctx context.Context, id string, params *GetInAppPurchaseContentQuery) (*InAppPurchaseContentResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchaseContents/%s", id)
+	res := new(InAppPurchaseContentResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,199 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchaseLocalization defines model for InAppPurchaseLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalization
+type InAppPurchaseLocalization struct {
+	Attributes    *InAppPurchaseLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *InAppPurchaseLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// InAppPurchaseLocalizationAttributes defines model for InAppPurchaseLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalization/attributes
+type InAppPurchaseLocalizationAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	State       *string `json:"state,omitempty"`
+}
+
+// InAppPurchaseLocalizationRelationships defines model for InAppPurchaseLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalization/relationships
+type InAppPurchaseLocalizationRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+}
+
+// inAppPurchaseLocalizationCreateRequest defines model for InAppPurchaseLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationcreaterequest/data
+type inAppPurchaseLocalizationCreateRequest struct {
+	Attributes    InAppPurchaseLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships inAppPurchaseLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// InAppPurchaseLocalizationCreateRequestAttributes are attributes for InAppPurchaseLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationcreaterequest/data/attributes
+type InAppPurchaseLocalizationCreateRequestAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Locale      string  `json:"locale"`
+	Name        string  `json:"name"`
+}
+
+// inAppPurchaseLocalizationCreateRequestRelationships are relationships for InAppPurchaseLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationcreaterequest/data/relationships
+type inAppPurchaseLocalizationCreateRequestRelationships struct {
+	InAppPurchaseV2 relationshipDeclaration `json:"inAppPurchaseV2"`
+}
+
+// inAppPurchaseLocalizationUpdateRequest defines model for InAppPurchaseLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationupdaterequest/data
+type inAppPurchaseLocalizationUpdateRequest struct {
+	Attributes *InAppPurchaseLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                            `json:"id"`
+	Type       string                                            `json:"type"`
+}
+
+// InAppPurchaseLocalizationUpdateRequestAttributes are attributes for InAppPurchaseLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationupdaterequest/data/attributes
+type InAppPurchaseLocalizationUpdateRequestAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// InAppPurchaseLocalizationResponse defines model for InAppPurchaseLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationresponse
+type InAppPurchaseLocalizationResponse struct {
+	Data     InAppPurchaseLocalization `json:"data"`
+	Included []InAppPurchaseV2         `json:"included,omitempty"`
+	Links    DocumentLinks             `json:"links"`
+}
+
+// InAppPurchaseLocalizationsResponse defines model for InAppPurchaseLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaselocalizationsresponse
+type InAppPurchaseLocalizationsResponse struct {
+	Data     []InAppPurchaseLocalization `json:"data"`
+	Included []InAppPurchaseV2           `json:"included,omitempty"`
+	Links    PagedDocumentLinks          `json:"links"`
+	Meta     *PagingInformation          `json:"meta,omitempty"`
+}
+
+// ListInAppPurchaseLocalizationsQuery are query options for ListInAppPurchaseLocalizations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_purchase_localizations_for_an_in-app_purchase
+type ListInAppPurchaseLocalizationsQuery struct {
+	FieldsInAppPurchaseLocalizations []string `url:"fields[inAppPurchaseLocalizations],omitempty"`
+	FieldsInAppPurchases             []string `url:"fields[inAppPurchases],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+	Limit                            int      `url:"limit,omitempty"`
+	Cursor                           string   `url:"cursor,omitempty"`
+}
+
+// GetInAppPurchaseLocalizationQuery are query options for GetInAppPurchaseLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_localization_information
+type GetInAppPurchaseLocalizationQuery struct {
+	FieldsInAppPurchaseLocalizations []string `url:"fields[inAppPurchaseLocalizations],omitempty"`
+	FieldsInAppPurchases             []string `url:"fields[inAppPurchases],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+}
+
+// ListInAppPurchaseLocalizations lists the localized names and descriptions of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_purchase_localizations_for_an_in-app_purchase
+func (s *InAppPurchasesService) ListInAppPurchaseLocalizations(ctx context.Context, id string, params *ListInAppPurchaseLocalizationsQuery) (*InAppPurchaseLocalizationsResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s/inAppPurchaseLocalizations", id))
+	res := new(InAppPurchaseLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetInAppPurchaseLocalization gets a specific localized name and description of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_localization_information
+func (s *InAppPurchasesService) GetInAppPurchaseLocalization(ctx context.Context, id string, params *GetInAppPurchaseLocalizationQuery) (*InAppPurchaseLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchaseLocalizations/%s", id)
+	res := new(InAppPurchaseLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateInAppPurchaseLocalization adds a localized name and description to an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_purchase_localization
+func (s *InAppPurchasesService) CreateInAppPurchaseLocalization(ctx context.Context, attributes InAppPurchaseLocalizationCreateRequestAttributes, inAppPurchaseID string) (*InAppPurchaseLocalizationResponse, *Response, error) {
+	req := inAppPurchaseLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: inAppPurchaseLocalizationCreateRequestRelationships{
+			InAppPurchaseV2: *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+		},
+		Type: "inAppPurchaseLocalizations",
+	}
+	res := new(InAppPurchaseLocalizationResponse)
+	resp, err := s.client.post(ctx, "inAppPurchaseLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateInAppPurchaseLocalization modifies the localized name or description of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_purchase_localization
+func (s *InAppPurchasesService) UpdateInAppPurchaseLocalization(ctx context.Context, id string, attributes *InAppPurchaseLocalizationUpdateRequestAttributes) (*InAppPurchaseLocalizationResponse, *Response, error) {
+	req := inAppPurchaseLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "inAppPurchaseLocalizations",
+	}
+	url := fmt.Sprintf("inAppPurchaseLocalizations/%s", id)
+	res := new(InAppPurchaseLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteInAppPurchaseLocalization deletes a localized name and description from an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_purchase_localization
+func (s *InAppPurchasesService) DeleteInAppPurchaseLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("inAppPurchaseLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,384 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchasePricePoint defines model for InAppPurchasePricePoint.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepoint
+type InAppPurchasePricePoint struct {
+	Attributes    *InAppPurchasePricePointAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Links         ResourceLinks                         `json:"links"`
+	Relationships *InAppPurchasePricePointRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// InAppPurchasePricePointAttributes defines model for InAppPurchasePricePoint.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepoint/attributes
+type InAppPurchasePricePointAttributes struct {
+	CustomerPrice *string `json:"customerPrice,omitempty"`
+	Proceeds      *string `json:"proceeds,omitempty"`
+}
+
+// InAppPurchasePricePointRelationships defines model for InAppPurchasePricePoint.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepoint/relationships
+type InAppPurchasePricePointRelationships struct {
+	Territory *Relationship `json:"territory,omitempty"`
+}
+
+// InAppPurchasePricePointsResponse defines model for InAppPurchasePricePointsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepointsresponse
+type InAppPurchasePricePointsResponse struct {
+	Data     []InAppPurchasePricePoint `json:"data"`
+	Included []Territory               `json:"included,omitempty"`
+	Links    PagedDocumentLinks        `json:"links"`
+	Meta     *PagingInformation        `json:"meta,omitempty"`
+}
+
+// InAppPurchasePriceSchedule defines model for InAppPurchasePriceSchedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepriceschedule
+type InAppPurchasePriceSchedule struct {
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *InAppPurchasePriceScheduleRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// InAppPurchasePriceScheduleRelationships defines model for InAppPurchasePriceSchedule.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepriceschedule/relationships
+type InAppPurchasePriceScheduleRelationships struct {
+	AutomaticPrices *PagedRelationship `json:"automaticPrices,omitempty"`
+	BaseTerritory   *Relationship      `json:"baseTerritory,omitempty"`
+	InAppPurchase   *Relationship      `json:"inAppPurchase,omitempty"`
+	ManualPrices    *PagedRelationship `json:"manualPrices,omitempty"`
+}
+
+// inAppPurchasePriceScheduleCreateRequest defines model for InAppPurchasePriceScheduleCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepriceschedulecreaterequest/data
+type inAppPurchasePriceScheduleCreateRequest struct {
+	Relationships inAppPurchasePriceScheduleCreateRequestRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+// inAppPurchasePriceScheduleCreateRequestRelationships are relationships for InAppPurchasePriceScheduleCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepriceschedulecreaterequest/data/relationships
+type inAppPurchasePriceScheduleCreateRequestRelationships struct {
+	BaseTerritory relationshipDeclaration      `json:"baseTerritory"`
+	InAppPurchase relationshipDeclaration      `json:"inAppPurchase"`
+	ManualPrices  pagedRelationshipDeclaration `json:"manualPrices"`
+}
+
+// InAppPurchasePriceScheduleResponse defines model for InAppPurchasePriceScheduleResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricescheduleresponse
+type InAppPurchasePriceScheduleResponse struct {
+	Data     InAppPurchasePriceSchedule                   `json:"data"`
+	Included []InAppPurchasePriceScheduleResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                `json:"links"`
+}
+
+// InAppPurchasePriceScheduleResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an InAppPurchasePriceScheduleResponse.
+type InAppPurchasePriceScheduleResponseIncluded included
+
+// InAppPurchasePrice defines model for InAppPurchasePrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseprice
+type InAppPurchasePrice struct {
+	Attributes    *InAppPurchasePriceAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Relationships *InAppPurchasePriceRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// InAppPurchasePriceAttributes defines model for InAppPurchasePrice.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseprice/attributes
+type InAppPurchasePriceAttributes struct {
+	EndDate   *Date `json:"endDate,omitempty"`
+	Manual    *bool `json:"manual,omitempty"`
+	StartDate *Date `json:"startDate,omitempty"`
+}
+
+// InAppPurchasePriceRelationships defines model for InAppPurchasePrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseprice/relationships
+type InAppPurchasePriceRelationships struct {
+	InAppPurchasePricePoint *Relationship `json:"inAppPurchasePricePoint,omitempty"`
+	Territory               *Relationship `json:"territory,omitempty"`
+}
+
+// InAppPurchasePricesResponse defines model for InAppPurchasePricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricesresponse
+type InAppPurchasePricesResponse struct {
+	Data     []InAppPurchasePrice                         `json:"data"`
+	Included []InAppPurchasePriceScheduleResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                           `json:"links"`
+	Meta     *PagingInformation                           `json:"meta,omitempty"`
+}
+
+// NewInAppPurchasePrice models the parameters for a manual price in a new in-app purchase price schedule.
+//
+// Set StartDate to nil if you want the price to take effect immediately, and EndDate to nil if you want
+// the price to remain in effect indefinitely. Use ListPricePointsForInAppPurchase to find a PricePointID.
+type NewInAppPurchasePrice struct {
+	StartDate    *Date
+	EndDate      *Date
+	PricePointID string
+}
+
+type inAppPurchasePriceInlineCreate struct {
+	Attributes    inAppPurchasePriceInlineCreateAttributes    `json:"attributes"`
+	ID            string                                      `json:"id"`
+	Relationships inAppPurchasePriceInlineCreateRelationships `json:"relationships"`
+	Type          string                                      `json:"type"`
+}
+
+type inAppPurchasePriceInlineCreateAttributes struct {
+	EndDate   *Date `json:"endDate,omitempty"`
+	StartDate *Date `json:"startDate"`
+}
+
+type inAppPurchasePriceInlineCreateRelationships struct {
+	InAppPurchasePricePoint relationshipDeclaration `json:"inAppPurchasePricePoint"`
+	InAppPurchaseV2         relationshipDeclaration `json:"inAppPurchaseV2"`
+}
+
+func (p NewInAppPurchasePrice) inlineCreate(index int, inAppPurchaseID string) inAppPurchasePriceInlineCreate {
+	return inAppPurchasePriceInlineCreate{
+		Attributes: inAppPurchasePriceInlineCreateAttributes{
+			EndDate:   p.EndDate,
+			StartDate: p.StartDate,
+		},
+		ID: fmt.Sprintf("${new-price-%d}", index),
+		Relationships: inAppPurchasePriceInlineCreateRelationships{
+			InAppPurchasePricePoint: *newRelationshipDeclaration(&p.PricePointID, "inAppPurchasePricePoints"),
+			InAppPurchaseV2:         *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+		},
+		Type: "inAppPurchasePrices",
+	}
+}
+
+// ListPricePointsForInAppPurchaseQuery are query options for ListPricePointsForInAppPurchase
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_an_in-app_purchase
+type ListPricePointsForInAppPurchaseQuery struct {
+	FieldsInAppPurchasePricePoints []string `url:"fields[inAppPurchasePricePoints],omitempty"`
+	FieldsTerritories              []string `url:"fields[territories],omitempty"`
+	FilterTerritory                []string `url:"filter[territory],omitempty"`
+	Include                        []string `url:"include,omitempty"`
+	Limit                          int      `url:"limit,omitempty"`
+	Cursor                         string   `url:"cursor,omitempty"`
+}
+
+// ListEqualizationsForInAppPurchasePricePointQuery are query options for ListEqualizationsForInAppPurchasePricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_equalizations_for_an_in-app_purchase_price_point
+type ListEqualizationsForInAppPurchasePricePointQuery struct {
+	FieldsInAppPurchasePricePoints []string `url:"fields[inAppPurchasePricePoints],omitempty"`
+	FieldsTerritories              []string `url:"fields[territories],omitempty"`
+	FilterTerritory                []string `url:"filter[territory],omitempty"`
+	Include                        []string `url:"include,omitempty"`
+	Limit                          int      `url:"limit,omitempty"`
+	Cursor                         string   `url:"cursor,omitempty"`
+}
+
+// GetInAppPurchasePriceScheduleQuery are query options for GetInAppPurchasePriceSchedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_price_information_for_an_in-app_purchase_price_schedule
+type GetInAppPurchasePriceScheduleQuery struct {
+	FieldsInAppPurchasePriceSchedules []string `url:"fields[inAppPurchasePriceSchedules],omitempty"`
+	FieldsInAppPurchasePrices         []string `url:"fields[inAppPurchasePrices],omitempty"`
+	FieldsTerritories                 []string `url:"fields[territories],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	LimitAutomaticPrices              int      `url:"limit[automaticPrices],omitempty"`
+	LimitManualPrices                 int      `url:"limit[manualPrices],omitempty"`
+}
+
+// GetBaseTerritoryForInAppPurchasePriceScheduleQuery are query options for GetBaseTerritoryForInAppPurchasePriceSchedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_base_territory_for_an_in-app_purchase_price_schedule
+type GetBaseTerritoryForInAppPurchasePriceScheduleQuery struct {
+	FieldsTerritories []string `url:"fields[territories],omitempty"`
+}
+
+// ListPricesForInAppPurchasePriceScheduleQuery are query options for ListManualPricesForInAppPurchasePriceSchedule
+// and ListAutomaticPricesForInAppPurchasePriceSchedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_manual_prices_for_an_in-app_purchase_price_schedule
+// https://developer.apple.com/documentation/appstoreconnectapi/list_automatically_generated_prices_for_an_in-app_purchase_price_schedule
+type ListPricesForInAppPurchasePriceScheduleQuery struct {
+	FieldsInAppPurchasePricePoints []string `url:"fields[inAppPurchasePricePoints],omitempty"`
+	FieldsInAppPurchasePrices      []string `url:"fields[inAppPurchasePrices],omitempty"`
+	FieldsTerritories              []string `url:"fields[territories],omitempty"`
+	FilterTerritory                []string `url:"filter[territory],omitempty"`
+	Include                        []string `url:"include,omitempty"`
+	Limit                          int      `url:"limit,omitempty"`
+	Cursor                         string   `url:"cursor,omitempty"`
+}
+
+// ListPricePointsForInAppPurchase lists the price points available for an in-app purchase, including customer price and proceeds per territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_an_in-app_purchase
+func (s *InAppPurchasesService) ListPricePointsForInAppPurchase(ctx context.Context, id string, params *ListPricePointsForInAppPurchaseQuery) (*InAppPurchasePricePointsResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s/pricePoints", id))
+	res := new(InAppPurchasePricePointsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListEqualizationsForInAppPurchasePricePoint lists the price points in other territories that are equalized to a given price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_equalizations_for_an_in-app_purchase_price_point
+func (s *InAppPurchasesService) ListEqualizationsForInAppPurchasePricePoint(ctx context.Context, id string, params *ListEqualizationsForInAppPurchasePricePointQuery) (*InAppPurchasePricePointsResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchasePricePoints/%s/equalizations", id)
+	res := new(InAppPurchasePricePointsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetPriceScheduleForInAppPurchase gets the price schedule of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_price_information_for_an_in-app_purchase
+func (s *InAppPurchasesService) GetPriceScheduleForInAppPurchase(ctx context.Context, id string, params *GetInAppPurchasePriceScheduleQuery) (*InAppPurchasePriceScheduleResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s/iapPriceSchedule", id))
+	res := new(InAppPurchasePriceScheduleResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetInAppPurchasePriceSchedule gets a specific in-app purchase price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_price_information_for_an_in-app_purchase_price_schedule
+func (s *InAppPurchasesService) GetInAppPurchasePriceSchedule(ctx context.Context, id string, params *GetInAppPurchasePriceScheduleQuery) (*InAppPurchasePriceScheduleResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchasePriceSchedules/%s", id)
+	res := new(InAppPurchasePriceScheduleResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateInAppPurchasePriceSchedule replaces the price schedule of an in-app purchase with a base territory and a set of manual prices.
+//
+// Prices in territories other than those covered by manualPrices are equalized automatically from the price in the base territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/add_a_scheduled_price_change_to_an_in-app_purchase
+func (s *InAppPurchasesService) CreateInAppPurchasePriceSchedule(ctx context.Context, inAppPurchaseID string, baseTerritoryID string, manualPrices []NewInAppPurchasePrice) (*InAppPurchasePriceScheduleResponse, *Response, error) {
+	newPrices := make([]inAppPurchasePriceInlineCreate, len(manualPrices))
+	priceIDs := make([]string, len(manualPrices))
+
+	for i, price := range manualPrices {
+		newPrice := price.inlineCreate(i, inAppPurchaseID)
+		newPrices[i] = newPrice
+		priceIDs[i] = newPrice.ID
+	}
+
+	req := inAppPurchasePriceScheduleCreateRequest{
+		Relationships: inAppPurchasePriceScheduleCreateRequestRelationships{
+			BaseTerritory: *newRelationshipDeclaration(&baseTerritoryID, "territories"),
+			InAppPurchase: *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+			ManualPrices:  newPagedRelationshipDeclaration(priceIDs, "inAppPurchasePrices"),
+		},
+		Type: "inAppPurchasePriceSchedules",
+	}
+	res := new(InAppPurchasePriceScheduleResponse)
+	resp, err := s.client.post(ctx, "inAppPurchasePriceSchedules", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// GetBaseTerritoryForInAppPurchasePriceSchedule gets the territory that automatically equalized prices of a price schedule are based on.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_base_territory_for_an_in-app_purchase_price_schedule
+func (s *InAppPurchasesService) GetBaseTerritoryForInAppPurchasePriceSchedule(ctx context.Context, id string, params *GetBaseTerritoryForInAppPurchasePriceScheduleQuery) (*TerritoryResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchasePriceSchedules/%s/baseTerritory", id)
+	res := new(TerritoryResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListManualPricesForInAppPurchasePriceSchedule lists the prices that were set manually in a price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_manual_prices_for_an_in-app_purchase_price_schedule
+func (s *InAppPurchasesService) ListManualPricesForInAppPurchasePriceSchedule(ctx context.Context, id string, params *ListPricesForInAppPurchasePriceScheduleQuery) (*InAppPurchasePricesResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchasePriceSchedules/%s/manualPrices", id)
+	res := new(InAppPurchasePricesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListAutomaticPricesForInAppPurchasePriceSchedule lists the prices that were equalized automatically from the base territory of a price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_automatically_generated_prices_for_an_in-app_purchase_price_schedule
+func (s *InAppPurchasesService) ListAutomaticPricesForInAppPurchasePriceSchedule(ctx context.Context, id string, params *ListPricesForInAppPurchasePriceScheduleQuery) (*InAppPurchasePricesResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchasePriceSchedules/%s/automaticPrices", id)
+	res := new(InAppPurchasePricesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in InAppPurchasePriceScheduleResponseIncluded.
+func (i *InAppPurchasePriceScheduleResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInAppPurchaseV2Include(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// InAppPurchasePrice returns the InAppPurchasePrice stored within, if one is present.
+func (i *InAppPurchasePriceScheduleResponseIncluded) InAppPurchasePrice() *InAppPurchasePrice {
+	return extractIncludedInAppPurchasePrice(i.inner)
+}
+
+// InAppPurchasePricePoint returns the InAppPurchasePricePoint stored within, if one is present.
+func (i *InAppPurchasePriceScheduleResponseIncluded) InAppPurchasePricePoint() *InAppPurchasePricePoint {
+	return extractIncludedInAppPurchasePricePoint(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *InAppPurchasePriceScheduleResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
+
+// InAppPurchaseV2 returns the InAppPurchaseV2 stored within, if one is present.
+func (i *InAppPurchasePriceScheduleResponseIncluded) InAppPurchaseV2() *InAppPurchaseV2 {
+	return extractIncludedInAppPurchaseV2(i.inner)
+}
@@ -0,0 +1,116 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPricePointsForInAppPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.ListPricePointsForInAppPurchase(ctx, "10", &ListPricePointsForInAppPurchaseQuery{})
+	})
+}
+
+func TestListEqualizationsForInAppPurchasePricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.ListEqualizationsForInAppPurchasePricePoint(ctx, "10", &ListEqualizationsForInAppPurchasePricePointQuery{})
+	})
+}
+
+func TestGetPriceScheduleForInAppPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.GetPriceScheduleForInAppPurchase(ctx, "10", &GetInAppPurchasePriceScheduleQuery{})
+	})
+}
+
+func TestGetInAppPurchasePriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.GetInAppPurchasePriceSchedule(ctx, "10", &GetInAppPurchasePriceScheduleQuery{})
+	})
+}
+
+func TestGetInAppPurchasePriceScheduleIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"inAppPurchasePrices"},{"type":"inAppPurchasePricePoints"},{"type":"territories"},{"type":"inAppPurchases"}]}`, func(ctx context.Context, client *Client) {
+		schedule, _, err := client.InAppPurchases.GetInAppPurchasePriceSchedule(ctx, "10", &GetInAppPurchasePriceScheduleQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, schedule.Included)
+
+		assert.NotNil(t, schedule.Included[0].InAppPurchasePrice())
+		assert.NotNil(t, schedule.Included[1].InAppPurchasePricePoint())
+		assert.NotNil(t, schedule.Included[2].Territory())
+		assert.NotNil(t, schedule.Included[3].InAppPurchaseV2())
+
+		assert.Nil(t, schedule.Included[0].InAppPurchasePricePoint())
+		assert.Nil(t, schedule.Included[0].Territory())
+		assert.Nil(t, schedule.Included[1].InAppPurchasePrice())
+		assert.Nil(t, schedule.Included[2].InAppPurchaseV2())
+	})
+}
+
+func TestCreateInAppPurchasePriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.CreateInAppPurchasePriceSchedule(ctx, "10", "USA", []NewInAppPurchasePrice{
+			{PricePointID: "10"},
+			{StartDate: &Date{time.Now()}, PricePointID: "11"},
+		})
+	})
+}
+
+func TestGetBaseTerritoryForInAppPurchasePriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.GetBaseTerritoryForInAppPurchasePriceSchedule(ctx, "10", &GetBaseTerritoryForInAppPurchasePriceScheduleQuery{})
+	})
+}
+
+func TestListManualPricesForInAppPurchasePriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.ListManualPricesForInAppPurchasePriceSchedule(ctx, "10", &ListPricesForInAppPurchasePriceScheduleQuery{})
+	})
+}
+
+func TestListAutomaticPricesForInAppPurchasePriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.ListAutomaticPricesForInAppPurchasePriceSchedule(ctx, "10", &ListPricesForInAppPurchasePriceScheduleQuery{})
+	})
+}
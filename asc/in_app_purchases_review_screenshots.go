@@ -0,0 +1,192 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchaseAppStoreReviewScreenshot defines model for InAppPurchaseAppStoreReviewScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshot
+type InAppPurchaseAppStoreReviewScreenshot struct {
+	Attributes    *InAppPurchaseAppStoreReviewScreenshotAttributes    `json:"attributes,omitempty"`
+	ID            string                                              `json:"id"`
+	Links         ResourceLinks                                       `json:"links"`
+	Relationships *InAppPurchaseAppStoreReviewScreenshotRelationships `json:"relationships,omitempty"`
+	Type          string                                              `json:"type"`
+}
+
+// InAppPurchaseAppStoreReviewScreenshotAttributes defines model for InAppPurchaseAppStoreReviewScreenshot.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshot/attributes
+type InAppPurchaseAppStoreReviewScreenshotAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	AssetToken         *string             `json:"assetToken,omitempty"`
+	AssetType          *string             `json:"assetType,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// InAppPurchaseAppStoreReviewScreenshotRelationships defines model for InAppPurchaseAppStoreReviewScreenshot.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshot/relationships
+type InAppPurchaseAppStoreReviewScreenshotRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+}
+
+// inAppPurchaseAppStoreReviewScreenshotCreateRequest defines model for InAppPurchaseAppStoreReviewScreenshotCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshotcreaterequest/data
+type inAppPurchaseAppStoreReviewScreenshotCreateRequest struct {
+	Attributes    inAppPurchaseAppStoreReviewScreenshotCreateRequestAttributes    `json:"attributes"`
+	Relationships inAppPurchaseAppStoreReviewScreenshotCreateRequestRelationships `json:"relationships"`
+	Type          string                                                          `json:"type"`
+}
+
+// inAppPurchaseAppStoreReviewScreenshotCreateRequestAttributes are attributes for InAppPurchaseAppStoreReviewScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshotcreaterequest/data/attributes
+type inAppPurchaseAppStoreReviewScreenshotCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// inAppPurchaseAppStoreReviewScreenshotCreateRequestRelationships are relationships for InAppPurchaseAppStoreReviewScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshotcreaterequest/data/relationships
+type inAppPurchaseAppStoreReviewScreenshotCreateRequestRelationships struct {
+	InAppPurchaseV2 relationshipDeclaration `json:"inAppPurchaseV2"`
+}
+
+// inAppPurchaseAppStoreReviewScreenshotUpdateRequest defines model for InAppPurchaseAppStoreReviewScreenshotUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshotupdaterequest/data
+type inAppPurchaseAppStoreReviewScreenshotUpdateRequest struct {
+	Attributes *inAppPurchaseAppStoreReviewScreenshotUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                        `json:"id"`
+	Type       string                                                        `json:"type"`
+}
+
+// inAppPurchaseAppStoreReviewScreenshotUpdateRequestAttributes are attributes for InAppPurchaseAppStoreReviewScreenshotUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshotupdaterequest/data/attributes
+type inAppPurchaseAppStoreReviewScreenshotUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// InAppPurchaseAppStoreReviewScreenshotResponse defines model for InAppPurchaseAppStoreReviewScreenshotResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseappstorereviewscreenshotresponse
+type InAppPurchaseAppStoreReviewScreenshotResponse struct {
+	Data     InAppPurchaseAppStoreReviewScreenshot `json:"data"`
+	Included []InAppPurchaseV2                     `json:"included,omitempty"`
+	Links    DocumentLinks                         `json:"links"`
+}
+
+// GetInAppPurchaseAppStoreReviewScreenshotQuery are query options for GetInAppPurchaseAppStoreReviewScreenshot
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_app_store_review_screenshot_information
+type GetInAppPurchaseAppStoreReviewScreenshotQuery struct {
+	FieldsInAppPurchaseAppStoreReviewScreenshots []string `url:"fields[inAppPurchaseAppStoreReviewScreenshots],omitempty"`
+	FieldsInAppPurchases                         []string `url:"fields[inAppPurchases],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+}
+
+// GetAppStoreReviewScreenshotForInAppPurchase gets the review screenshot attached to an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_review_screenshot_for_an_in-app_purchase
+func (s *InAppPurchasesService) GetAppStoreReviewScreenshotForInAppPurchase(ctx context.Context, id string, params *GetInAppPurchaseAppStoreReviewScreenshotQuery) (*InAppPurchaseAppStoreReviewScreenshotResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("inAppPurchases/%s/appStoreReviewScreenshot", id))
+	res := new(InAppPurchaseAppStoreReviewScreenshotResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetInAppPurchaseAppStoreReviewScreenshot gets information about an in-app purchase review screenshot and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_app_store_review_screenshot_information
+func (s *InAppPurchasesService) GetInAppPurchaseAppStoreReviewScreenshot(ctx context.Context, id string, params *GetInAppPurchaseAppStoreReviewScreenshotQuery) (*InAppPurchaseAppStoreReviewScreenshotResponse, *Response, error) {
+	url := fmt.Sprintf("inAppPurchaseAppStoreReviewScreenshots/%s", id)
+	res := new(InAppPurchaseAppStoreReviewScreenshotResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateInAppPurchaseAppStoreReviewScreenshot reserves a review screenshot for an in-app purchase. Upload the file
+// with the returned upload operations, then commit it with CommitInAppPurchaseAppStoreReviewScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_purchase_review_screenshot
+func (s *InAppPurchasesService) CreateInAppPurchaseAppStoreReviewScreenshot(ctx context.Context, fileName string, fileSize int64, inAppPurchaseID string) (*InAppPurchaseAppStoreReviewScreenshotResponse, *Response, error) {
+	req := inAppPurchaseAppStoreReviewScreenshotCreateRequest{
+		Attributes: inAppPurchaseAppStoreReviewScreenshotCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: inAppPurchaseAppStoreReviewScreenshotCreateRequestRelationships{
+			InAppPurchaseV2: *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+		},
+		Type: "inAppPurchaseAppStoreReviewScreenshots",
+	}
+	res := new(InAppPurchaseAppStoreReviewScreenshotResponse)
+	resp, err := s.client.post(ctx, "inAppPurchaseAppStoreReviewScreenshots", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitInAppPurchaseAppStoreReviewScreenshot commits an in-app purchase review screenshot after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_purchase_review_screenshot
+func (s *InAppPurchasesService) CommitInAppPurchaseAppStoreReviewScreenshot(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*InAppPurchaseAppStoreReviewScreenshotResponse, *Response, error) {
+	req := inAppPurchaseAppStoreReviewScreenshotUpdateRequest{
+		ID:   id,
+		Type: "inAppPurchaseAppStoreReviewScreenshots",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &inAppPurchaseAppStoreReviewScreenshotUpdateRequestAttributes{
+			SourceFileChecksum: sourceFileChecksum,
+			Uploaded:           uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("inAppPurchaseAppStoreReviewScreenshots/%s", id)
+	res := new(InAppPurchaseAppStoreReviewScreenshotResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteInAppPurchaseAppStoreReviewScreenshot deletes the review screenshot of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_purchase_app_store_review_screenshot
+func (s *InAppPurchasesService) DeleteInAppPurchaseAppStoreReviewScreenshot(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("inAppPurchaseAppStoreReviewScreenshots/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,81 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+)
+
+// InAppPurchaseSubmission defines model for InAppPurchaseSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmission
+type InAppPurchaseSubmission struct {
+	ID            string                                `json:"id"`
+	Links         ResourceLinks                         `json:"links"`
+	Relationships *InAppPurchaseSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// InAppPurchaseSubmissionRelationships defines model for InAppPurchaseSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmission/relationships
+type InAppPurchaseSubmissionRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+}
+
+// inAppPurchaseSubmissionCreateRequest defines model for InAppPurchaseSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmissioncreaterequest/data
+type inAppPurchaseSubmissionCreateRequest struct {
+	Relationships inAppPurchaseSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                            `json:"type"`
+}
+
+// inAppPurchaseSubmissionCreateRequestRelationships are relationships for InAppPurchaseSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmissioncreaterequest/data/relationships
+type inAppPurchaseSubmissionCreateRequestRelationships struct {
+	InAppPurchaseV2 relationshipDeclaration `json:"inAppPurchaseV2"`
+}
+
+// InAppPurchaseSubmissionResponse defines model for InAppPurchaseSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmissionresponse
+type InAppPurchaseSubmissionResponse struct {
+	Data  InAppPurchaseSubmission `json:"data"`
+	Links DocumentLinks           `json:"links"`
+}
+
+// CreateInAppPurchaseSubmission submits an in-app purchase to App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_purchase_submission
+func (s *InAppPurchasesService) CreateInAppPurchaseSubmission(ctx context.Context, inAppPurchaseID string) (*InAppPurchaseSubmissionResponse, *Response, error) {
+	req := inAppPurchaseSubmissionCreateRequest{
+		Relationships: inAppPurchaseSubmissionCreateRequestRelationships{
+			InAppPurchaseV2: *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+		},
+		Type: "inAppPurchaseSubmissions",
+	}
+	res := new(InAppPurchaseSubmissionResponse)
+	resp, err := s.client.post(ctx, "inAppPurchaseSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
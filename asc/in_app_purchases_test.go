@@ -0,0 +1,97 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListInAppPurchasesV2ForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasesV2Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.ListInAppPurchasesV2ForApp(ctx, "10", &ListInAppPurchasesV2ForAppQuery{})
+	})
+}
+
+func TestGetInAppPurchaseV2(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchaseV2Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.GetInAppPurchaseV2(ctx, "10", &GetInAppPurchaseV2Query{})
+	})
+}
+
+func TestGetInAppPurchaseV2Includeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[
+		{"type":"inAppPurchaseLocalizations"},{"type":"inAppPurchaseContents"},
+		{"type":"inAppPurchaseAppStoreReviewScreenshots"},{"type":"inAppPurchasePriceSchedules"},
+		{"type":"inAppPurchaseAvailabilities"},{"type":"inAppPurchases","attributes":{"inAppPurchaseType":"CONSUMABLE"}}
+		]}`, func(ctx context.Context, client *Client) {
+		iap, _, err := client.InAppPurchases.GetInAppPurchaseV2(ctx, "10", &GetInAppPurchaseV2Query{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, iap.Included)
+
+		assert.NotNil(t, iap.Included[0].InAppPurchaseLocalization())
+		assert.NotNil(t, iap.Included[1].InAppPurchaseContent())
+		assert.NotNil(t, iap.Included[2].InAppPurchaseAppStoreReviewScreenshot())
+		assert.NotNil(t, iap.Included[3].InAppPurchasePriceSchedule())
+		assert.NotNil(t, iap.Included[4].InAppPurchaseAvailability())
+		assert.NotNil(t, iap.Included[5].InAppPurchaseV2())
+		assert.Equal(t, InAppPurchaseTypeConsumable, *iap.Included[5].InAppPurchaseV2().Attributes.InAppPurchaseType)
+
+		assert.Nil(t, iap.Included[0].InAppPurchaseContent())
+		assert.Nil(t, iap.Included[0].InAppPurchaseAppStoreReviewScreenshot())
+		assert.Nil(t, iap.Included[0].InAppPurchasePriceSchedule())
+		assert.Nil(t, iap.Included[0].InAppPurchaseAvailability())
+		assert.Nil(t, iap.Included[1].InAppPurchaseLocalization())
+		assert.Nil(t, iap.Included[0].InAppPurchaseV2())
+	})
+}
+
+func TestCreateInAppPurchaseV2(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchaseV2Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.CreateInAppPurchaseV2(ctx, InAppPurchaseV2CreateRequestAttributes{InAppPurchaseType: InAppPurchaseTypeConsumable}, "10")
+	})
+}
+
+func TestUpdateInAppPurchaseV2(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchaseV2Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.InAppPurchases.UpdateInAppPurchaseV2(ctx, "10", &InAppPurchaseV2UpdateRequestAttributes{})
+	})
+}
+
+func TestDeleteInAppPurchaseV2(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.InAppPurchases.DeleteInAppPurchaseV2(ctx, "10")
+	})
+}
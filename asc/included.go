@@ -65,6 +65,86 @@ func extractIncludedAppCategory(i interface{}) *AppCategory {
 	return nil
 }
 
+func extractIncludedAppClip(i interface{}) *AppClip {
+	if v, ok := i.(AppClip); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipAdvancedExperienceImage(i interface{}) *AppClipAdvancedExperienceImage {
+	if v, ok := i.(AppClipAdvancedExperienceImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipAdvancedExperienceLocalization(i interface{}) *AppClipAdvancedExperienceLocalization {
+	if v, ok := i.(AppClipAdvancedExperienceLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipAppStoreReviewDetail(i interface{}) *AppClipAppStoreReviewDetail {
+	if v, ok := i.(AppClipAppStoreReviewDetail); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipDefaultExperience(i interface{}) *AppClipDefaultExperience {
+	if v, ok := i.(AppClipDefaultExperience); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipDefaultExperienceLocalization(i interface{}) *AppClipDefaultExperienceLocalization {
+	if v, ok := i.(AppClipDefaultExperienceLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipHeaderImage(i interface{}) *AppClipHeaderImage {
+	if v, ok := i.(AppClipHeaderImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppCustomProductPage(i interface{}) *AppCustomProductPage {
+	if v, ok := i.(AppCustomProductPage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppCustomProductPageLocalization(i interface{}) *AppCustomProductPageLocalization {
+	if v, ok := i.(AppCustomProductPageLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppCustomProductPageVersion(i interface{}) *AppCustomProductPageVersion {
+	if v, ok := i.(AppCustomProductPageVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppEncryptionDeclaration(i interface{}) *AppEncryptionDeclaration {
 	if v, ok := i.(AppEncryptionDeclaration); ok {
 		return &v
@@ -73,6 +153,38 @@ func extractIncludedAppEncryptionDeclaration(i interface{}) *AppEncryptionDeclar
 	return nil
 }
 
+func extractIncludedAppEvent(i interface{}) *AppEvent {
+	if v, ok := i.(AppEvent); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppEventLocalization(i interface{}) *AppEventLocalization {
+	if v, ok := i.(AppEventLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppEventScreenshot(i interface{}) *AppEventScreenshot {
+	if v, ok := i.(AppEventScreenshot); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppEventVideoClip(i interface{}) *AppEventVideoClip {
+	if v, ok := i.(AppEventVideoClip); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppInfo(i interface{}) *AppInfo {
 	if v, ok := i.(AppInfo); ok {
 		return &v
@@ -113,6 +225,14 @@ func extractIncludedAppPrice(i interface{}) *AppPrice {
 	return nil
 }
 
+func extractIncludedAppPricePointV3(i interface{}) *AppPricePointV3 {
+	if v, ok := i.(AppPricePointV3); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppScreenshotSet(i interface{}) *AppScreenshotSet {
 	if v, ok := i.(AppScreenshotSet); ok {
 		return &v
@@ -137,6 +257,30 @@ func extractIncludedAppStoreVersion(i interface{}) *AppStoreVersion {
 	return nil
 }
 
+func extractIncludedAppStoreVersionExperiment(i interface{}) *AppStoreVersionExperiment {
+	if v, ok := i.(AppStoreVersionExperiment); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppStoreVersionExperimentTreatment(i interface{}) *AppStoreVersionExperimentTreatment {
+	if v, ok := i.(AppStoreVersionExperimentTreatment); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppStoreVersionExperimentTreatmentLocalization(i interface{}) *AppStoreVersionExperimentTreatmentLocalization {
+	if v, ok := i.(AppStoreVersionExperimentTreatmentLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppStoreVersionLocalization(i interface{}) *AppStoreVersionLocalization {
 	if v, ok := i.(AppStoreVersionLocalization); ok {
 		return &v
@@ -209,6 +353,14 @@ func extractIncludedBetaLicenseAgreement(i interface{}) *BetaLicenseAgreement {
 	return nil
 }
 
+func extractIncludedBetaRecruitmentCriterion(i interface{}) *BetaRecruitmentCriterion {
+	if v, ok := i.(BetaRecruitmentCriterion); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedBetaTester(i interface{}) *BetaTester {
 	if v, ok := i.(BetaTester); ok {
 		return &v
@@ -265,6 +417,38 @@ func extractIncludedCertificate(i interface{}) *Certificate {
 	return nil
 }
 
+func extractIncludedCIMacOSVersion(i interface{}) *CIMacOSVersion {
+	if v, ok := i.(CIMacOSVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCIProduct(i interface{}) *CIProduct {
+	if v, ok := i.(CIProduct); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCIWorkflow(i interface{}) *CIWorkflow {
+	if v, ok := i.(CIWorkflow); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCIXcodeVersion(i interface{}) *CIXcodeVersion {
+	if v, ok := i.(CIXcodeVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedDevice(i interface{}) *Device {
 	if v, ok := i.(Device); ok {
 		return &v
@@ -289,161 +473,643 @@ func extractIncludedEndUserLicenseAgreement(i interface{}) *EndUserLicenseAgreem
 	return nil
 }
 
-func extractIncludedGameCenterEnabledVersion(i interface{}) *GameCenterEnabledVersion {
-	if v, ok := i.(GameCenterEnabledVersion); ok {
+func extractIncludedGameCenterAchievement(i interface{}) *GameCenterAchievement {
+	if v, ok := i.(GameCenterAchievement); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedIDFADeclaration(i interface{}) *IDFADeclaration {
-	if v, ok := i.(IDFADeclaration); ok {
+func extractIncludedGameCenterAchievementImage(i interface{}) *GameCenterAchievementImage {
+	if v, ok := i.(GameCenterAchievementImage); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedInAppPurchase(i interface{}) *InAppPurchase {
-	if v, ok := i.(InAppPurchase); ok {
+func extractIncludedGameCenterAchievementLocalization(i interface{}) *GameCenterAchievementLocalization {
+	if v, ok := i.(GameCenterAchievementLocalization); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedPerfPowerMetric(i interface{}) *PerfPowerMetric {
-	if v, ok := i.(PerfPowerMetric); ok {
+func extractIncludedGameCenterAchievementRelease(i interface{}) *GameCenterAchievementRelease {
+	if v, ok := i.(GameCenterAchievementRelease); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedPrereleaseVersion(i interface{}) *PrereleaseVersion {
-	if v, ok := i.(PrereleaseVersion); ok {
+func extractIncludedGameCenterDetail(i interface{}) *GameCenterDetail {
+	if v, ok := i.(GameCenterDetail); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedProfile(i interface{}) *Profile {
-	if v, ok := i.(Profile); ok {
+func extractIncludedGameCenterEnabledVersion(i interface{}) *GameCenterEnabledVersion {
+	if v, ok := i.(GameCenterEnabledVersion); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedRoutingAppCoverage(i interface{}) *RoutingAppCoverage {
-	if v, ok := i.(RoutingAppCoverage); ok {
+func extractIncludedGameCenterGroup(i interface{}) *GameCenterGroup {
+	if v, ok := i.(GameCenterGroup); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedTerritory(i interface{}) *Territory {
-	if v, ok := i.(Territory); ok {
+func extractIncludedGameCenterLeaderboard(i interface{}) *GameCenterLeaderboard {
+	if v, ok := i.(GameCenterLeaderboard); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func unmarshalInclude(b []byte) (string, interface{}, error) {
-	var typeRef struct {
-		Type string `json:"type"`
+func extractIncludedGameCenterLeaderboardImage(i interface{}) *GameCenterLeaderboardImage {
+	if v, ok := i.(GameCenterLeaderboardImage); ok {
+		return &v
 	}
 
-	err := json.Unmarshal(b, &typeRef)
-	if err != nil {
-		return "", nil, err
-	}
+	return nil
+}
 
-	typeName := typeRef.Type
+func extractIncludedGameCenterLeaderboardLocalization(i interface{}) *GameCenterLeaderboardLocalization {
+	if v, ok := i.(GameCenterLeaderboardLocalization); ok {
+		return &v
+	}
 
-	return supportedIncludeTypes()(typeName, b)
+	return nil
 }
 
-type includeTypeUnmarshallers map[string]func([]byte) (string, interface{}, error)
+func extractIncludedGameCenterLeaderboardRelease(i interface{}) *GameCenterLeaderboardRelease {
+	if v, ok := i.(GameCenterLeaderboardRelease); ok {
+		return &v
+	}
 
-func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
-	allCases := includeTypeUnmarshallers{
-		"ageRatingDeclarations": func(b []byte) (string, interface{}, error) {
-			var v AgeRatingDeclaration
-			err := json.Unmarshal(b, &v)
+	return nil
+}
 
-			return v.Type, v, err
-		},
-		"apps": func(b []byte) (string, interface{}, error) {
-			var v App
-			err := json.Unmarshal(b, &v)
+func extractIncludedGameCenterLeaderboardSet(i interface{}) *GameCenterLeaderboardSet {
+	if v, ok := i.(GameCenterLeaderboardSet); ok {
+		return &v
+	}
 
-			return v.Type, v, err
-		},
-		"appCategories": func(b []byte) (string, interface{}, error) {
-			var v AppCategory
-			err := json.Unmarshal(b, &v)
+	return nil
+}
 
-			return v.Type, v, err
-		},
-		"appEncryptionDeclarations": func(b []byte) (string, interface{}, error) {
-			var v AppEncryptionDeclaration
-			err := json.Unmarshal(b, &v)
+func extractIncludedGameCenterLeaderboardSetImage(i interface{}) *GameCenterLeaderboardSetImage {
+	if v, ok := i.(GameCenterLeaderboardSetImage); ok {
+		return &v
+	}
 
-			return v.Type, v, err
-		},
-		"appInfos": func(b []byte) (string, interface{}, error) {
-			var v AppInfo
-			err := json.Unmarshal(b, &v)
+	return nil
+}
 
-			return v.Type, v, err
-		},
-		"appInfoLocalizations": func(b []byte) (string, interface{}, error) {
-			var v AppInfoLocalization
-			err := json.Unmarshal(b, &v)
+func extractIncludedGameCenterLeaderboardSetLocalization(i interface{}) *GameCenterLeaderboardSetLocalization {
+	if v, ok := i.(GameCenterLeaderboardSetLocalization); ok {
+		return &v
+	}
 
-			return v.Type, v, err
-		},
-		"appPreOrders": func(b []byte) (string, interface{}, error) {
-			var v AppPreOrder
-			err := json.Unmarshal(b, &v)
+	return nil
+}
 
-			return v.Type, v, err
-		},
-		"appPreviewSets": func(b []byte) (string, interface{}, error) {
-			var v AppPreviewSet
-			err := json.Unmarshal(b, &v)
+func extractIncludedGameCenterLeaderboardSetRelease(i interface{}) *GameCenterLeaderboardSetRelease {
+	if v, ok := i.(GameCenterLeaderboardSetRelease); ok {
+		return &v
+	}
 
-			return v.Type, v, err
-		},
-		"appPrices": func(b []byte) (string, interface{}, error) {
-			var v AppPrice
-			err := json.Unmarshal(b, &v)
+	return nil
+}
 
-			return v.Type, v, err
-		},
-		"appScreenshotSets": func(b []byte) (string, interface{}, error) {
-			var v AppScreenshotSet
-			err := json.Unmarshal(b, &v)
+func extractIncludedIDFADeclaration(i interface{}) *IDFADeclaration {
+	if v, ok := i.(IDFADeclaration); ok {
+		return &v
+	}
 
-			return v.Type, v, err
-		},
-		"appStoreReviewDetails": func(b []byte) (string, interface{}, error) {
-			var v AppStoreReviewDetail
-			err := json.Unmarshal(b, &v)
+	return nil
+}
 
-			return v.Type, v, err
-		},
-		"appStoreVersions": func(b []byte) (string, interface{}, error) {
+func extractIncludedInAppPurchase(i interface{}) *InAppPurchase {
+	if v, ok := i.(InAppPurchase); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchaseAppStoreReviewScreenshot(i interface{}) *InAppPurchaseAppStoreReviewScreenshot {
+	if v, ok := i.(InAppPurchaseAppStoreReviewScreenshot); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchaseAvailability(i interface{}) *InAppPurchaseAvailability {
+	if v, ok := i.(InAppPurchaseAvailability); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchaseContent(i interface{}) *InAppPurchaseContent {
+	if v, ok := i.(InAppPurchaseContent); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchaseLocalization(i interface{}) *InAppPurchaseLocalization {
+	if v, ok := i.(InAppPurchaseLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchasePrice(i interface{}) *InAppPurchasePrice {
+	if v, ok := i.(InAppPurchasePrice); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchasePricePoint(i interface{}) *InAppPurchasePricePoint {
+	if v, ok := i.(InAppPurchasePricePoint); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchasePriceSchedule(i interface{}) *InAppPurchasePriceSchedule {
+	if v, ok := i.(InAppPurchasePriceSchedule); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchaseV2(i interface{}) *InAppPurchaseV2 {
+	if v, ok := i.(InAppPurchaseV2); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedPerfPowerMetric(i interface{}) *PerfPowerMetric {
+	if v, ok := i.(PerfPowerMetric); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedPrereleaseVersion(i interface{}) *PrereleaseVersion {
+	if v, ok := i.(PrereleaseVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedProfile(i interface{}) *Profile {
+	if v, ok := i.(Profile); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedReviewSubmission(i interface{}) *ReviewSubmission {
+	if v, ok := i.(ReviewSubmission); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedReviewSubmissionItem(i interface{}) *ReviewSubmissionItem {
+	if v, ok := i.(ReviewSubmissionItem); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedRoutingAppCoverage(i interface{}) *RoutingAppCoverage {
+	if v, ok := i.(RoutingAppCoverage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSCMGitReference(i interface{}) *SCMGitReference {
+	if v, ok := i.(SCMGitReference); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSCMProvider(i interface{}) *SCMProvider {
+	if v, ok := i.(SCMProvider); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSCMPullRequest(i interface{}) *SCMPullRequest {
+	if v, ok := i.(SCMPullRequest); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSCMRepository(i interface{}) *SCMRepository {
+	if v, ok := i.(SCMRepository); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscription(i interface{}) *Subscription {
+	if v, ok := i.(Subscription); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionAppStoreReviewScreenshot(i interface{}) *SubscriptionAppStoreReviewScreenshot {
+	if v, ok := i.(SubscriptionAppStoreReviewScreenshot); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionAvailability(i interface{}) *SubscriptionAvailability {
+	if v, ok := i.(SubscriptionAvailability); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionGroup(i interface{}) *SubscriptionGroup {
+	if v, ok := i.(SubscriptionGroup); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionGroupLocalization(i interface{}) *SubscriptionGroupLocalization {
+	if v, ok := i.(SubscriptionGroupLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionIntroductoryOffer(i interface{}) *SubscriptionIntroductoryOffer {
+	if v, ok := i.(SubscriptionIntroductoryOffer); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionLocalization(i interface{}) *SubscriptionLocalization {
+	if v, ok := i.(SubscriptionLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionOfferCode(i interface{}) *SubscriptionOfferCode {
+	if v, ok := i.(SubscriptionOfferCode); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionOfferCodeCustomCode(i interface{}) *SubscriptionOfferCodeCustomCode {
+	if v, ok := i.(SubscriptionOfferCodeCustomCode); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionOfferCodeOneTimeUseCode(i interface{}) *SubscriptionOfferCodeOneTimeUseCode {
+	if v, ok := i.(SubscriptionOfferCodeOneTimeUseCode); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionOfferCodePrice(i interface{}) *SubscriptionOfferCodePrice {
+	if v, ok := i.(SubscriptionOfferCodePrice); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionPrice(i interface{}) *SubscriptionPrice {
+	if v, ok := i.(SubscriptionPrice); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionPricePoint(i interface{}) *SubscriptionPricePoint {
+	if v, ok := i.(SubscriptionPricePoint); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionPromotionalOffer(i interface{}) *SubscriptionPromotionalOffer {
+	if v, ok := i.(SubscriptionPromotionalOffer); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedSubscriptionPromotionalOfferPrice(i interface{}) *SubscriptionPromotionalOfferPrice {
+	if v, ok := i.(SubscriptionPromotionalOfferPrice); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedTerritory(i interface{}) *Territory {
+	if v, ok := i.(Territory); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedWinBackOffer(i interface{}) *WinBackOffer {
+	if v, ok := i.(WinBackOffer); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedWinBackOfferPrice(i interface{}) *WinBackOfferPrice {
+	if v, ok := i.(WinBackOfferPrice); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func unmarshalInclude(b []byte) (string, interface{}, error) {
+	var typeRef struct {
+		Type string `json:"type"`
+	}
+
+	err := json.Unmarshal(b, &typeRef)
+	if err != nil {
+		return "", nil, err
+	}
+
+	typeName := typeRef.Type
+
+	return supportedIncludeTypes()(typeName, b)
+}
+
+// unmarshalInAppPurchaseV2Include is unmarshalInclude for responses of the v2 in-app purchase API. The v1 and v2
+// in-app purchase models share the inAppPurchases type name, which unmarshalInclude decodes as the v1 InAppPurchase.
+func unmarshalInAppPurchaseV2Include(b []byte) (string, interface{}, error) {
+	typeName, inner, err := unmarshalInclude(b)
+	if err != nil || typeName != "inAppPurchases" {
+		return typeName, inner, err
+	}
+
+	var v InAppPurchaseV2
+	err = json.Unmarshal(b, &v)
+
+	return v.Type, v, err
+}
+
+type includeTypeUnmarshallers map[string]func([]byte) (string, interface{}, error)
+
+func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
+	allCases := includeTypeUnmarshallers{
+		"ageRatingDeclarations": func(b []byte) (string, interface{}, error) {
+			var v AgeRatingDeclaration
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"apps": func(b []byte) (string, interface{}, error) {
+			var v App
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCategories": func(b []byte) (string, interface{}, error) {
+			var v AppCategory
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClips": func(b []byte) (string, interface{}, error) {
+			var v AppClip
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipAdvancedExperienceImages": func(b []byte) (string, interface{}, error) {
+			var v AppClipAdvancedExperienceImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipAdvancedExperienceLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppClipAdvancedExperienceLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipAppStoreReviewDetails": func(b []byte) (string, interface{}, error) {
+			var v AppClipAppStoreReviewDetail
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipDefaultExperiences": func(b []byte) (string, interface{}, error) {
+			var v AppClipDefaultExperience
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipDefaultExperienceLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppClipDefaultExperienceLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipHeaderImages": func(b []byte) (string, interface{}, error) {
+			var v AppClipHeaderImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCustomProductPages": func(b []byte) (string, interface{}, error) {
+			var v AppCustomProductPage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCustomProductPageLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppCustomProductPageLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCustomProductPageVersions": func(b []byte) (string, interface{}, error) {
+			var v AppCustomProductPageVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEncryptionDeclarations": func(b []byte) (string, interface{}, error) {
+			var v AppEncryptionDeclaration
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEvents": func(b []byte) (string, interface{}, error) {
+			var v AppEvent
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEventLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppEventLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEventScreenshots": func(b []byte) (string, interface{}, error) {
+			var v AppEventScreenshot
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEventVideoClips": func(b []byte) (string, interface{}, error) {
+			var v AppEventVideoClip
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appInfos": func(b []byte) (string, interface{}, error) {
+			var v AppInfo
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appInfoLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppInfoLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appPreOrders": func(b []byte) (string, interface{}, error) {
+			var v AppPreOrder
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appPreviewSets": func(b []byte) (string, interface{}, error) {
+			var v AppPreviewSet
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appPrices": func(b []byte) (string, interface{}, error) {
+			var v AppPrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appPricePoints": func(b []byte) (string, interface{}, error) {
+			var v AppPricePointV3
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appScreenshotSets": func(b []byte) (string, interface{}, error) {
+			var v AppScreenshotSet
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appStoreReviewDetails": func(b []byte) (string, interface{}, error) {
+			var v AppStoreReviewDetail
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appStoreVersions": func(b []byte) (string, interface{}, error) {
 			var v AppStoreVersion
 			err := json.Unmarshal(b, &v)
 
 			return v.Type, v, err
 		},
+		"appStoreVersionExperiments": func(b []byte) (string, interface{}, error) {
+			var v AppStoreVersionExperiment
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appStoreVersionExperimentTreatments": func(b []byte) (string, interface{}, error) {
+			var v AppStoreVersionExperimentTreatment
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appStoreVersionExperimentTreatmentLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppStoreVersionExperimentTreatmentLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"appStoreVersionLocalizations": func(b []byte) (string, interface{}, error) {
 			var v AppStoreVersionLocalization
 			err := json.Unmarshal(b, &v)
@@ -498,6 +1164,12 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"betaRecruitmentCriteria": func(b []byte) (string, interface{}, error) {
+			var v BetaRecruitmentCriterion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"betaTesters": func(b []byte) (string, interface{}, error) {
 			var v BetaTester
 			err := json.Unmarshal(b, &v)
@@ -540,6 +1212,30 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"ciMacOsVersions": func(b []byte) (string, interface{}, error) {
+			var v CIMacOSVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciProducts": func(b []byte) (string, interface{}, error) {
+			var v CIProduct
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciWorkflows": func(b []byte) (string, interface{}, error) {
+			var v CIWorkflow
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciXcodeVersions": func(b []byte) (string, interface{}, error) {
+			var v CIXcodeVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"devices": func(b []byte) (string, interface{}, error) {
 			var v Device
 			err := json.Unmarshal(b, &v)
@@ -558,12 +1254,96 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"gameCenterAchievements": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievement
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAchievementImages": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievementImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAchievementLocalizations": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievementLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAchievementReleases": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievementRelease
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterDetails": func(b []byte) (string, interface{}, error) {
+			var v GameCenterDetail
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"gameCenterEnabledVersions": func(b []byte) (string, interface{}, error) {
 			var v GameCenterEnabledVersion
 			err := json.Unmarshal(b, &v)
 
 			return v.Type, v, err
 		},
+		"gameCenterGroups": func(b []byte) (string, interface{}, error) {
+			var v GameCenterGroup
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboards": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboard
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardImages": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardLocalizations": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardReleases": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardRelease
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardSets": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardSet
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardSetImages": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardSetImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardSetLocalizations": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardSetLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboardSetReleases": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboardSetRelease
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"idfaDeclarations": func(b []byte) (string, interface{}, error) {
 			var v IDFADeclaration
 			err := json.Unmarshal(b, &v)
@@ -576,6 +1356,48 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"inAppPurchaseAppStoreReviewScreenshots": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchaseAppStoreReviewScreenshot
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"inAppPurchaseAvailabilities": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchaseAvailability
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"inAppPurchaseContents": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchaseContent
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"inAppPurchaseLocalizations": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchaseLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"inAppPurchasePrices": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchasePrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"inAppPurchasePricePoints": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchasePricePoint
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"inAppPurchasePriceSchedules": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchasePriceSchedule
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"perfPowerMetrics": func(b []byte) (string, interface{}, error) {
 			var v PerfPowerMetric
 			err := json.Unmarshal(b, &v)
@@ -594,16 +1416,154 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"reviewSubmissions": func(b []byte) (string, interface{}, error) {
+			var v ReviewSubmission
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"reviewSubmissionItems": func(b []byte) (string, interface{}, error) {
+			var v ReviewSubmissionItem
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"routingAppCoverages": func(b []byte) (string, interface{}, error) {
 			var v RoutingAppCoverage
 			err := json.Unmarshal(b, &v)
 
 			return v.Type, v, err
 		},
+		"scmGitReferences": func(b []byte) (string, interface{}, error) {
+			var v SCMGitReference
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"scmProviders": func(b []byte) (string, interface{}, error) {
+			var v SCMProvider
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"scmPullRequests": func(b []byte) (string, interface{}, error) {
+			var v SCMPullRequest
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"scmRepositories": func(b []byte) (string, interface{}, error) {
+			var v SCMRepository
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptions": func(b []byte) (string, interface{}, error) {
+			var v Subscription
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionAppStoreReviewScreenshots": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionAppStoreReviewScreenshot
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionAvailabilities": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionAvailability
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionGroups": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionGroup
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionGroupLocalizations": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionGroupLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionIntroductoryOffers": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionIntroductoryOffer
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionLocalizations": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodes": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCode
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodeCustomCodes": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCodeCustomCode
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodeOneTimeUseCodes": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCodeOneTimeUseCode
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodePrices": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCodePrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionPrices": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionPrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionPricePoints": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionPricePoint
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionPromotionalOffers": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionPromotionalOffer
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionPromotionalOfferPrices": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionPromotionalOfferPrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"territories": func(b []byte) (string, interface{}, error) {
 			var v Territory
 			err := json.Unmarshal(b, &v)
 
+			return v.Type, v, err
+		},
+		"winBackOffers": func(b []byte) (string, interface{}, error) {
+			var v WinBackOffer
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"winBackOfferPrices": func(b []byte) (string, interface{}, error) {
+			var v WinBackOfferPrice
+			err := json.Unmarshal(b, &v)
+
 			return v.Type, v, err
 		},
 	}
@@ -80,7 +80,26 @@ func TestKnownIncludeTypes(t *testing.T) {
 		"betaBuildLocalizations", "betaGroups", "betaLicenseAgreements", "betaTesters", "builds", "buildBetaDetails",
 		"buildIcons", "bundleIds", "bundleIdCapabilities", "certificates", "devices", "diagnosticSignatures",
 		"endUserLicenseAgreements", "gameCenterEnabledVersions", "idfaDeclarations", "inAppPurchases", "perfPowerMetrics",
-		"preReleaseVersions", "profiles", "routingAppCoverages", "territories"}
+		"preReleaseVersions", "profiles", "routingAppCoverages", "territories", "inAppPurchaseLocalizations",
+		"inAppPurchaseContents", "inAppPurchaseAppStoreReviewScreenshots", "inAppPurchasePriceSchedules",
+		"inAppPurchaseAvailabilities", "inAppPurchasePrices", "inAppPurchasePricePoints", "subscriptionGroups",
+		"subscriptionGroupLocalizations", "subscriptions", "subscriptionLocalizations",
+		"subscriptionAppStoreReviewScreenshots", "subscriptionAvailabilities", "subscriptionPrices",
+		"subscriptionPricePoints", "subscriptionIntroductoryOffers", "subscriptionPromotionalOffers",
+		"subscriptionPromotionalOfferPrices", "subscriptionOfferCodes", "subscriptionOfferCodePrices",
+		"subscriptionOfferCodeOneTimeUseCodes", "subscriptionOfferCodeCustomCodes", "winBackOffers",
+		"winBackOfferPrices", "ciProducts", "ciWorkflows", "ciXcodeVersions", "ciMacOsVersions", "scmProviders",
+		"scmRepositories", "scmGitReferences", "scmPullRequests", "reviewSubmissions", "reviewSubmissionItems",
+		"appEvents", "appEventLocalizations", "appEventScreenshots", "appEventVideoClips", "appCustomProductPages",
+		"appCustomProductPageVersions", "appCustomProductPageLocalizations", "appStoreVersionExperiments",
+		"appStoreVersionExperimentTreatments", "appStoreVersionExperimentTreatmentLocalizations", "appPricePoints",
+		"betaRecruitmentCriteria", "appClips", "appClipDefaultExperiences", "appClipAppStoreReviewDetails",
+		"appClipDefaultExperienceLocalizations", "appClipHeaderImages", "appClipAdvancedExperienceImages",
+		"appClipAdvancedExperienceLocalizations", "gameCenterDetails", "gameCenterGroups", "gameCenterAchievements",
+		"gameCenterAchievementLocalizations", "gameCenterAchievementImages", "gameCenterAchievementReleases",
+		"gameCenterLeaderboards", "gameCenterLeaderboardLocalizations", "gameCenterLeaderboardImages",
+		"gameCenterLeaderboardReleases", "gameCenterLeaderboardSets", "gameCenterLeaderboardSetLocalizations",
+		"gameCenterLeaderboardSetImages", "gameCenterLeaderboardSetReleases"}
 
 	var payload *mockPayloadIncluded
 
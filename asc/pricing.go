@@ -28,6 +28,8 @@ import (
 // PricingService handles communication with pricing-related methods of the App Store Connect API
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/app_prices
+// https://developer.apple.com/documentation/appstoreconnectapi/app_price_schedules
+// https://developer.apple.com/documentation/appstoreconnectapi/app_availabilities
 // https://developer.apple.com/documentation/appstoreconnectapi/territories
 // https://developer.apple.com/documentation/appstoreconnectapi/app_price_reference_data
 type PricingService service
@@ -36,18 +38,32 @@ type PricingService service
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appprice
 type AppPrice struct {
+	Attributes    *AppPriceAttributes    `json:"attributes,omitempty"`
 	ID            string                 `json:"id"`
 	Links         ResourceLinks          `json:"links"`
 	Relationships *AppPriceRelationships `json:"relationships,omitempty"`
 	Type          string                 `json:"type"`
 }
 
+// AppPriceAttributes defines model for AppPriceV2.Attributes
+//
+// Attributes are only set on prices that belong to an app price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricev2/attributes
+type AppPriceAttributes struct {
+	EndDate   *Date `json:"endDate,omitempty"`
+	Manual    *bool `json:"manual,omitempty"`
+	StartDate *Date `json:"startDate,omitempty"`
+}
+
 // AppPriceRelationships defines model for AppPrice.Relationships
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appprice/relationships
 type AppPriceRelationships struct {
-	App       *Relationship `json:"app,omitempty"`
-	PriceTier *Relationship `json:"priceTier,omitempty"`
+	App           *Relationship `json:"app,omitempty"`
+	AppPricePoint *Relationship `json:"appPricePoint,omitempty"`
+	PriceTier     *Relationship `json:"priceTier,omitempty"`
+	Territory     *Relationship `json:"territory,omitempty"`
 }
 
 // AppPriceResponse defines model for AppPriceResponse.
@@ -0,0 +1,504 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrTerritoryNotInAvailability happens when a territory is not one of the territory availabilities of an app availability.
+var ErrTerritoryNotInAvailability = errors.New("territory is not part of the app availability")
+
+// TerritoryAvailabilityContentStatus defines model for TerritoryAvailability.Attributes.ContentStatuses
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability/attributes
+type TerritoryAvailabilityContentStatus string
+
+const (
+	// TerritoryAvailabilityContentStatusAvailable is a content status for Available.
+	TerritoryAvailabilityContentStatusAvailable TerritoryAvailabilityContentStatus = "AVAILABLE"
+	// TerritoryAvailabilityContentStatusAvailableForPreorder is a content status for AvailableForPreorder.
+	TerritoryAvailabilityContentStatusAvailableForPreorder TerritoryAvailabilityContentStatus = "AVAILABLE_FOR_PREORDER"
+	// TerritoryAvailabilityContentStatusAvailableForPreorderOnDate is a content status for AvailableForPreorderOnDate.
+	TerritoryAvailabilityContentStatusAvailableForPreorderOnDate TerritoryAvailabilityContentStatus = "AVAILABLE_FOR_PREORDER_ON_DATE"
+	// TerritoryAvailabilityContentStatusAvailableForSaleUnreleasedApp is a content status for AvailableForSaleUnreleasedApp.
+	TerritoryAvailabilityContentStatusAvailableForSaleUnreleasedApp TerritoryAvailabilityContentStatus = "AVAILABLE_FOR_SALE_UNRELEASED_APP"
+	// TerritoryAvailabilityContentStatusBrazilRequiredTaxID is a content status for BrazilRequiredTaxID.
+	TerritoryAvailabilityContentStatusBrazilRequiredTaxID TerritoryAvailabilityContentStatus = "BRAZIL_REQUIRED_TAX_ID"
+	// TerritoryAvailabilityContentStatusCannotSell is a content status for CannotSell.
+	TerritoryAvailabilityContentStatusCannotSell TerritoryAvailabilityContentStatus = "CANNOT_SELL"
+	// TerritoryAvailabilityContentStatusCannotSellAdultOnly is a content status for CannotSellAdultOnly.
+	TerritoryAvailabilityContentStatusCannotSellAdultOnly TerritoryAvailabilityContentStatus = "CANNOT_SELL_ADULT_ONLY"
+	// TerritoryAvailabilityContentStatusCannotSellCasino is a content status for CannotSellCasino.
+	TerritoryAvailabilityContentStatusCannotSellCasino TerritoryAvailabilityContentStatus = "CANNOT_SELL_CASINO"
+	// TerritoryAvailabilityContentStatusCannotSellCasinoWithoutAgeVerification is a content status for CannotSellCasinoWithoutAgeVerification.
+	TerritoryAvailabilityContentStatusCannotSellCasinoWithoutAgeVerification TerritoryAvailabilityContentStatus = "CANNOT_SELL_CASINO_WITHOUT_AGE_VERIFICATION"
+	// TerritoryAvailabilityContentStatusCannotSellCasinoWithoutGRAC is a content status for CannotSellCasinoWithoutGRAC.
+	TerritoryAvailabilityContentStatusCannotSellCasinoWithoutGRAC TerritoryAvailabilityContentStatus = "CANNOT_SELL_CASINO_WITHOUT_GRAC"
+	// TerritoryAvailabilityContentStatusCannotSellContests is a content status for CannotSellContests.
+	TerritoryAvailabilityContentStatusCannotSellContests TerritoryAvailabilityContentStatus = "CANNOT_SELL_CONTESTS"
+	// TerritoryAvailabilityContentStatusCannotSellFrequentIntense is a content status for CannotSellFrequentIntense.
+	TerritoryAvailabilityContentStatusCannotSellFrequentIntense TerritoryAvailabilityContentStatus = "CANNOT_SELL_FREQUENT_INTENSE"
+	// TerritoryAvailabilityContentStatusCannotSellFrequentIntenseAlcoholTobaccoDrugs is a content status for CannotSellFrequentIntenseAlcoholTobaccoDrugs.
+	TerritoryAvailabilityContentStatusCannotSellFrequentIntenseAlcoholTobaccoDrugs TerritoryAvailabilityContentStatus = "CANNOT_SELL_FREQUENT_INTENSE_ALCOHOL_TOBACCO_DRUGS"
+	// TerritoryAvailabilityContentStatusCannotSellFrequentIntenseGambling is a content status for CannotSellFrequentIntenseGambling.
+	TerritoryAvailabilityContentStatusCannotSellFrequentIntenseGambling TerritoryAvailabilityContentStatus = "CANNOT_SELL_FREQUENT_INTENSE_GAMBLING"
+	// TerritoryAvailabilityContentStatusCannotSellFrequentIntenseSexualContentNudity is a content status for CannotSellFrequentIntenseSexualContentNudity.
+	TerritoryAvailabilityContentStatusCannotSellFrequentIntenseSexualContentNudity TerritoryAvailabilityContentStatus = "CANNOT_SELL_FREQUENT_INTENSE_SEXUAL_CONTENT_NUDITY"
+	// TerritoryAvailabilityContentStatusCannotSellFrequentIntenseViolence is a content status for CannotSellFrequentIntenseViolence.
+	TerritoryAvailabilityContentStatusCannotSellFrequentIntenseViolence TerritoryAvailabilityContentStatus = "CANNOT_SELL_FREQUENT_INTENSE_VIOLENCE"
+	// TerritoryAvailabilityContentStatusCannotSellFrequentIntenseWithoutGRAC is a content status for CannotSellFrequentIntenseWithoutGRAC.
+	TerritoryAvailabilityContentStatusCannotSellFrequentIntenseWithoutGRAC TerritoryAvailabilityContentStatus = "CANNOT_SELL_FREQUENT_INTENSE_WITHOUT_GRAC"
+	// TerritoryAvailabilityContentStatusCannotSellGambling is a content status for CannotSellGambling.
+	TerritoryAvailabilityContentStatusCannotSellGambling TerritoryAvailabilityContentStatus = "CANNOT_SELL_GAMBLING"
+	// TerritoryAvailabilityContentStatusCannotSellGamblingContests is a content status for CannotSellGamblingContests.
+	TerritoryAvailabilityContentStatusCannotSellGamblingContests TerritoryAvailabilityContentStatus = "CANNOT_SELL_GAMBLING_CONTESTS"
+	// TerritoryAvailabilityContentStatusCannotSellInfrequentMildAlcoholTobaccoDrugs is a content status for CannotSellInfrequentMildAlcoholTobaccoDrugs.
+	TerritoryAvailabilityContentStatusCannotSellInfrequentMildAlcoholTobaccoDrugs TerritoryAvailabilityContentStatus = "CANNOT_SELL_INFREQUENT_MILD_ALCOHOL_TOBACCO_DRUGS"
+	// TerritoryAvailabilityContentStatusCannotSellInfrequentMildSexualContentNudity is a content status for CannotSellInfrequentMildSexualContentNudity.
+	TerritoryAvailabilityContentStatusCannotSellInfrequentMildSexualContentNudity TerritoryAvailabilityContentStatus = "CANNOT_SELL_INFREQUENT_MILD_SEXUAL_CONTENT_NUDITY"
+	// TerritoryAvailabilityContentStatusCannotSellNonIOSGames is a content status for CannotSellNonIOSGames.
+	TerritoryAvailabilityContentStatusCannotSellNonIOSGames TerritoryAvailabilityContentStatus = "CANNOT_SELL_NON_IOS_GAMES"
+	// TerritoryAvailabilityContentStatusCannotSellRestrictedRating is a content status for CannotSellRestrictedRating.
+	TerritoryAvailabilityContentStatusCannotSellRestrictedRating TerritoryAvailabilityContentStatus = "CANNOT_SELL_RESTRICTED_RATING"
+	// TerritoryAvailabilityContentStatusCannotSellSeventeenPlusApps is a content status for CannotSellSeventeenPlusApps.
+	TerritoryAvailabilityContentStatusCannotSellSeventeenPlusApps TerritoryAvailabilityContentStatus = "CANNOT_SELL_SEVENTEEN_PLUS_APPS"
+	// TerritoryAvailabilityContentStatusCannotSellSeventeenPlusGames is a content status for CannotSellSeventeenPlusGames.
+	TerritoryAvailabilityContentStatusCannotSellSeventeenPlusGames TerritoryAvailabilityContentStatus = "CANNOT_SELL_SEVENTEEN_PLUS_GAMES"
+	// TerritoryAvailabilityContentStatusCannotSellSexuallyExplicit is a content status for CannotSellSexuallyExplicit.
+	TerritoryAvailabilityContentStatusCannotSellSexuallyExplicit TerritoryAvailabilityContentStatus = "CANNOT_SELL_SEXUALLY_EXPLICIT"
+	// TerritoryAvailabilityContentStatusICPNumberInvalid is a content status for ICPNumberInvalid.
+	TerritoryAvailabilityContentStatusICPNumberInvalid TerritoryAvailabilityContentStatus = "ICP_NUMBER_INVALID"
+	// TerritoryAvailabilityContentStatusICPNumberMissing is a content status for ICPNumberMissing.
+	TerritoryAvailabilityContentStatusICPNumberMissing TerritoryAvailabilityContentStatus = "ICP_NUMBER_MISSING"
+	// TerritoryAvailabilityContentStatusMissingGRN is a content status for MissingGRN.
+	TerritoryAvailabilityContentStatusMissingGRN TerritoryAvailabilityContentStatus = "MISSING_GRN"
+	// TerritoryAvailabilityContentStatusMissingRating is a content status for MissingRating.
+	TerritoryAvailabilityContentStatusMissingRating TerritoryAvailabilityContentStatus = "MISSING_RATING"
+	// TerritoryAvailabilityContentStatusPreorderOnUnreleasedApp is a content status for PreorderOnUnreleasedApp.
+	TerritoryAvailabilityContentStatusPreorderOnUnreleasedApp TerritoryAvailabilityContentStatus = "PREORDER_ON_UNRELEASED_APP"
+	// TerritoryAvailabilityContentStatusProcessingToAvailable is a content status for ProcessingToAvailable.
+	TerritoryAvailabilityContentStatusProcessingToAvailable TerritoryAvailabilityContentStatus = "PROCESSING_TO_AVAILABLE"
+	// TerritoryAvailabilityContentStatusProcessingToNotAvailable is a content status for ProcessingToNotAvailable.
+	TerritoryAvailabilityContentStatusProcessingToNotAvailable TerritoryAvailabilityContentStatus = "PROCESSING_TO_NOT_AVAILABLE"
+	// TerritoryAvailabilityContentStatusProcessingToPreOrder is a content status for ProcessingToPreOrder.
+	TerritoryAvailabilityContentStatusProcessingToPreOrder TerritoryAvailabilityContentStatus = "PROCESSING_TO_PRE_ORDER"
+	// TerritoryAvailabilityContentStatusTraderStatusNotProvided is a content status for TraderStatusNotProvided.
+	TerritoryAvailabilityContentStatusTraderStatusNotProvided TerritoryAvailabilityContentStatus = "TRADER_STATUS_NOT_PROVIDED"
+	// TerritoryAvailabilityContentStatusTraderStatusVerificationFailed is a content status for TraderStatusVerificationFailed.
+	TerritoryAvailabilityContentStatusTraderStatusVerificationFailed TerritoryAvailabilityContentStatus = "TRADER_STATUS_VERIFICATION_FAILED"
+	// TerritoryAvailabilityContentStatusTraderStatusVerificationStatusMissing is a content status for TraderStatusVerificationStatusMissing.
+	TerritoryAvailabilityContentStatusTraderStatusVerificationStatusMissing TerritoryAvailabilityContentStatus = "TRADER_STATUS_VERIFICATION_STATUS_MISSING"
+	// TerritoryAvailabilityContentStatusUnverifiedGRN is a content status for UnverifiedGRN.
+	TerritoryAvailabilityContentStatusUnverifiedGRN TerritoryAvailabilityContentStatus = "UNVERIFIED_GRN"
+)
+
+// AppAvailability defines model for AppAvailabilityV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2
+type AppAvailability struct {
+	Attributes    *AppAvailabilityAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *AppAvailabilityRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// AppAvailabilityAttributes defines model for AppAvailabilityV2.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2/attributes
+type AppAvailabilityAttributes struct {
+	AvailableInNewTerritories *bool `json:"availableInNewTerritories,omitempty"`
+}
+
+// AppAvailabilityRelationships defines model for AppAvailabilityV2.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2/relationships
+type AppAvailabilityRelationships struct {
+	TerritoryAvailabilities *PagedRelationship `json:"territoryAvailabilities,omitempty"`
+}
+
+// appAvailabilityCreateRequest defines model for AppAvailabilityV2CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2createrequest/data
+type appAvailabilityCreateRequest struct {
+	Attributes    appAvailabilityCreateRequestAttributes    `json:"attributes"`
+	Relationships appAvailabilityCreateRequestRelationships `json:"relationships"`
+	Type          string                                    `json:"type"`
+}
+
+// appAvailabilityCreateRequestAttributes are attributes for AppAvailabilityV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2createrequest/data/attributes
+type appAvailabilityCreateRequestAttributes struct {
+	AvailableInNewTerritories bool `json:"availableInNewTerritories"`
+}
+
+// appAvailabilityCreateRequestRelationships are relationships for AppAvailabilityV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2createrequest/data/relationships
+type appAvailabilityCreateRequestRelationships struct {
+	App                     relationshipDeclaration      `json:"app"`
+	TerritoryAvailabilities pagedRelationshipDeclaration `json:"territoryAvailabilities"`
+}
+
+// AppAvailabilityResponse defines model for AppAvailabilityV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2response
+type AppAvailabilityResponse struct {
+	Data     AppAvailability         `json:"data"`
+	Included []TerritoryAvailability `json:"included,omitempty"`
+	Links    DocumentLinks           `json:"links"`
+}
+
+// TerritoryAvailability defines model for TerritoryAvailability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability
+type TerritoryAvailability struct {
+	Attributes    *TerritoryAvailabilityAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *TerritoryAvailabilityRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// TerritoryAvailabilityAttributes defines model for TerritoryAvailability.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability/attributes
+type TerritoryAvailabilityAttributes struct {
+	Available           *bool                                `json:"available,omitempty"`
+	ContentStatuses     []TerritoryAvailabilityContentStatus `json:"contentStatuses,omitempty"`
+	PreOrderEnabled     *bool                                `json:"preOrderEnabled,omitempty"`
+	PreOrderPublishDate *Date                                `json:"preOrderPublishDate,omitempty"`
+	ReleaseDate         *Date                                `json:"releaseDate,omitempty"`
+}
+
+// TerritoryAvailabilityRelationships defines model for TerritoryAvailability.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability/relationships
+type TerritoryAvailabilityRelationships struct {
+	Territory *Relationship `json:"territory,omitempty"`
+}
+
+// territoryAvailabilityUpdateRequest defines model for TerritoryAvailabilityUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilityupdaterequest/data
+type territoryAvailabilityUpdateRequest struct {
+	Attributes *TerritoryAvailabilityUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// TerritoryAvailabilityUpdateRequestAttributes are attributes for TerritoryAvailabilityUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilityupdaterequest/data/attributes
+type TerritoryAvailabilityUpdateRequestAttributes struct {
+	Available       *bool `json:"available,omitempty"`
+	PreOrderEnabled *bool `json:"preOrderEnabled,omitempty"`
+	ReleaseDate     *Date `json:"releaseDate,omitempty"`
+}
+
+// TerritoryAvailabilityResponse defines model for TerritoryAvailabilityResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilityresponse
+type TerritoryAvailabilityResponse struct {
+	Data     TerritoryAvailability `json:"data"`
+	Included []Territory           `json:"included,omitempty"`
+	Links    DocumentLinks         `json:"links"`
+}
+
+// TerritoryAvailabilitiesResponse defines model for TerritoryAvailabilitiesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilitiesresponse
+type TerritoryAvailabilitiesResponse struct {
+	Data     []TerritoryAvailability `json:"data"`
+	Included []Territory             `json:"included,omitempty"`
+	Links    PagedDocumentLinks      `json:"links"`
+	Meta     *PagingInformation      `json:"meta,omitempty"`
+}
+
+// NewTerritoryAvailability models the parameters for a territory in a new app availability.
+//
+// Set ReleaseDate to nil to release the app in the territory as soon as it's available. PreOrderEnabled
+// requires a ReleaseDate in the future.
+type NewTerritoryAvailability struct {
+	Available       bool
+	PreOrderEnabled bool
+	ReleaseDate     *Date
+	TerritoryID     string
+}
+
+type territoryAvailabilityInlineCreate struct {
+	Attributes    territoryAvailabilityInlineCreateAttributes    `json:"attributes"`
+	ID            string                                         `json:"id"`
+	Relationships territoryAvailabilityInlineCreateRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+type territoryAvailabilityInlineCreateAttributes struct {
+	Available       bool  `json:"available"`
+	PreOrderEnabled bool  `json:"preOrderEnabled"`
+	ReleaseDate     *Date `json:"releaseDate,omitempty"`
+}
+
+type territoryAvailabilityInlineCreateRelationships struct {
+	Territory relationshipDeclaration `json:"territory"`
+}
+
+func (t NewTerritoryAvailability) inlineCreate(index int) territoryAvailabilityInlineCreate {
+	return territoryAvailabilityInlineCreate{
+		Attributes: territoryAvailabilityInlineCreateAttributes{
+			Available:       t.Available,
+			PreOrderEnabled: t.PreOrderEnabled,
+			ReleaseDate:     t.ReleaseDate,
+		},
+		ID: fmt.Sprintf("${new-territory-availability-%d}", index),
+		Relationships: territoryAvailabilityInlineCreateRelationships{
+			Territory: *newRelationshipDeclaration(&t.TerritoryID, "territories"),
+		},
+		Type: "territoryAvailabilities",
+	}
+}
+
+// GetAppAvailabilityQuery are query options for GetAppAvailability and GetAppAvailabilityForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_availability_information_v2
+type GetAppAvailabilityQuery struct {
+	FieldsAppAvailabilities       []string `url:"fields[appAvailabilities],omitempty"`
+	FieldsTerritoryAvailabilities []string `url:"fields[territoryAvailabilities],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	LimitTerritoryAvailabilities  int      `url:"limit[territoryAvailabilities],omitempty"`
+}
+
+// ListTerritoryAvailabilitiesQuery are query options for ListTerritoryAvailabilitiesForAppAvailability
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_territory_availabilities_for_an_app_availability_v2
+type ListTerritoryAvailabilitiesQuery struct {
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FieldsTerritoryAvailabilities []string `url:"fields[territoryAvailabilities],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetAppAvailabilityForApp gets the availability of an app in App Store territories.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_for_an_app_v2
+func (s *PricingService) GetAppAvailabilityForApp(ctx context.Context, id string, params *GetAppAvailabilityQuery) (*AppAvailabilityResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appAvailabilityV2", id)
+	res := new(AppAvailabilityResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppAvailability gets a specific app availability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_availability_information_v2
+func (s *PricingService) GetAppAvailability(ctx context.Context, id string, params *GetAppAvailabilityQuery) (*AppAvailabilityResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("appAvailabilities/%s", id))
+	res := new(AppAvailabilityResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppAvailability replaces the availability of an app with one that covers exactly the given territories.
+// Once an app has an availability, prefer UpdateTerritoryAvailability to change a single territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_availability_v2
+func (s *PricingService) CreateAppAvailability(ctx context.Context, appID string, availableInNewTerritories bool, territories []NewTerritoryAvailability) (*AppAvailabilityResponse, *Response, error) {
+	newTerritories := make([]territoryAvailabilityInlineCreate, len(territories))
+	territoryIDs := make([]string, len(territories))
+
+	for i, territory := range territories {
+		newTerritory := territory.inlineCreate(i)
+		newTerritories[i] = newTerritory
+		territoryIDs[i] = newTerritory.ID
+	}
+
+	req := appAvailabilityCreateRequest{
+		Attributes: appAvailabilityCreateRequestAttributes{
+			AvailableInNewTerritories: availableInNewTerritories,
+		},
+		Relationships: appAvailabilityCreateRequestRelationships{
+			App:                     *newRelationshipDeclaration(&appID, "apps"),
+			TerritoryAvailabilities: newPagedRelationshipDeclaration(territoryIDs, "territoryAvailabilities"),
+		},
+		Type: "appAvailabilities",
+	}
+	res := new(AppAvailabilityResponse)
+	resp, err := s.client.post(ctx, versionedPath("v2", "appAvailabilities"), newRequestBodyWithIncluded(req, newTerritories), res)
+
+	return res, resp, err
+}
+
+// ListTerritoryAvailabilitiesForAppAvailability lists the availability of an app in each App Store territory,
+// including the content statuses that explain why a territory is unavailable.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_territory_availabilities_for_an_app_availability_v2
+func (s *PricingService) ListTerritoryAvailabilitiesForAppAvailability(ctx context.Context, id string, params *ListTerritoryAvailabilitiesQuery) (*TerritoryAvailabilitiesResponse, *Response, error) {
+	url := versionedPath("v2", fmt.Sprintf("appAvailabilities/%s/territoryAvailabilities", id))
+	res := new(TerritoryAvailabilitiesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// UpdateTerritoryAvailability changes whether an app is available in a single territory, and when it's released there.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_territory_availability
+func (s *PricingService) UpdateTerritoryAvailability(ctx context.Context, id string, attributes *TerritoryAvailabilityUpdateRequestAttributes) (*TerritoryAvailabilityResponse, *Response, error) {
+	req := territoryAvailabilityUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "territoryAvailabilities",
+	}
+	url := fmt.Sprintf("territoryAvailabilities/%s", id)
+	res := new(TerritoryAvailabilityResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// AddTerritoriesToAppAvailability makes an app available in the given territories, leaving all other
+// territories as they are. It returns the territory availabilities that were changed.
+func (s *PricingService) AddTerritoriesToAppAvailability(ctx context.Context, appAvailabilityID string, territoryIDs []string) ([]TerritoryAvailability, error) {
+	return s.setTerritoriesAvailable(ctx, appAvailabilityID, territoryIDs, true)
+}
+
+// RemoveTerritoriesFromAppAvailability makes an app unavailable in the given territories, leaving all other
+// territories as they are. It returns the territory availabilities that were changed.
+func (s *PricingService) RemoveTerritoriesFromAppAvailability(ctx context.Context, appAvailabilityID string, territoryIDs []string) ([]TerritoryAvailability, error) {
+	return s.setTerritoriesAvailable(ctx, appAvailabilityID, territoryIDs, false)
+}
+
+// SetAppAvailableInNewTerritories changes whether an app automatically becomes available in territories
+// the App Store expands to in the future. The availability in every current territory is preserved, except
+// for release dates that have already passed, which are dropped along with any pre-order that relied on them.
+func (s *PricingService) SetAppAvailableInNewTerritories(ctx context.Context, appID string, availableInNewTerritories bool) (*AppAvailabilityResponse, *Response, error) {
+	availability, resp, err := s.GetAppAvailabilityForApp(ctx, appID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	current, err := s.listAllTerritoryAvailabilities(ctx, availability.Data.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	territories := make([]NewTerritoryAvailability, 0, len(current))
+	now := today()
+
+	for _, territoryAvailability := range current {
+		territoryID := territoryIDForAvailability(territoryAvailability)
+		if territoryID == "" {
+			continue
+		}
+
+		territory := NewTerritoryAvailability{TerritoryID: territoryID}
+
+		if attrs := territoryAvailability.Attributes; attrs != nil {
+			territory.Available = attrs.Available != nil && *attrs.Available
+
+			if attrs.ReleaseDate != nil && attrs.ReleaseDate.After(now.Time) {
+				territory.PreOrderEnabled = attrs.PreOrderEnabled != nil && *attrs.PreOrderEnabled
+				territory.ReleaseDate = attrs.ReleaseDate
+			}
+		}
+
+		territories = append(territories, territory)
+	}
+
+	return s.CreateAppAvailability(ctx, appID, availableInNewTerritories, territories)
+}
+
+func (s *PricingService) setTerritoriesAvailable(ctx context.Context, appAvailabilityID string, territoryIDs []string, available bool) ([]TerritoryAvailability, error) {
+	current, err := s.listAllTerritoryAvailabilities(ctx, appAvailabilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	byTerritory := make(map[string]TerritoryAvailability, len(current))
+	for _, territoryAvailability := range current {
+		byTerritory[territoryIDForAvailability(territoryAvailability)] = territoryAvailability
+	}
+
+	for _, territoryID := range territoryIDs {
+		if _, ok := byTerritory[territoryID]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrTerritoryNotInAvailability, territoryID)
+		}
+	}
+
+	changed := make([]TerritoryAvailability, 0, len(territoryIDs))
+
+	for _, territoryID := range territoryIDs {
+		territoryAvailability := byTerritory[territoryID]
+		if attrs := territoryAvailability.Attributes; attrs != nil && attrs.Available != nil && *attrs.Available == available {
+			continue
+		}
+
+		res, _, err := s.UpdateTerritoryAvailability(ctx, territoryAvailability.ID, &TerritoryAvailabilityUpdateRequestAttributes{
+			Available: Bool(available),
+		})
+		if err != nil {
+			return changed, err
+		}
+
+		changed = append(changed, res.Data)
+	}
+
+	return changed, nil
+}
+
+func (s *PricingService) listAllTerritoryAvailabilities(ctx context.Context, appAvailabilityID string) ([]TerritoryAvailability, error) {
+	query := ListTerritoryAvailabilitiesQuery{
+		Include: []string{"territory"},
+		Limit:   200,
+	}
+
+	var all []TerritoryAvailability
+
+	for {
+		res, _, err := s.ListTerritoryAvailabilitiesForAppAvailability(ctx, appAvailabilityID, &query)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, res.Data...)
+
+		if res.Links.Next == nil || res.Links.Next.Cursor() == "" {
+			return all, nil
+		}
+
+		query.Cursor = res.Links.Next.Cursor()
+	}
+}
+
+func territoryIDForAvailability(territoryAvailability TerritoryAvailability) string {
+	if territoryAvailability.Relationships == nil || territoryAvailability.Relationships.Territory == nil || territoryAvailability.Relationships.Territory.Data == nil {
+		return ""
+	}
+
+	return territoryAvailability.Relationships.Territory.Data.ID
+}
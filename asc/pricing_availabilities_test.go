@@ -0,0 +1,181 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAppAvailabilityForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppAvailabilityForApp(ctx, "10", &GetAppAvailabilityQuery{})
+	})
+}
+
+func TestGetAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppAvailability(ctx, "10", &GetAppAvailabilityQuery{})
+	})
+}
+
+func TestCreateAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.CreateAppAvailability(ctx, "10", true, []NewTerritoryAvailability{{TerritoryID: "USA", Available: true}})
+	})
+}
+
+func TestListTerritoryAvailabilitiesForAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryAvailabilitiesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListTerritoryAvailabilitiesForAppAvailability(ctx, "10", &ListTerritoryAvailabilitiesQuery{})
+	})
+}
+
+func TestUpdateTerritoryAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.UpdateTerritoryAvailability(ctx, "10", &TerritoryAvailabilityUpdateRequestAttributes{Available: Bool(false)})
+	})
+}
+
+func newTerritoryAvailabilityServer() (*Client, *routedServer) {
+	update := func(req capturedRequest) routeResponse {
+		var body struct {
+			Data territoryAvailabilityUpdateRequest `json:"data"`
+		}
+
+		_ = req.decode(&body)
+
+		return routeResponse{Body: fmt.Sprintf(`{"data":{"id":"%s","type":"territoryAvailabilities"}}`, body.Data.ID)}
+	}
+
+	return newRoutedServer(map[string]route{
+		"GET /v1/apps/10/appAvailabilityV2": respondWith(http.StatusOK, `{"data":{"id":"av","type":"appAvailabilities","attributes":{"availableInNewTerritories":false}}}`),
+		"GET /v2/appAvailabilities/av/territoryAvailabilities": func(req capturedRequest) routeResponse {
+			if req.Query.Get("cursor") == "" {
+				return routeResponse{Body: fmt.Sprintf(`{"data":[{"id":"ta-usa","type":"territoryAvailabilities","attributes":{"available":true,"preOrderEnabled":true,"releaseDate":"2024-01-01"},"relationships":{"territory":{"data":{"id":"USA","type":"territories"}}}}],"links":{"self":"","next":"http://%s/v2/appAvailabilities/av/territoryAvailabilities?cursor=2"}}`, req.Host)}
+			}
+
+			return routeResponse{Body: `{"data":[{"id":"ta-fra","type":"territoryAvailabilities","attributes":{"available":false,"contentStatuses":["CANNOT_SELL_RESTRICTED_RATING"]},"relationships":{"territory":{"data":{"id":"FRA","type":"territories"}}}},{"id":"ta-jpn","type":"territoryAvailabilities","attributes":{"available":true,"preOrderEnabled":true,"releaseDate":"2099-06-01"},"relationships":{"territory":{"data":{"id":"JPN","type":"territories"}}}}],"links":{"self":""}}`}
+		},
+		"PATCH /v1/territoryAvailabilities/ta-usa": update,
+		"PATCH /v1/territoryAvailabilities/ta-fra": update,
+		"POST /v2/appAvailabilities":               respondWith(http.StatusOK, `{"data":{"id":"av2","type":"appAvailabilities"}}`),
+	})
+}
+
+func patchedTerritoryAvailabilities(t *testing.T, server *routedServer) map[string]bool {
+	t.Helper()
+
+	patched := map[string]bool{}
+
+	for _, id := range []string{"ta-usa", "ta-fra"} {
+		for _, req := range server.requests("PATCH /v1/territoryAvailabilities/" + id) {
+			var body struct {
+				Data territoryAvailabilityUpdateRequest `json:"data"`
+			}
+
+			assert.NoError(t, req.decode(&body))
+			patched[body.Data.ID] = *body.Data.Attributes.Available
+		}
+	}
+
+	return patched
+}
+
+func TestAddTerritoriesToAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTerritoryAvailabilityServer()
+	defer server.Close()
+
+	changed, err := client.Pricing.AddTerritoriesToAppAvailability(context.Background(), "av", []string{"USA", "FRA"})
+	assert.NoError(t, err)
+	assert.Len(t, changed, 1)
+	assert.Equal(t, "ta-fra", changed[0].ID)
+	assert.Equal(t, map[string]bool{"ta-fra": true}, patchedTerritoryAvailabilities(t, server))
+}
+
+func TestRemoveTerritoriesFromAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTerritoryAvailabilityServer()
+	defer server.Close()
+
+	changed, err := client.Pricing.RemoveTerritoriesFromAppAvailability(context.Background(), "av", []string{"USA"})
+	assert.NoError(t, err)
+	assert.Len(t, changed, 1)
+	assert.Equal(t, map[string]bool{"ta-usa": false}, patchedTerritoryAvailabilities(t, server))
+}
+
+func TestRemoveTerritoriesFromAppAvailabilityUnknownTerritory(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTerritoryAvailabilityServer()
+	defer server.Close()
+
+	changed, err := client.Pricing.RemoveTerritoriesFromAppAvailability(context.Background(), "av", []string{"USA", "CAN"})
+	assert.ErrorIs(t, err, ErrTerritoryNotInAvailability)
+	assert.Nil(t, changed)
+	assert.Empty(t, patchedTerritoryAvailabilities(t, server))
+}
+
+func TestSetAppAvailableInNewTerritories(t *testing.T) {
+	t.Parallel()
+
+	client, server := newTerritoryAvailabilityServer()
+	defer server.Close()
+
+	availability, _, err := client.Pricing.SetAppAvailableInNewTerritories(context.Background(), "10", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "av2", availability.Data.ID)
+
+	var body struct {
+		Data     appAvailabilityCreateRequest        `json:"data"`
+		Included []territoryAvailabilityInlineCreate `json:"included"`
+	}
+
+	requests := server.requests("POST /v2/appAvailabilities")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.True(t, body.Data.Attributes.AvailableInNewTerritories)
+
+	releaseDate := Date{time.Date(2099, 6, 1, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, []territoryAvailabilityInlineCreate{
+		NewTerritoryAvailability{TerritoryID: "USA", Available: true}.inlineCreate(0),
+		NewTerritoryAvailability{TerritoryID: "FRA"}.inlineCreate(1),
+		NewTerritoryAvailability{TerritoryID: "JPN", Available: true, PreOrderEnabled: true, ReleaseDate: &releaseDate}.inlineCreate(2),
+	}, body.Included)
+}
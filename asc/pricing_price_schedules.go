@@ -0,0 +1,476 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrMissingBaseTerritory happens when an app price schedule doesn't report its base territory.
+var ErrMissingBaseTerritory = errors.New("app price schedule has no base territory")
+
+// AppPricePointV3 defines model for AppPricePointV3.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricepointv3
+type AppPricePointV3 struct {
+	Attributes    *AppPricePointV3Attributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *AppPricePointV3Relationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// AppPricePointV3Attributes defines model for AppPricePointV3.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricepointv3/attributes
+type AppPricePointV3Attributes struct {
+	CustomerPrice *string `json:"customerPrice,omitempty"`
+	Proceeds      *string `json:"proceeds,omitempty"`
+}
+
+// AppPricePointV3Relationships defines model for AppPricePointV3.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricepointv3/relationships
+type AppPricePointV3Relationships struct {
+	App       *Relationship `json:"app,omitempty"`
+	Territory *Relationship `json:"territory,omitempty"`
+}
+
+// AppPricePointV3Response defines model for AppPricePointV3Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricepointv3response
+type AppPricePointV3Response struct {
+	Data     AppPricePointV3 `json:"data"`
+	Included []Territory     `json:"included,omitempty"`
+	Links    DocumentLinks   `json:"links"`
+}
+
+// AppPricePointsV3Response defines model for AppPricePointsV3Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricepointsv3response
+type AppPricePointsV3Response struct {
+	Data     []AppPricePointV3  `json:"data"`
+	Included []Territory        `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// AppPriceSchedule defines model for AppPriceSchedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedule
+type AppPriceSchedule struct {
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *AppPriceScheduleRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// AppPriceScheduleRelationships defines model for AppPriceSchedule.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedule/relationships
+type AppPriceScheduleRelationships struct {
+	App             *Relationship      `json:"app,omitempty"`
+	AutomaticPrices *PagedRelationship `json:"automaticPrices,omitempty"`
+	BaseTerritory   *Relationship      `json:"baseTerritory,omitempty"`
+	ManualPrices    *PagedRelationship `json:"manualPrices,omitempty"`
+}
+
+// appPriceScheduleCreateRequest defines model for AppPriceScheduleCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedulecreaterequest/data
+type appPriceScheduleCreateRequest struct {
+	Relationships appPriceScheduleCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// appPriceScheduleCreateRequestRelationships are relationships for AppPriceScheduleCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedulecreaterequest/data/relationships
+type appPriceScheduleCreateRequestRelationships struct {
+	App           relationshipDeclaration      `json:"app"`
+	BaseTerritory relationshipDeclaration      `json:"baseTerritory"`
+	ManualPrices  pagedRelationshipDeclaration `json:"manualPrices"`
+}
+
+// AppPriceScheduleResponse defines model for AppPriceScheduleResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricescheduleresponse
+type AppPriceScheduleResponse struct {
+	Data     AppPriceSchedule                   `json:"data"`
+	Included []AppPriceScheduleResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// AppPricesV2Response defines model for AppPricesV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricesv2response
+type AppPricesV2Response struct {
+	Data     []AppPrice                         `json:"data"`
+	Included []AppPriceScheduleResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                 `json:"links"`
+	Meta     *PagingInformation                 `json:"meta,omitempty"`
+}
+
+// AppPriceScheduleResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in an AppPriceScheduleResponse or AppPricesV2Response.
+type AppPriceScheduleResponseIncluded included
+
+// NewAppPrice models the parameters for a manual price in a new app price schedule.
+//
+// Set StartDate to nil if you want the price to take effect immediately, and EndDate to nil if you want
+// the price to remain in effect indefinitely. Use ListAppPricePointsForApp to find a PricePointID.
+type NewAppPrice struct {
+	StartDate    *Date
+	EndDate      *Date
+	PricePointID string
+}
+
+type appPriceInlineCreate struct {
+	Attributes    appPriceInlineCreateAttributes    `json:"attributes"`
+	ID            string                            `json:"id"`
+	Relationships appPriceInlineCreateRelationships `json:"relationships"`
+	Type          string                            `json:"type"`
+}
+
+type appPriceInlineCreateAttributes struct {
+	EndDate   *Date `json:"endDate,omitempty"`
+	StartDate *Date `json:"startDate"`
+}
+
+type appPriceInlineCreateRelationships struct {
+	AppPricePoint relationshipDeclaration `json:"appPricePoint"`
+}
+
+func (p NewAppPrice) inlineCreate(index int) appPriceInlineCreate {
+	return appPriceInlineCreate{
+		Attributes: appPriceInlineCreateAttributes{
+			EndDate:   p.EndDate,
+			StartDate: p.StartDate,
+		},
+		ID: fmt.Sprintf("${new-price-%d}", index),
+		Relationships: appPriceInlineCreateRelationships{
+			AppPricePoint: *newRelationshipDeclaration(&p.PricePointID, "appPricePoints"),
+		},
+		Type: "appPrices",
+	}
+}
+
+// ListAppPricePointsForAppQuery are query options for ListAppPricePointsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_points
+type ListAppPricePointsForAppQuery struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	FilterTerritory      []string `url:"filter[territory],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// GetAppPricePointV3Query are query options for GetAppPricePointV3
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_point_information
+type GetAppPricePointV3Query struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	Include              []string `url:"include,omitempty"`
+}
+
+// ListEqualizationsForAppPricePointQuery are query options for ListEqualizationsForAppPricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_point_equalizations
+type ListEqualizationsForAppPricePointQuery struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	FilterTerritory      []string `url:"filter[territory],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// GetAppPriceScheduleQuery are query options for GetAppPriceSchedule and GetAppPriceScheduleForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_an_app_s_price_schedule_information
+type GetAppPriceScheduleQuery struct {
+	FieldsAppPrices         []string `url:"fields[appPrices],omitempty"`
+	FieldsAppPriceSchedules []string `url:"fields[appPriceSchedules],omitempty"`
+	FieldsTerritories       []string `url:"fields[territories],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+	LimitAutomaticPrices    int      `url:"limit[automaticPrices],omitempty"`
+	LimitManualPrices       int      `url:"limit[manualPrices],omitempty"`
+}
+
+// ListPricesForAppPriceScheduleQuery are query options for ListManualPricesForAppPriceSchedule
+// and ListAutomaticPricesForAppPriceSchedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_manual_prices_for_an_app
+// https://developer.apple.com/documentation/appstoreconnectapi/list_automatically_generated_prices_for_an_app
+type ListPricesForAppPriceScheduleQuery struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsAppPrices      []string `url:"fields[appPrices],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	FilterEndDate        []string `url:"filter[endDate],omitempty"`
+	FilterStartDate      []string `url:"filter[startDate],omitempty"`
+	FilterTerritory      []string `url:"filter[territory],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// ListAppPricePointsForApp lists the price points available for an app, including customer price and proceeds per territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_points
+func (s *PricingService) ListAppPricePointsForApp(ctx context.Context, id string, params *ListAppPricePointsForAppQuery) (*AppPricePointsV3Response, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appPricePoints", id)
+	res := new(AppPricePointsV3Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppPricePointV3 reads the customer price and your proceeds for a specific price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_point_information
+func (s *PricingService) GetAppPricePointV3(ctx context.Context, id string, params *GetAppPricePointV3Query) (*AppPricePointV3Response, *Response, error) {
+	url := versionedPath("v3", fmt.Sprintf("appPricePoints/%s", id))
+	res := new(AppPricePointV3Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListEqualizationsForAppPricePoint lists the price points in other territories that are equalized to a given price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_point_equalizations
+func (s *PricingService) ListEqualizationsForAppPricePoint(ctx context.Context, id string, params *ListEqualizationsForAppPricePointQuery) (*AppPricePointsV3Response, *Response, error) {
+	url := versionedPath("v3", fmt.Sprintf("appPricePoints/%s/equalizations", id))
+	res := new(AppPricePointsV3Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppPriceScheduleForApp gets the price schedule of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_price_schedule_information_for_an_app
+func (s *PricingService) GetAppPriceScheduleForApp(ctx context.Context, id string, params *GetAppPriceScheduleQuery) (*AppPriceScheduleResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/appPriceSchedule", id)
+	res := new(AppPriceScheduleResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAppPriceSchedule gets a specific app price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_an_app_s_price_schedule_information
+func (s *PricingService) GetAppPriceSchedule(ctx context.Context, id string, params *GetAppPriceScheduleQuery) (*AppPriceScheduleResponse, *Response, error) {
+	url := fmt.Sprintf("appPriceSchedules/%s", id)
+	res := new(AppPriceScheduleResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateAppPriceSchedule replaces the price schedule of an app with a base territory and a set of manual prices.
+//
+// Prices in territories other than those covered by manualPrices are equalized automatically from the price in the base territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/add_a_scheduled_price_change_to_an_app
+func (s *PricingService) CreateAppPriceSchedule(ctx context.Context, appID string, baseTerritoryID string, manualPrices []NewAppPrice) (*AppPriceScheduleResponse, *Response, error) {
+	newPrices := make([]appPriceInlineCreate, len(manualPrices))
+	priceIDs := make([]string, len(manualPrices))
+
+	for i, price := range manualPrices {
+		newPrice := price.inlineCreate(i)
+		newPrices[i] = newPrice
+		priceIDs[i] = newPrice.ID
+	}
+
+	req := appPriceScheduleCreateRequest{
+		Relationships: appPriceScheduleCreateRequestRelationships{
+			App:           *newRelationshipDeclaration(&appID, "apps"),
+			BaseTerritory: *newRelationshipDeclaration(&baseTerritoryID, "territories"),
+			ManualPrices:  newPagedRelationshipDeclaration(priceIDs, "appPrices"),
+		},
+		Type: "appPriceSchedules",
+	}
+	res := new(AppPriceScheduleResponse)
+	resp, err := s.client.post(ctx, "appPriceSchedules", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// GetBaseTerritoryForAppPriceSchedule gets the territory that automatically equalized prices of a price schedule are based on.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_base_territory_for_an_app_s_price_schedule
+func (s *PricingService) GetBaseTerritoryForAppPriceSchedule(ctx context.Context, id string, params *GetTerritoryForAppPricePointQuery) (*TerritoryResponse, *Response, error) {
+	url := fmt.Sprintf("appPriceSchedules/%s/baseTerritory", id)
+	res := new(TerritoryResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListManualPricesForAppPriceSchedule lists the prices that were set manually in a price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_manual_prices_for_an_app
+func (s *PricingService) ListManualPricesForAppPriceSchedule(ctx context.Context, id string, params *ListPricesForAppPriceScheduleQuery) (*AppPricesV2Response, *Response, error) {
+	url := fmt.Sprintf("appPriceSchedules/%s/manualPrices", id)
+	res := new(AppPricesV2Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListAutomaticPricesForAppPriceSchedule lists the prices that were equalized automatically from the base territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_automatically_generated_prices_for_an_app
+func (s *PricingService) ListAutomaticPricesForAppPriceSchedule(ctx context.Context, id string, params *ListPricesForAppPriceScheduleQuery) (*AppPricesV2Response, *Response, error) {
+	url := fmt.Sprintf("appPriceSchedules/%s/automaticPrices", id)
+	res := new(AppPricesV2Response)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ScheduleAppPriceChange schedules the price of an app in its base territory to change to the given price
+// point on startDate. Manual prices in other territories are kept, and prices in the base territory that
+// would start on or after startDate are replaced by the new price. Prices that have already ended are
+// dropped, and start dates in the past are cleared, since Apple rejects both.
+func (s *PricingService) ScheduleAppPriceChange(ctx context.Context, appID string, pricePointID string, startDate Date) (*AppPriceScheduleResponse, *Response, error) {
+	schedule, resp, err := s.GetAppPriceScheduleForApp(ctx, appID, &GetAppPriceScheduleQuery{
+		Include: []string{"baseTerritory"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rels := schedule.Data.Relationships
+	if rels == nil || rels.BaseTerritory == nil || rels.BaseTerritory.Data == nil {
+		return nil, resp, ErrMissingBaseTerritory
+	}
+
+	baseTerritoryID := rels.BaseTerritory.Data.ID
+
+	current, err := s.listAllManualPrices(ctx, schedule.Data.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prices := make([]NewAppPrice, 0, len(current)+1)
+	now := today()
+
+	for _, price := range current {
+		if price.Relationships == nil || price.Relationships.AppPricePoint == nil || price.Relationships.AppPricePoint.Data == nil {
+			continue
+		}
+
+		newPrice := NewAppPrice{PricePointID: price.Relationships.AppPricePoint.Data.ID}
+
+		if price.Attributes != nil {
+			newPrice.StartDate = price.Attributes.StartDate
+			newPrice.EndDate = price.Attributes.EndDate
+		}
+
+		if newPrice.EndDate != nil && newPrice.EndDate.Before(now.Time) {
+			continue
+		}
+
+		if newPrice.StartDate != nil && newPrice.StartDate.Before(now.Time) {
+			newPrice.StartDate = nil
+		}
+
+		if price.Relationships.Territory != nil && price.Relationships.Territory.Data != nil && price.Relationships.Territory.Data.ID == baseTerritoryID {
+			if newPrice.StartDate != nil && !newPrice.StartDate.Before(startDate.Time) {
+				continue
+			}
+
+			if newPrice.EndDate == nil || newPrice.EndDate.After(startDate.Time) {
+				newPrice.EndDate = &startDate
+			}
+		}
+
+		prices = append(prices, newPrice)
+	}
+
+	prices = append(prices, NewAppPrice{
+		StartDate:    &startDate,
+		PricePointID: pricePointID,
+	})
+
+	return s.CreateAppPriceSchedule(ctx, appID, baseTerritoryID, prices)
+}
+
+func (s *PricingService) listAllManualPrices(ctx context.Context, appPriceScheduleID string) ([]AppPrice, error) {
+	query := ListPricesForAppPriceScheduleQuery{
+		Include: []string{"appPricePoint", "territory"},
+		Limit:   200,
+	}
+
+	var all []AppPrice
+
+	for {
+		res, _, err := s.ListManualPricesForAppPriceSchedule(ctx, appPriceScheduleID, &query)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, res.Data...)
+
+		if res.Links.Next == nil || res.Links.Next.Cursor() == "" {
+			return all, nil
+		}
+
+		query.Cursor = res.Links.Next.Cursor()
+	}
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppPriceScheduleResponseIncluded.
+func (i *AppPriceScheduleResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppPrice returns the AppPrice stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) AppPrice() *AppPrice {
+	return extractIncludedAppPrice(i.inner)
+}
+
+// AppPricePointV3 returns the AppPricePointV3 stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) AppPricePointV3() *AppPricePointV3 {
+	return extractIncludedAppPricePointV3(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
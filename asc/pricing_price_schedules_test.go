@@ -0,0 +1,181 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppPricePointsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricePointsV3Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListAppPricePointsForApp(ctx, "10", &ListAppPricePointsForAppQuery{})
+	})
+}
+
+func TestGetAppPricePointV3(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricePointV3Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppPricePointV3(ctx, "10", &GetAppPricePointV3Query{})
+	})
+}
+
+func TestListEqualizationsForAppPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricePointsV3Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListEqualizationsForAppPricePoint(ctx, "10", &ListEqualizationsForAppPricePointQuery{})
+	})
+}
+
+func TestGetAppPriceScheduleForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppPriceScheduleForApp(ctx, "10", &GetAppPriceScheduleQuery{})
+	})
+}
+
+func TestGetAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppPriceSchedule(ctx, "10", &GetAppPriceScheduleQuery{})
+	})
+}
+
+func TestCreateAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.CreateAppPriceSchedule(ctx, "10", "USA", []NewAppPrice{{PricePointID: "20"}})
+	})
+}
+
+func TestGetBaseTerritoryForAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetBaseTerritoryForAppPriceSchedule(ctx, "10", &GetTerritoryForAppPricePointQuery{})
+	})
+}
+
+func TestListManualPricesForAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricesV2Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListManualPricesForAppPriceSchedule(ctx, "10", &ListPricesForAppPriceScheduleQuery{})
+	})
+}
+
+func TestListAutomaticPricesForAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricesV2Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListAutomaticPricesForAppPriceSchedule(ctx, "10", &ListPricesForAppPriceScheduleQuery{})
+	})
+}
+
+func TestGetAppPriceScheduleIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appPrices"},{"type":"appPricePoints"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		schedule, _, err := client.Pricing.GetAppPriceSchedule(ctx, "10", &GetAppPriceScheduleQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, schedule.Included)
+
+		assert.NotNil(t, schedule.Included[0].App())
+		assert.NotNil(t, schedule.Included[1].AppPrice())
+		assert.NotNil(t, schedule.Included[2].AppPricePointV3())
+		assert.NotNil(t, schedule.Included[3].Territory())
+
+		assert.Nil(t, schedule.Included[0].AppPrice())
+		assert.Nil(t, schedule.Included[1].AppPricePointV3())
+		assert.Nil(t, schedule.Included[2].Territory())
+		assert.Nil(t, schedule.Included[3].App())
+	})
+}
+
+func TestGetAppPricePointV3Path(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"GET /v3/appPricePoints/10/equalizations": respondWith(http.StatusOK, "{}"),
+	})
+	defer server.Close()
+
+	_, _, err := client.Pricing.ListEqualizationsForAppPricePoint(context.Background(), "10", nil)
+	assert.NoError(t, err)
+}
+
+func TestScheduleAppPriceChange(t *testing.T) {
+	t.Parallel()
+
+	var body struct {
+		Data     appPriceScheduleCreateRequest `json:"data"`
+		Included []appPriceInlineCreate        `json:"included"`
+	}
+
+	client, server := newRoutedServer(map[string]route{
+		"GET /v1/apps/10/appPriceSchedule": respondWith(http.StatusOK, `{"data":{"id":"ps","type":"appPriceSchedules","relationships":{"baseTerritory":{"data":{"id":"USA","type":"territories"}}}}}`),
+		"GET /v1/appPriceSchedules/ps/manualPrices": respondWith(http.StatusOK, `{"data":[
+			{"id":"p1","type":"appPrices","attributes":{"startDate":"2020-01-01","endDate":null},"relationships":{"appPricePoint":{"data":{"id":"pp-usa-1","type":"appPricePoints"}},"territory":{"data":{"id":"USA","type":"territories"}}}},
+			{"id":"p2","type":"appPrices","attributes":{"startDate":"2030-03-01"},"relationships":{"appPricePoint":{"data":{"id":"pp-usa-3","type":"appPricePoints"}},"territory":{"data":{"id":"USA","type":"territories"}}}},
+			{"id":"p3","type":"appPrices","attributes":{},"relationships":{"appPricePoint":{"data":{"id":"pp-fra-1","type":"appPricePoints"}},"territory":{"data":{"id":"FRA","type":"territories"}}}},
+			{"id":"p4","type":"appPrices","attributes":{"startDate":"2019-01-01","endDate":"2020-01-01"},"relationships":{"appPricePoint":{"data":{"id":"pp-fra-0","type":"appPricePoints"}},"territory":{"data":{"id":"FRA","type":"territories"}}}}
+		],"links":{"self":""}}`),
+		"POST /v1/appPriceSchedules": respondWith(http.StatusOK, `{"data":{"id":"ps2","type":"appPriceSchedules"}}`),
+	})
+	defer server.Close()
+
+	startDate := Date{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	schedule, _, err := client.Pricing.ScheduleAppPriceChange(context.Background(), "10", "pp-usa-2", startDate)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ps2", schedule.Data.ID)
+
+	requests := server.requests("POST /v1/appPriceSchedules")
+	assert.Len(t, requests, 1)
+	assert.NoError(t, requests[0].decode(&body))
+	assert.Equal(t, "USA", body.Data.Relationships.BaseTerritory.Data.ID)
+	assert.Equal(t, []appPriceInlineCreate{
+		NewAppPrice{EndDate: &startDate, PricePointID: "pp-usa-1"}.inlineCreate(0),
+		NewAppPrice{PricePointID: "pp-fra-1"}.inlineCreate(1),
+		NewAppPrice{StartDate: &startDate, PricePointID: "pp-usa-2"}.inlineCreate(2),
+	}, body.Included)
+}
+
+func TestScheduleAppPriceChangeMissingBaseTerritory(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"ps","type":"appPriceSchedules"}}`, http.StatusOK, false)
+	defer server.Close()
+
+	_, _, err := client.Pricing.ScheduleAppPriceChange(context.Background(), "10", "pp", Date{time.Now()})
+	assert.ErrorIs(t, err, ErrMissingBaseTerritory)
+}
@@ -172,6 +172,8 @@ type GetAppPricePointQuery struct {
 
 // ListAppPriceTiers lists all app price tiers available in App Store Connect, including related price points.
 //
+// Deprecated: Apple has retired price tiers. Use ListAppPricePointsForApp instead.
+//
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_tiers
 func (s *PricingService) ListAppPriceTiers(ctx context.Context, params *ListAppPriceTiersQuery) (*AppPriceTiersResponse, *Response, error) {
 	res := new(AppPriceTiersResponse)
@@ -182,6 +184,8 @@ func (s *PricingService) ListAppPriceTiers(ctx context.Context, params *ListAppP
 
 // GetAppPriceTier reads available app price tiers.
 //
+// Deprecated: Apple has retired price tiers. Use ListAppPricePointsForApp instead.
+//
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_tier_information
 func (s *PricingService) GetAppPriceTier(ctx context.Context, id string, params *GetAppPriceTierQuery) (*AppPriceTierResponse, *Response, error) {
 	url := fmt.Sprintf("appPriceTiers/%s", id)
@@ -193,6 +197,8 @@ func (s *PricingService) GetAppPriceTier(ctx context.Context, id string, params
 
 // ListPricePointsForAppPriceTier lists price points across all App Store territories for a specific price tier.
 //
+// Deprecated: Apple has retired price tiers. Use ListAppPricePointsForApp instead.
+//
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_an_app_price_tier
 func (s *PricingService) ListPricePointsForAppPriceTier(ctx context.Context, id string, params *ListPricePointsForAppPriceTierQuery) (*AppPricePointsResponse, *Response, error) {
 	url := fmt.Sprintf("appPriceTiers/%s/pricePoints", id)
@@ -204,6 +210,8 @@ func (s *PricingService) ListPricePointsForAppPriceTier(ctx context.Context, id
 
 // ListAppPricePoints lists all app price points available in App Store Connect, including related price tier, developer proceeds, and territory.
 //
+// Deprecated: Apple has retired price tiers. Use ListAppPricePointsForApp instead.
+//
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_points
 func (s *PricingService) ListAppPricePoints(ctx context.Context, params *ListAppPricePointsQuery) (*AppPricePointsResponse, *Response, error) {
 	res := new(AppPricePointsResponse)
@@ -225,6 +233,8 @@ func (s *PricingService) GetTerritoryForAppPricePoint(ctx context.Context, id st
 
 // GetAppPricePoint reads the customer prices and your proceeds for a price tier.
 //
+// Deprecated: Apple has retired price tiers. Use GetAppPricePointV3 instead.
+//
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_point_information
 func (s *PricingService) GetAppPricePoint(ctx context.Context, id string, params *GetAppPricePointQuery) (*AppPricePointResponse, *Response, error) {
 	url := fmt.Sprintf("appPricePoints/%s", id)
@@ -20,8 +20,42 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 
 package asc
 
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+)
+
 // ReportingService handles communication with reporting-related methods of the App Store Connect API
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/sales_and_finance_reports
 // https://developer.apple.com/documentation/appstoreconnectapi/power_and_performance_metrics_and_logs
+// https://developer.apple.com/documentation/appstoreconnectapi/analytics
 type ReportingService service
+
+// newDecompressingReader returns a reader of the decompressed contents of r if r is gzip-compressed, or of r
+// itself otherwise. Report payloads are gzip-compressed, but may already have been decompressed in transit.
+func newDecompressingReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.Peek(2)
+	if err != nil || magic[0] != 0x1f || magic[1] != 0x8b {
+		return io.NopCloser(br), nil
+	}
+
+	return gzip.NewReader(br)
+}
+
+// decompress copies r to w, gunzipping it first if it is gzip-compressed.
+func decompress(r io.Reader, w io.Writer) error {
+	rc, err := newDecompressingReader(r)
+	if err != nil {
+		return err
+	}
+
+	defer rc.Close()
+
+	_, err = io.Copy(w, rc)
+
+	return err
+}
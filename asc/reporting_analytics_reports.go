@@ -0,0 +1,550 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrMissingSegmentURL happens when DownloadAnalyticsReportSegment is given a segment without a URL.
+var ErrMissingSegmentURL = errors.New("analytics report segment has no url")
+
+// AnalyticsReportAccessType defines model for AnalyticsReportRequest.Attributes.AccessType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest/attributes
+type AnalyticsReportAccessType string
+
+const (
+	// AnalyticsReportAccessTypeOngoing is an access type for Ongoing.
+	AnalyticsReportAccessTypeOngoing AnalyticsReportAccessType = "ONGOING"
+	// AnalyticsReportAccessTypeOneTimeSnapshot is an access type for OneTimeSnapshot.
+	AnalyticsReportAccessTypeOneTimeSnapshot AnalyticsReportAccessType = "ONE_TIME_SNAPSHOT"
+)
+
+// AnalyticsReportCategory defines model for AnalyticsReport.Attributes.Category
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport/attributes
+type AnalyticsReportCategory string
+
+const (
+	// AnalyticsReportCategoryAppStoreEngagement is a report category for AppStoreEngagement.
+	AnalyticsReportCategoryAppStoreEngagement AnalyticsReportCategory = "APP_STORE_ENGAGEMENT"
+	// AnalyticsReportCategoryAppStoreCommerce is a report category for AppStoreCommerce.
+	AnalyticsReportCategoryAppStoreCommerce AnalyticsReportCategory = "APP_STORE_COMMERCE"
+	// AnalyticsReportCategoryAppUsage is a report category for AppUsage.
+	AnalyticsReportCategoryAppUsage AnalyticsReportCategory = "APP_USAGE"
+	// AnalyticsReportCategoryFrameworksUsage is a report category for FrameworksUsage.
+	AnalyticsReportCategoryFrameworksUsage AnalyticsReportCategory = "FRAMEWORKS_USAGE"
+	// AnalyticsReportCategoryPerformance is a report category for Performance.
+	AnalyticsReportCategoryPerformance AnalyticsReportCategory = "PERFORMANCE"
+)
+
+// AnalyticsReportGranularity defines model for AnalyticsReportInstance.Attributes.Granularity
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance/attributes
+type AnalyticsReportGranularity string
+
+const (
+	// AnalyticsReportGranularityDaily is a report granularity for Daily.
+	AnalyticsReportGranularityDaily AnalyticsReportGranularity = "DAILY"
+	// AnalyticsReportGranularityWeekly is a report granularity for Weekly.
+	AnalyticsReportGranularityWeekly AnalyticsReportGranularity = "WEEKLY"
+	// AnalyticsReportGranularityMonthly is a report granularity for Monthly.
+	AnalyticsReportGranularityMonthly AnalyticsReportGranularity = "MONTHLY"
+)
+
+// AnalyticsReportRequest defines model for AnalyticsReportRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest
+type AnalyticsReportRequest struct {
+	Attributes    *AnalyticsReportRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *AnalyticsReportRequestRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// AnalyticsReportRequestAttributes defines model for AnalyticsReportRequest.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest/attributes
+type AnalyticsReportRequestAttributes struct {
+	AccessType             *AnalyticsReportAccessType `json:"accessType,omitempty"`
+	StoppedDueToInactivity *bool                      `json:"stoppedDueToInactivity,omitempty"`
+}
+
+// AnalyticsReportRequestRelationships defines model for AnalyticsReportRequest.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest/relationships
+type AnalyticsReportRequestRelationships struct {
+	Reports *PagedRelationship `json:"reports,omitempty"`
+}
+
+// analyticsReportRequestCreateRequest defines model for AnalyticsReportRequestCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestcreaterequest/data
+type analyticsReportRequestCreateRequest struct {
+	Attributes    analyticsReportRequestCreateRequestAttributes    `json:"attributes"`
+	Relationships analyticsReportRequestCreateRequestRelationships `json:"relationships"`
+	Type          string                                           `json:"type"`
+}
+
+// analyticsReportRequestCreateRequestAttributes are attributes for AnalyticsReportRequestCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestcreaterequest/data/attributes
+type analyticsReportRequestCreateRequestAttributes struct {
+	AccessType AnalyticsReportAccessType `json:"accessType"`
+}
+
+// analyticsReportRequestCreateRequestRelationships are relationships for AnalyticsReportRequestCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestcreaterequest/data/relationships
+type analyticsReportRequestCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// AnalyticsReportRequestResponse defines model for AnalyticsReportRequestResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestresponse
+type AnalyticsReportRequestResponse struct {
+	Data     AnalyticsReportRequest `json:"data"`
+	Included []AnalyticsReport      `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// AnalyticsReportRequestsResponse defines model for AnalyticsReportRequestsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestsresponse
+type AnalyticsReportRequestsResponse struct {
+	Data     []AnalyticsReportRequest `json:"data"`
+	Included []AnalyticsReport        `json:"included,omitempty"`
+	Links    PagedDocumentLinks       `json:"links"`
+	Meta     *PagingInformation       `json:"meta,omitempty"`
+}
+
+// AnalyticsReport defines model for AnalyticsReport.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport
+type AnalyticsReport struct {
+	Attributes    *AnalyticsReportAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *AnalyticsReportRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// AnalyticsReportAttributes defines model for AnalyticsReport.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport/attributes
+type AnalyticsReportAttributes struct {
+	Category *AnalyticsReportCategory `json:"category,omitempty"`
+	Name     *string                  `json:"name,omitempty"`
+}
+
+// AnalyticsReportRelationships defines model for AnalyticsReport.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport/relationships
+type AnalyticsReportRelationships struct {
+	Instances *PagedRelationship `json:"instances,omitempty"`
+}
+
+// AnalyticsReportResponse defines model for AnalyticsReportResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportresponse
+type AnalyticsReportResponse struct {
+	Data  AnalyticsReport `json:"data"`
+	Links DocumentLinks   `json:"links"`
+}
+
+// AnalyticsReportsResponse defines model for AnalyticsReportsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsresponse
+type AnalyticsReportsResponse struct {
+	Data  []AnalyticsReport  `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// AnalyticsReportInstance defines model for AnalyticsReportInstance.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance
+type AnalyticsReportInstance struct {
+	Attributes    *AnalyticsReportInstanceAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Links         ResourceLinks                         `json:"links"`
+	Relationships *AnalyticsReportInstanceRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// AnalyticsReportInstanceAttributes defines model for AnalyticsReportInstance.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance/attributes
+type AnalyticsReportInstanceAttributes struct {
+	Granularity    *AnalyticsReportGranularity `json:"granularity,omitempty"`
+	ProcessingDate *Date                       `json:"processingDate,omitempty"`
+}
+
+// AnalyticsReportInstanceRelationships defines model for AnalyticsReportInstance.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance/relationships
+type AnalyticsReportInstanceRelationships struct {
+	Segments *PagedRelationship `json:"segments,omitempty"`
+}
+
+// AnalyticsReportInstanceResponse defines model for AnalyticsReportInstanceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstanceresponse
+type AnalyticsReportInstanceResponse struct {
+	Data  AnalyticsReportInstance `json:"data"`
+	Links DocumentLinks           `json:"links"`
+}
+
+// AnalyticsReportInstancesResponse defines model for AnalyticsReportInstancesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstancesresponse
+type AnalyticsReportInstancesResponse struct {
+	Data  []AnalyticsReportInstance `json:"data"`
+	Links PagedDocumentLinks        `json:"links"`
+	Meta  *PagingInformation        `json:"meta,omitempty"`
+}
+
+// AnalyticsReportSegment defines model for AnalyticsReportSegment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegment
+type AnalyticsReportSegment struct {
+	Attributes *AnalyticsReportSegmentAttributes `json:"attributes,omitempty"`
+	ID         string                            `json:"id"`
+	Links      ResourceLinks                     `json:"links"`
+	Type       string                            `json:"type"`
+}
+
+// AnalyticsReportSegmentAttributes defines model for AnalyticsReportSegment.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegment/attributes
+type AnalyticsReportSegmentAttributes struct {
+	Checksum    *string `json:"checksum,omitempty"`
+	SizeInBytes *int    `json:"sizeInBytes,omitempty"`
+	URL         *string `json:"url,omitempty"`
+}
+
+// AnalyticsReportSegmentResponse defines model for AnalyticsReportSegmentResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegmentresponse
+type AnalyticsReportSegmentResponse struct {
+	Data  AnalyticsReportSegment `json:"data"`
+	Links DocumentLinks          `json:"links"`
+}
+
+// AnalyticsReportSegmentsResponse defines model for AnalyticsReportSegmentsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegmentsresponse
+type AnalyticsReportSegmentsResponse struct {
+	Data  []AnalyticsReportSegment `json:"data"`
+	Links PagedDocumentLinks       `json:"links"`
+	Meta  *PagingInformation       `json:"meta,omitempty"`
+}
+
+// ListAnalyticsReportRequestsForAppQuery are query options for ListAnalyticsReportRequestsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_analytics_report_requests
+type ListAnalyticsReportRequestsForAppQuery struct {
+	FieldsAnalyticsReportRequests []string `url:"fields[analyticsReportRequests],omitempty"`
+	FieldsAnalyticsReports        []string `url:"fields[analyticsReports],omitempty"`
+	FilterAccessType              []string `url:"filter[accessType],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	LimitReports                  int      `url:"limit[reports],omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportRequestQuery are query options for GetAnalyticsReportRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_request_information
+type GetAnalyticsReportRequestQuery struct {
+	FieldsAnalyticsReportRequests []string `url:"fields[analyticsReportRequests],omitempty"`
+	FieldsAnalyticsReports        []string `url:"fields[analyticsReports],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	LimitReports                  int      `url:"limit[reports],omitempty"`
+}
+
+// ListReportsForAnalyticsReportRequestQuery are query options for ListReportsForAnalyticsReportRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_analytics_reports
+type ListReportsForAnalyticsReportRequestQuery struct {
+	FieldsAnalyticsReports []string `url:"fields[analyticsReports],omitempty"`
+	FilterCategory         []string `url:"filter[category],omitempty"`
+	FilterName             []string `url:"filter[name],omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportQuery are query options for GetAnalyticsReport
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_information
+type GetAnalyticsReportQuery struct {
+	FieldsAnalyticsReports []string `url:"fields[analyticsReports],omitempty"`
+}
+
+// ListInstancesForAnalyticsReportQuery are query options for ListInstancesForAnalyticsReport
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_instances_of_an_analytics_report
+type ListInstancesForAnalyticsReportQuery struct {
+	FieldsAnalyticsReportInstances []string `url:"fields[analyticsReportInstances],omitempty"`
+	FilterGranularity              []string `url:"filter[granularity],omitempty"`
+	FilterProcessingDate           []string `url:"filter[processingDate],omitempty"`
+	Limit                          int      `url:"limit,omitempty"`
+	Cursor                         string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportInstanceQuery are query options for GetAnalyticsReportInstance
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_instance_information
+type GetAnalyticsReportInstanceQuery struct {
+	FieldsAnalyticsReportInstances []string `url:"fields[analyticsReportInstances],omitempty"`
+}
+
+// ListSegmentsForAnalyticsReportInstanceQuery are query options for ListSegmentsForAnalyticsReportInstance
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_segments_of_an_analytics_report_instance
+type ListSegmentsForAnalyticsReportInstanceQuery struct {
+	FieldsAnalyticsReportSegments []string `url:"fields[analyticsReportSegments],omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportSegmentQuery are query options for GetAnalyticsReportSegment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_segment_information
+type GetAnalyticsReportSegmentQuery struct {
+	FieldsAnalyticsReportSegments []string `url:"fields[analyticsReportSegments],omitempty"`
+}
+
+// CreateAnalyticsReportRequest requests ongoing or one-time snapshot analytics reports for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/request_reports
+func (s *ReportingService) CreateAnalyticsReportRequest(ctx context.Context, accessType AnalyticsReportAccessType, appID string) (*AnalyticsReportRequestResponse, *Response, error) {
+	req := analyticsReportRequestCreateRequest{
+		Attributes: analyticsReportRequestCreateRequestAttributes{
+			AccessType: accessType,
+		},
+		Relationships: analyticsReportRequestCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "analyticsReportRequests",
+	}
+	res := new(AnalyticsReportRequestResponse)
+	resp, err := s.client.post(ctx, "analyticsReportRequests", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListAnalyticsReportRequestsForApp lists the analytics report requests made for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_analytics_report_requests
+func (s *ReportingService) ListAnalyticsReportRequestsForApp(ctx context.Context, id string, params *ListAnalyticsReportRequestsForAppQuery) (*AnalyticsReportRequestsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/analyticsReportRequests", id)
+	res := new(AnalyticsReportRequestsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAnalyticsReportRequest gets information about a specific analytics report request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_request_information
+func (s *ReportingService) GetAnalyticsReportRequest(ctx context.Context, id string, params *GetAnalyticsReportRequestQuery) (*AnalyticsReportRequestResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReportRequests/%s", id)
+	res := new(AnalyticsReportRequestResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// DeleteAnalyticsReportRequest stops generating reports for an analytics report request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_analytics_report_request
+func (s *ReportingService) DeleteAnalyticsReportRequest(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("analyticsReportRequests/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListReportsForAnalyticsReportRequest lists the reports generated for an analytics report request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_analytics_reports
+func (s *ReportingService) ListReportsForAnalyticsReportRequest(ctx context.Context, id string, params *ListReportsForAnalyticsReportRequestQuery) (*AnalyticsReportsResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReportRequests/%s/reports", id)
+	res := new(AnalyticsReportsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAnalyticsReport gets information about a specific analytics report.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_information
+func (s *ReportingService) GetAnalyticsReport(ctx context.Context, id string, params *GetAnalyticsReportQuery) (*AnalyticsReportResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReports/%s", id)
+	res := new(AnalyticsReportResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListInstancesForAnalyticsReport lists the instances of an analytics report.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_instances_of_an_analytics_report
+func (s *ReportingService) ListInstancesForAnalyticsReport(ctx context.Context, id string, params *ListInstancesForAnalyticsReportQuery) (*AnalyticsReportInstancesResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReports/%s/instances", id)
+	res := new(AnalyticsReportInstancesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAnalyticsReportInstance gets information about a specific analytics report instance.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_instance_information
+func (s *ReportingService) GetAnalyticsReportInstance(ctx context.Context, id string, params *GetAnalyticsReportInstanceQuery) (*AnalyticsReportInstanceResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReportInstances/%s", id)
+	res := new(AnalyticsReportInstanceResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListSegmentsForAnalyticsReportInstance lists the downloadable segments of an analytics report instance.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_a_list_of_segments_of_an_analytics_report_instance
+func (s *ReportingService) ListSegmentsForAnalyticsReportInstance(ctx context.Context, id string, params *ListSegmentsForAnalyticsReportInstanceQuery) (*AnalyticsReportSegmentsResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReportInstances/%s/segments", id)
+	res := new(AnalyticsReportSegmentsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetAnalyticsReportSegment gets information about a specific analytics report segment, including its download URL.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_analytics_report_segment_information
+func (s *ReportingService) GetAnalyticsReportSegment(ctx context.Context, id string, params *GetAnalyticsReportSegmentQuery) (*AnalyticsReportSegmentResponse, *Response, error) {
+	url := fmt.Sprintf("analyticsReportSegments/%s", id)
+	res := new(AnalyticsReportSegmentResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// DownloadAnalyticsReportSegment downloads a segment and writes its decompressed contents to the given writer.
+func (s *ReportingService) DownloadAnalyticsReportSegment(ctx context.Context, segment *AnalyticsReportSegment, w io.Writer) (*Response, error) {
+	if segment == nil || segment.Attributes == nil || segment.Attributes.URL == nil {
+		return nil, ErrMissingSegmentURL
+	}
+
+	resp, err := s.client.stream(ctx, *segment.Attributes.URL, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	defer closeDesc(resp.Body)
+
+	return resp, decompress(resp.Body, w)
+}
+
+// AnalyticsReportSegmentWalkFunc is the type of the function called by WalkAnalyticsReportSegments
+// for each segment it visits. Returning an error stops the walk.
+type AnalyticsReportSegmentWalkFunc func(instance AnalyticsReportInstance, segment AnalyticsReportSegment) error
+
+// WalkAnalyticsReportSegments visits every segment of every instance of an analytics report with the given
+// granularity whose processing date falls between start and end, inclusive. Zero start or end times leave
+// that side of the range open.
+func (s *ReportingService) WalkAnalyticsReportSegments(ctx context.Context, reportID string, granularity AnalyticsReportGranularity, start, end time.Time, fn AnalyticsReportSegmentWalkFunc) error {
+	instancesQuery := &ListInstancesForAnalyticsReportQuery{
+		FilterGranularity: []string{string(granularity)},
+	}
+
+	for {
+		instances, _, err := s.ListInstancesForAnalyticsReport(ctx, reportID, instancesQuery)
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range instances.Data {
+			if !analyticsReportInstanceInRange(instance, start, end) {
+				continue
+			}
+
+			if err := s.walkAnalyticsReportInstanceSegments(ctx, instance, fn); err != nil {
+				return err
+			}
+		}
+
+		if instances.Links.Next == nil || instances.Links.Next.Cursor() == "" {
+			return nil
+		}
+
+		instancesQuery.Cursor = instances.Links.Next.Cursor()
+	}
+}
+
+func (s *ReportingService) walkAnalyticsReportInstanceSegments(ctx context.Context, instance AnalyticsReportInstance, fn AnalyticsReportSegmentWalkFunc) error {
+	segmentsQuery := &ListSegmentsForAnalyticsReportInstanceQuery{}
+
+	for {
+		segments, _, err := s.ListSegmentsForAnalyticsReportInstance(ctx, instance.ID, segmentsQuery)
+		if err != nil {
+			return err
+		}
+
+		for _, segment := range segments.Data {
+			if err := fn(instance, segment); err != nil {
+				return err
+			}
+		}
+
+		if segments.Links.Next == nil || segments.Links.Next.Cursor() == "" {
+			return nil
+		}
+
+		segmentsQuery.Cursor = segments.Links.Next.Cursor()
+	}
+}
+
+func analyticsReportInstanceInRange(instance AnalyticsReportInstance, start, end time.Time) bool {
+	if instance.Attributes == nil || instance.Attributes.ProcessingDate == nil {
+		return start.IsZero() && end.IsZero()
+	}
+
+	date := instance.Attributes.ProcessingDate.Time
+
+	if !start.IsZero() && date.Before(start) {
+		return false
+	}
+
+	if !end.IsZero() && date.After(end) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,230 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.CreateAnalyticsReportRequest(ctx, AnalyticsReportAccessTypeOngoing, "10")
+	})
+}
+
+func TestListAnalyticsReportRequestsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportRequestsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListAnalyticsReportRequestsForApp(ctx, "10", &ListAnalyticsReportRequestsForAppQuery{})
+	})
+}
+
+func TestGetAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReportRequest(ctx, "10", &GetAnalyticsReportRequestQuery{})
+	})
+}
+
+func TestDeleteAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Reporting.DeleteAnalyticsReportRequest(ctx, "10")
+	})
+}
+
+func TestListReportsForAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListReportsForAnalyticsReportRequest(ctx, "10", &ListReportsForAnalyticsReportRequestQuery{})
+	})
+}
+
+func TestGetAnalyticsReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReport(ctx, "10", &GetAnalyticsReportQuery{})
+	})
+}
+
+func TestListInstancesForAnalyticsReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportInstancesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListInstancesForAnalyticsReport(ctx, "10", &ListInstancesForAnalyticsReportQuery{})
+	})
+}
+
+func TestGetAnalyticsReportInstance(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportInstanceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReportInstance(ctx, "10", &GetAnalyticsReportInstanceQuery{})
+	})
+}
+
+func TestListSegmentsForAnalyticsReportInstance(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportSegmentsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListSegmentsForAnalyticsReportInstance(ctx, "10", &ListSegmentsForAnalyticsReportInstanceQuery{})
+	})
+}
+
+func TestGetAnalyticsReportSegment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportSegmentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReportSegment(ctx, "10", &GetAnalyticsReportSegmentQuery{})
+	})
+}
+
+func TestDownloadAnalyticsReportSegment(t *testing.T) {
+	t.Parallel()
+
+	var compressed bytes.Buffer
+
+	zw := gzip.NewWriter(&compressed)
+	_, _ = zw.Write([]byte("Date\tApp Name\n"))
+	_ = zw.Close()
+
+	client, server := newRoutedServer(map[string]route{
+		"GET /segment.csv.gz": respondWith(http.StatusOK, compressed.String()),
+	})
+	defer server.Close()
+
+	segment := &AnalyticsReportSegment{
+		Attributes: &AnalyticsReportSegmentAttributes{
+			URL: String(server.URL + "/segment.csv.gz"),
+		},
+	}
+
+	var buf bytes.Buffer
+	resp, err := client.Reporting.DownloadAnalyticsReportSegment(context.Background(), segment, &buf)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "Date\tApp Name\n", buf.String())
+}
+
+func TestDownloadAnalyticsReportSegmentUncompressed(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("Date", http.StatusOK, false)
+	defer server.Close()
+
+	segment := &AnalyticsReportSegment{
+		Attributes: &AnalyticsReportSegmentAttributes{
+			URL: String(server.URL + "/segment.csv"),
+		},
+	}
+
+	var buf bytes.Buffer
+	_, err := client.Reporting.DownloadAnalyticsReportSegment(context.Background(), segment, &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Date\n", buf.String())
+}
+
+func TestDownloadAnalyticsReportSegmentMissingURL(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	resp, err := client.Reporting.DownloadAnalyticsReportSegment(context.Background(), &AnalyticsReportSegment{}, &bytes.Buffer{})
+
+	assert.ErrorIs(t, err, ErrMissingSegmentURL)
+	assert.Nil(t, resp)
+}
+
+func newAnalyticsReportServer() (*Client, *routedServer) {
+	return newRoutedServer(map[string]route{
+		"GET /v1/analyticsReports/10/instances": func(req capturedRequest) routeResponse {
+			if req.Query.Get("cursor") == "" {
+				return routeResponse{Body: fmt.Sprintf(`{"data":[{"id":"1","type":"analyticsReportInstances","attributes":{"processingDate":"2024-01-01"}}],"links":{"self":"","next":"http://%s/v1/analyticsReports/10/instances?cursor=2"}}`, req.Host)}
+			}
+
+			return routeResponse{Body: `{"data":[{"id":"2","type":"analyticsReportInstances","attributes":{"processingDate":"2024-02-01"}}],"links":{"self":""}}`}
+		},
+		"GET /v1/analyticsReportInstances/1/segments": respondWith(http.StatusOK, `{"data":[{"id":"1a","type":"analyticsReportSegments"},{"id":"1b","type":"analyticsReportSegments"}],"links":{"self":""}}`),
+		"GET /v1/analyticsReportInstances/2/segments": respondWith(http.StatusOK, `{"data":[{"id":"2a","type":"analyticsReportSegments"}],"links":{"self":""}}`),
+	})
+}
+
+func TestWalkAnalyticsReportSegments(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAnalyticsReportServer()
+	defer server.Close()
+
+	var visited []string
+	err := client.Reporting.WalkAnalyticsReportSegments(context.Background(), "10", AnalyticsReportGranularityDaily, time.Time{}, time.Time{}, func(instance AnalyticsReportInstance, segment AnalyticsReportSegment) error {
+		visited = append(visited, instance.ID+"/"+segment.ID)
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1/1a", "1/1b", "2/2a"}, visited)
+
+	visited = nil
+	err = client.Reporting.WalkAnalyticsReportSegments(context.Background(), "10", AnalyticsReportGranularityDaily, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), func(instance AnalyticsReportInstance, segment AnalyticsReportSegment) error {
+		visited = append(visited, instance.ID+"/"+segment.ID)
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2/2a"}, visited)
+}
+
+func TestWalkAnalyticsReportSegmentsStopsOnError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAnalyticsReportServer()
+	defer server.Close()
+
+	errStop := errors.New("stop")
+	calls := 0
+	err := client.Reporting.WalkAnalyticsReportSegments(context.Background(), "10", AnalyticsReportGranularityDaily, time.Time{}, time.Time{}, func(instance AnalyticsReportInstance, segment AnalyticsReportSegment) error {
+		calls++
+
+		return errStop
+	})
+
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, calls)
+}
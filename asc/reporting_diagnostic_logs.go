@@ -0,0 +1,286 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const diagnosticLogsMediaType = "application/vnd.apple.diagnostic-logs+json"
+
+// DiagnosticLogs defines model for the diagnostic logs payload returned with the
+// application/vnd.apple.diagnostic-logs+json media type.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs
+type DiagnosticLogs struct {
+	ProductData []DiagnosticLogsProductData `json:"productData,omitempty"`
+	Version     *string                     `json:"version,omitempty"`
+}
+
+// DiagnosticLogsProductData defines model for DiagnosticLogs.ProductData
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs/productdata
+type DiagnosticLogsProductData struct {
+	DiagnosticInsights []DiagnosticInsight      `json:"diagnosticInsights,omitempty"`
+	DiagnosticLogs     []DiagnosticLogsLogEntry `json:"diagnosticLogs,omitempty"`
+	SignatureID        *string                  `json:"signatureId,omitempty"`
+}
+
+// DiagnosticInsight defines model for DiagnosticInsight.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticinsight
+type DiagnosticInsight struct {
+	InsightsCategory *string `json:"insightsCategory,omitempty"`
+	InsightsString   *string `json:"insightsString,omitempty"`
+	InsightsURL      *string `json:"insightsURL,omitempty"`
+}
+
+// DiagnosticLogsLogEntry defines model for DiagnosticLogs.ProductData.DiagnosticLogs
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs/productdata/diagnosticlogs
+type DiagnosticLogsLogEntry struct {
+	CallStackTree      []DiagnosticLogCallStackTree `json:"callStackTree,omitempty"`
+	DiagnosticMetaData *DiagnosticLogMetaData       `json:"diagnosticMetaData,omitempty"`
+}
+
+// DiagnosticLogMetaData defines model for DiagnosticLogs.ProductData.DiagnosticLogs.DiagnosticMetaData
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs/productdata/diagnosticlogs/diagnosticmetadata
+type DiagnosticLogMetaData struct {
+	AppVersion           *string `json:"appVersion,omitempty"`
+	BuildVersion         *string `json:"buildVersion,omitempty"`
+	BundleID             *string `json:"bundleId,omitempty"`
+	DeviceType           *string `json:"deviceType,omitempty"`
+	Event                *string `json:"event,omitempty"`
+	EventDetail          *string `json:"eventDetail,omitempty"`
+	OSVersion            *string `json:"osVersion,omitempty"`
+	PlatformArchitecture *string `json:"platformArchitecture,omitempty"`
+	WritesCaused         *string `json:"writesCaused,omitempty"`
+}
+
+// DiagnosticLogCallStackTree defines model for DiagnosticLogCallStackTree.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogcallstacktree
+type DiagnosticLogCallStackTree struct {
+	CallStackPerThread *bool                    `json:"callStackPerThread,omitempty"`
+	CallStacks         []DiagnosticLogCallStack `json:"callStacks,omitempty"`
+}
+
+// DiagnosticLogCallStack defines model for DiagnosticLogCallStack.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogcallstack
+type DiagnosticLogCallStack struct {
+	CallStackRootFrames []DiagnosticLogCallStackNode `json:"callStackRootFrames,omitempty"`
+}
+
+// DiagnosticLogCallStackNode defines model for DiagnosticLogCallStackNode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogcallstacknode
+type DiagnosticLogCallStackNode struct {
+	Address                     *string                      `json:"address,omitempty"`
+	BinaryName                  *string                      `json:"binaryName,omitempty"`
+	BinaryUUID                  *string                      `json:"binaryUUID,omitempty"`
+	FileName                    *string                      `json:"fileName,omitempty"`
+	InsightsCategory            *string                      `json:"insightsCategory,omitempty"`
+	IsBlameFrame                *bool                        `json:"isBlameFrame,omitempty"`
+	LineNumber                  *string                      `json:"lineNumber,omitempty"`
+	OffsetIntoBinaryTextSegment *string                      `json:"offsetIntoBinaryTextSegment,omitempty"`
+	RawFrame                    *string                      `json:"rawFrame,omitempty"`
+	SampleCount                 *int                         `json:"sampleCount,omitempty"`
+	SubFrames                   []DiagnosticLogCallStackNode `json:"subFrames,omitempty"`
+	SymbolName                  *string                      `json:"symbolName,omitempty"`
+}
+
+// DiagnosticStackFrame is a frame of a flattened call stack, holding the binary image and offset
+// a symbolicator such as atos needs to resolve it.
+type DiagnosticStackFrame struct {
+	Address                     string
+	BinaryName                  string
+	BinaryUUID                  string
+	FileName                    string
+	IsBlameFrame                bool
+	LineNumber                  string
+	OffsetIntoBinaryTextSegment string
+	RawFrame                    string
+	SampleCount                 int
+	SymbolName                  string
+}
+
+// IsSymbolicated reports whether Apple already resolved the frame to a symbol.
+func (f DiagnosticStackFrame) IsSymbolicated() bool {
+	return f.SymbolName != ""
+}
+
+// DiagnosticStackTrace is a single path through a call stack tree, from its root frame to a leaf,
+// in the order the frames appear in the tree.
+type DiagnosticStackTrace struct {
+	// Thread is the index of the call stack the trace belongs to within its tree.
+	Thread int
+	Frames []DiagnosticStackFrame
+}
+
+// String formats the trace like the backtrace of a crash report, one numbered frame per line. Symbolicated
+// frames end with their symbol and its offset, and the others with their binary and offset into it.
+func (t DiagnosticStackTrace) String() string {
+	var b strings.Builder
+
+	for i, frame := range t.Frames {
+		location := frame.BinaryName + " + " + frame.OffsetIntoBinaryTextSegment
+
+		if frame.IsSymbolicated() {
+			location = frame.SymbolName
+			if frame.OffsetIntoBinaryTextSegment != "" {
+				location += " + " + frame.OffsetIntoBinaryTextSegment
+			}
+		}
+
+		fmt.Fprintf(&b, "%-4d%-32s %s %s\n", i, frame.BinaryName, frame.Address, location)
+	}
+
+	return b.String()
+}
+
+// Flatten returns every path from a root frame to a leaf frame of the call stack tree. Trees that
+// merge samples from several stacks branch wherever the stacks diverge, producing one trace per
+// distinct stack.
+func (t DiagnosticLogCallStackTree) Flatten() []DiagnosticStackTrace {
+	var traces []DiagnosticStackTrace
+
+	for thread, stack := range t.CallStacks {
+		for _, root := range stack.CallStackRootFrames {
+			traces = appendDiagnosticStackTraces(traces, thread, nil, root)
+		}
+	}
+
+	return traces
+}
+
+func appendDiagnosticStackTraces(traces []DiagnosticStackTrace, thread int, prefix []DiagnosticStackFrame, node DiagnosticLogCallStackNode) []DiagnosticStackTrace {
+	frames := append(prefix[:len(prefix):len(prefix)], newDiagnosticStackFrame(node))
+
+	if len(node.SubFrames) == 0 {
+		return append(traces, DiagnosticStackTrace{Thread: thread, Frames: frames})
+	}
+
+	for _, sub := range node.SubFrames {
+		traces = appendDiagnosticStackTraces(traces, thread, frames, sub)
+	}
+
+	return traces
+}
+
+func newDiagnosticStackFrame(node DiagnosticLogCallStackNode) DiagnosticStackFrame {
+	frame := DiagnosticStackFrame{
+		Address:                     stringValue(node.Address),
+		BinaryName:                  stringValue(node.BinaryName),
+		BinaryUUID:                  stringValue(node.BinaryUUID),
+		FileName:                    stringValue(node.FileName),
+		IsBlameFrame:                node.IsBlameFrame != nil && *node.IsBlameFrame,
+		LineNumber:                  stringValue(node.LineNumber),
+		OffsetIntoBinaryTextSegment: stringValue(node.OffsetIntoBinaryTextSegment),
+		RawFrame:                    stringValue(node.RawFrame),
+		SymbolName:                  stringValue(node.SymbolName),
+	}
+
+	if node.SampleCount != nil {
+		frame.SampleCount = *node.SampleCount
+	}
+
+	return frame
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// GetDiagnosticLogsForSignature gets the content of the anonymized backtrace logs associated with a specific
+// diagnostic signature, including their call stack trees, device metadata and insights.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_logs_for_a_diagnostic_signature
+func (s *ReportingService) GetDiagnosticLogsForSignature(ctx context.Context, id string, params *GetLogsForDiagnosticSignatureQuery) (*DiagnosticLogs, *Response, error) {
+	url := fmt.Sprintf("diagnosticSignatures/%s/logs", id)
+	res := new(DiagnosticLogs)
+	resp, err := s.client.get(ctx, url, params, res, withAccept(diagnosticLogsMediaType))
+
+	return res, resp, err
+}
+
+// DiagnosticLogsWalkFunc is the type of the function called by WalkDiagnosticLogsForBuild for each
+// signature it visits. Returning an error stops the walk.
+type DiagnosticLogsWalkFunc func(signature DiagnosticSignature, logs *DiagnosticLogs) error
+
+// WalkDiagnosticLogsForBuild downloads the logs of every diagnostic signature of a build matching params,
+// visiting the signatures with the highest weight first.
+func (s *ReportingService) WalkDiagnosticLogsForBuild(ctx context.Context, buildID string, params *ListDiagnosticsSignaturesQuery, fn DiagnosticLogsWalkFunc) error {
+	query := ListDiagnosticsSignaturesQuery{}
+	if params != nil {
+		query = *params
+	}
+
+	var signatures []DiagnosticSignature
+
+	for {
+		res, _, err := s.ListDiagnosticSignaturesForBuild(ctx, buildID, &query)
+		if err != nil {
+			return err
+		}
+
+		signatures = append(signatures, res.Data...)
+
+		if res.Links.Next == nil || res.Links.Next.Cursor() == "" {
+			break
+		}
+
+		query.Cursor = res.Links.Next.Cursor()
+	}
+
+	sort.SliceStable(signatures, func(i, j int) bool {
+		return diagnosticSignatureWeight(signatures[i]) > diagnosticSignatureWeight(signatures[j])
+	})
+
+	for _, signature := range signatures {
+		logs, _, err := s.GetDiagnosticLogsForSignature(ctx, signature.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(signature, logs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func diagnosticSignatureWeight(signature DiagnosticSignature) float32 {
+	if signature.Attributes == nil || signature.Attributes.Weight == nil {
+		return -1
+	}
+
+	return *signature.Attributes.Weight
+}
@@ -0,0 +1,167 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const diagnosticLogsJSON = `{
+	"version": "1.0",
+	"productData": [{
+		"signatureId": "sig",
+		"diagnosticInsights": [{"insightsCategory": "DISK_WRITES", "insightsString": "Avoid writing on the main thread", "insightsURL": "https://developer.apple.com"}],
+		"diagnosticLogs": [{
+			"diagnosticMetaData": {"bundleId": "com.example.app", "event": "Disk Writes", "osVersion": "iOS 17.0", "appVersion": "1.1", "buildVersion": "42", "deviceType": "iPhone14,2", "platformArchitecture": "arm64", "writesCaused": "1.2 GB"},
+			"callStackTree": [{
+				"callStackPerThread": false,
+				"callStacks": [{
+					"callStackRootFrames": [{
+						"binaryName": "Example",
+						"binaryUUID": "A1B2",
+						"address": "0x0000000102a3c4d8",
+						"offsetIntoBinaryTextSegment": "12345",
+						"sampleCount": 10,
+						"isBlameFrame": true,
+						"subFrames": [
+							{"binaryName": "Foundation", "symbolName": "-[NSData writeToFile:]", "address": "0x1", "sampleCount": 6},
+							{"binaryName": "libsqlite3.dylib", "rawFrame": "libsqlite3.dylib + 200", "address": "0x2", "sampleCount": 4}
+						]
+					}]
+				}]
+			}]
+		}]
+	}]
+}`
+
+func TestGetDiagnosticLogsForSignature(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(diagnosticLogsJSON, http.StatusOK, true)
+	defer server.Close()
+
+	got, resp, err := client.Reporting.GetDiagnosticLogsForSignature(context.Background(), "10", &GetLogsForDiagnosticSignatureQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, "application/vnd.apple.diagnostic-logs+json", resp.Request.Header.Get("Accept"))
+
+	var want DiagnosticLogs
+
+	assert.NoError(t, json.Unmarshal([]byte(diagnosticLogsJSON), &want))
+	assert.Equal(t, &want, got)
+	assert.Equal(t, "1.2 GB", *got.ProductData[0].DiagnosticLogs[0].DiagnosticMetaData.WritesCaused)
+	assert.Equal(t, "DISK_WRITES", *got.ProductData[0].DiagnosticInsights[0].InsightsCategory)
+}
+
+func TestDiagnosticLogCallStackTreeFlatten(t *testing.T) {
+	t.Parallel()
+
+	var logs DiagnosticLogs
+
+	assert.NoError(t, json.Unmarshal([]byte(diagnosticLogsJSON), &logs))
+
+	traces := logs.ProductData[0].DiagnosticLogs[0].CallStackTree[0].Flatten()
+	assert.Len(t, traces, 2)
+
+	root := DiagnosticStackFrame{
+		Address:                     "0x0000000102a3c4d8",
+		BinaryName:                  "Example",
+		BinaryUUID:                  "A1B2",
+		IsBlameFrame:                true,
+		OffsetIntoBinaryTextSegment: "12345",
+		SampleCount:                 10,
+	}
+	assert.Equal(t, DiagnosticStackTrace{
+		Thread: 0,
+		Frames: []DiagnosticStackFrame{
+			root,
+			{Address: "0x1", BinaryName: "Foundation", SymbolName: "-[NSData writeToFile:]", SampleCount: 6},
+		},
+	}, traces[0])
+	assert.Equal(t, DiagnosticStackTrace{
+		Thread: 0,
+		Frames: []DiagnosticStackFrame{
+			root,
+			{Address: "0x2", BinaryName: "libsqlite3.dylib", RawFrame: "libsqlite3.dylib + 200", SampleCount: 4},
+		},
+	}, traces[1])
+
+	assert.False(t, root.IsSymbolicated())
+	assert.Equal(t, fmt.Sprintf("%-4d%-32s 0x0000000102a3c4d8 Example + 12345\n%-4d%-32s 0x1 -[NSData writeToFile:]\n", 0, "Example", 1, "Foundation"), traces[0].String())
+
+	symbolicated := DiagnosticStackTrace{Frames: []DiagnosticStackFrame{
+		{Address: "0x1a2b3c", BinaryName: "Foundation", OffsetIntoBinaryTextSegment: "48", SymbolName: "-[NSData writeToFile:]"},
+	}}
+	assert.Equal(t, fmt.Sprintf("%-4d%-32s 0x1a2b3c -[NSData writeToFile:] + 48\n", 0, "Foundation"), symbolicated.String())
+	assert.Empty(t, DiagnosticLogCallStackTree{}.Flatten())
+}
+
+func newDiagnosticSignatureServer() (*Client, *routedServer) {
+	logs := respondWith(http.StatusOK, diagnosticLogsJSON)
+
+	return newRoutedServer(map[string]route{
+		"GET /v1/builds/10/diagnosticSignatures": func(req capturedRequest) routeResponse {
+			if req.Query.Get("cursor") == "" {
+				return routeResponse{Body: fmt.Sprintf(`{"data":[{"id":"light","type":"diagnosticSignatures","attributes":{"weight":0.1}},{"id":"unweighted","type":"diagnosticSignatures"}],"links":{"self":"","next":"http://%s/v1/builds/10/diagnosticSignatures?cursor=2"}}`, req.Host)}
+			}
+
+			return routeResponse{Body: `{"data":[{"id":"heavy","type":"diagnosticSignatures","attributes":{"weight":0.7}}],"links":{"self":""}}`}
+		},
+		"GET /v1/diagnosticSignatures/light/logs":      logs,
+		"GET /v1/diagnosticSignatures/heavy/logs":      logs,
+		"GET /v1/diagnosticSignatures/unweighted/logs": logs,
+	})
+}
+
+func TestWalkDiagnosticLogsForBuild(t *testing.T) {
+	t.Parallel()
+
+	client, server := newDiagnosticSignatureServer()
+	defer server.Close()
+
+	var visited []string
+	err := client.Reporting.WalkDiagnosticLogsForBuild(context.Background(), "10", nil, func(signature DiagnosticSignature, logs *DiagnosticLogs) error {
+		visited = append(visited, signature.ID)
+		assert.Len(t, logs.ProductData, 1)
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"heavy", "light", "unweighted"}, visited)
+
+	errStop := errors.New("stop")
+	err = client.Reporting.WalkDiagnosticLogsForBuild(context.Background(), "10", &ListDiagnosticsSignaturesQuery{}, func(signature DiagnosticSignature, logs *DiagnosticLogs) error {
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+
+	err = client.Reporting.WalkDiagnosticLogsForBuild(context.Background(), "11", nil, func(signature DiagnosticSignature, logs *DiagnosticLogs) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
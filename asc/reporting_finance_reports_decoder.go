@@ -0,0 +1,294 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FinanceReportRow defines a transaction row of a FINANCIAL report or of a FINANCE_DETAIL report.
+// Columns that only appear in one of the two report types are left empty for the other.
+type FinanceReportRow struct {
+	StartDate                            Date    `tsv:"Start Date"`
+	EndDate                              Date    `tsv:"End Date"`
+	TransactionDate                      Date    `tsv:"Transaction Date"`
+	SettlementDate                       Date    `tsv:"Settlement Date"`
+	UPC                                  string  `tsv:"UPC"`
+	ISRCOrISBN                           string  `tsv:"ISRC/ISBN"`
+	VendorIdentifier                     string  `tsv:"Vendor Identifier|SKU"`
+	Quantity                             int     `tsv:"Quantity"`
+	PartnerShare                         Decimal `tsv:"Partner Share"`
+	ExtendedPartnerShare                 Decimal `tsv:"Extended Partner Share"`
+	PartnerShareCurrency                 string  `tsv:"Partner Share Currency"`
+	SalesOrReturn                        string  `tsv:"Sales or Return|Sale or Return"`
+	AppleIdentifier                      string  `tsv:"Apple Identifier"`
+	ArtistShowDeveloperAuthor            string  `tsv:"Artist/Show/Developer/Author|Developer Name"`
+	Title                                string  `tsv:"Title"`
+	LabelStudioNetworkDeveloperPublisher string  `tsv:"Label/Studio/Network/Developer/Publisher"`
+	Grid                                 string  `tsv:"Grid"`
+	ProductTypeIdentifier                string  `tsv:"Product Type Identifier"`
+	ISANOrOtherIdentifier                string  `tsv:"ISAN/Other Identifier"`
+	CountryOfSale                        string  `tsv:"Country Of Sale"`
+	PreOrderFlag                         string  `tsv:"Pre-order Flag"`
+	PromoCode                            string  `tsv:"Promo Code"`
+	CustomerPrice                        Decimal `tsv:"Customer Price"`
+	CustomerCurrency                     string  `tsv:"Customer Currency"`
+	OrderType                            string  `tsv:"Order Type"`
+	Region                               string  `tsv:"Region"`
+}
+
+// FinanceReportSummaryRow defines a row of the per-region summary of a FINANCE_DETAIL report, which
+// converts the amount owed in each currency into the currency of the bank account.
+type FinanceReportSummaryRow struct {
+	CountryOrRegion     string  `tsv:"Country or Region (Currency)"`
+	Units               int     `tsv:"Units"`
+	Earned              Decimal `tsv:"Earned"`
+	PreTaxSubtotal      Decimal `tsv:"Pre-Tax Subtotal"`
+	InputTax            Decimal `tsv:"Input Tax"`
+	Adjustments         Decimal `tsv:"Adjustments"`
+	WithholdingTax      Decimal `tsv:"Withholding Tax"`
+	TotalOwed           Decimal `tsv:"Total Owed"`
+	ExchangeRate        Decimal `tsv:"Exchange Rate"`
+	Proceeds            Decimal `tsv:"Proceeds"`
+	BankAccountCurrency string  `tsv:"Bank Account Currency"`
+}
+
+// FinanceReportTotals holds the Total_Rows, Total_Amount and Total_Units footer lines that close
+// a section of a finance report.
+type FinanceReportTotals struct {
+	Rows   int
+	Amount Decimal
+	Units  int
+}
+
+// FinanceReportCurrencyTotal is the sum of the transaction rows of a finance report in a single currency.
+type FinanceReportCurrencyTotal struct {
+	Currency string
+	Rows     int
+	Units    int
+	Amount   Decimal
+}
+
+// FinanceReport is a fully decoded finance report.
+type FinanceReport struct {
+	Rows           []FinanceReportRow
+	Totals         []FinanceReportTotals
+	Summary        []FinanceReportSummaryRow
+	CurrencyTotals []FinanceReportCurrencyTotal
+}
+
+var (
+	financeReportRowColumns     = reportColumnIndex(reflect.TypeOf(FinanceReportRow{}))
+	financeReportSummaryColumns = reportColumnIndex(reflect.TypeOf(FinanceReportSummaryRow{}))
+)
+
+// FinanceReportDecoder reads typed rows from a finance report as returned by DownloadFinanceReports,
+// including reports that combine several sections, each with its own header and totals footer.
+// Compressed and decompressed reports are both accepted.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_finance_reports
+type FinanceReportDecoder struct {
+	r        *reportReader
+	summary  bool
+	inFooter bool
+	totals   []FinanceReportTotals
+	rows     []FinanceReportSummaryRow
+	currency map[string]*FinanceReportCurrencyTotal
+	order    []string
+}
+
+// NewFinanceReportDecoder returns a decoder that reads a finance report from r.
+func NewFinanceReportDecoder(r io.Reader) *FinanceReportDecoder {
+	return &FinanceReportDecoder{
+		r:        newReportReader(r),
+		currency: make(map[string]*FinanceReportCurrencyTotal),
+	}
+}
+
+// Decode reads the next transaction row of the report into row. Section headers, totals footers
+// and summary rows met along the way are consumed and made available through Totals and Summary.
+// It returns io.EOF when there are no more transaction rows, and ErrUnknownReportColumn if a
+// section header contains a column FinanceReportRow or FinanceReportSummaryRow does not define.
+func (d *FinanceReportDecoder) Decode(row *FinanceReportRow) error {
+	if row == nil {
+		return ErrInvalidReportRow
+	}
+
+	for {
+		record, err := d.r.next()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case isFinanceReportTotal(record):
+			if err := d.readTotal(record); err != nil {
+				return err
+			}
+		case isFinanceReportHeader(record):
+			d.inFooter = false
+			d.summary = isFinanceReportSummaryHeader(record)
+			d.r.setHeader(record)
+		case d.r.header == nil:
+			continue
+		case d.summary:
+			var summary FinanceReportSummaryRow
+			if err := d.r.decode(record, &summary); err != nil {
+				return err
+			}
+
+			d.rows = append(d.rows, summary)
+		default:
+			*row = FinanceReportRow{}
+			if err := d.r.decode(record, row); err != nil {
+				return err
+			}
+
+			d.addToCurrencyTotal(row)
+
+			return nil
+		}
+	}
+}
+
+// Totals returns the totals footers of the sections read so far.
+func (d *FinanceReportDecoder) Totals() []FinanceReportTotals {
+	return d.totals
+}
+
+// Summary returns the summary rows read so far.
+func (d *FinanceReportDecoder) Summary() []FinanceReportSummaryRow {
+	return d.rows
+}
+
+// CurrencyTotals returns the sums of the transaction rows read so far, per partner share currency,
+// in the order the currencies first appeared in the report.
+func (d *FinanceReportDecoder) CurrencyTotals() []FinanceReportCurrencyTotal {
+	totals := make([]FinanceReportCurrencyTotal, 0, len(d.order))
+	for _, currency := range d.order {
+		totals = append(totals, *d.currency[currency])
+	}
+
+	return totals
+}
+
+func (d *FinanceReportDecoder) readTotal(record []string) error {
+	if !d.inFooter {
+		d.totals = append(d.totals, FinanceReportTotals{})
+		d.inFooter = true
+	}
+
+	totals := &d.totals[len(d.totals)-1]
+	value := lastNonEmpty(record[1:])
+
+	var err error
+
+	switch strings.ToLower(record[0]) {
+	case "total_rows":
+		totals.Rows, err = strconv.Atoi(strings.ReplaceAll(value, ",", ""))
+	case "total_amount":
+		totals.Amount, err = ParseDecimal(strings.ReplaceAll(value, ",", ""))
+	case "total_units":
+		totals.Units, err = strconv.Atoi(strings.ReplaceAll(value, ",", ""))
+	}
+
+	if err != nil {
+		return ErrInvalidReportValue{
+			Line:   d.r.line,
+			Column: record[0],
+			Value:  value,
+			Err:    err,
+		}
+	}
+
+	return nil
+}
+
+func (d *FinanceReportDecoder) addToCurrencyTotal(row *FinanceReportRow) {
+	total, ok := d.currency[row.PartnerShareCurrency]
+	if !ok {
+		total = &FinanceReportCurrencyTotal{Currency: row.PartnerShareCurrency}
+		d.currency[row.PartnerShareCurrency] = total
+		d.order = append(d.order, row.PartnerShareCurrency)
+	}
+
+	total.Rows++
+	total.Units += row.Quantity
+	total.Amount = total.Amount.Add(row.ExtendedPartnerShare)
+}
+
+// DecodeFinanceReport reads a whole finance report from r.
+func DecodeFinanceReport(r io.Reader) (*FinanceReport, error) {
+	d := NewFinanceReportDecoder(r)
+	report := new(FinanceReport)
+
+	for {
+		var row FinanceReportRow
+
+		err := d.Decode(&row)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		report.Rows = append(report.Rows, row)
+	}
+
+	report.Totals = d.Totals()
+	report.Summary = d.Summary()
+	report.CurrencyTotals = d.CurrencyTotals()
+
+	return report, nil
+}
+
+func isFinanceReportTotal(record []string) bool {
+	return strings.HasPrefix(strings.ToLower(record[0]), "total_")
+}
+
+func isFinanceReportHeader(record []string) bool {
+	first := strings.ToLower(strings.TrimPrefix(record[0], byteOrderMark))
+	_, isRow := financeReportRowColumns[first]
+
+	return isRow || isFinanceReportSummaryHeader(record)
+}
+
+func isFinanceReportSummaryHeader(record []string) bool {
+	_, ok := financeReportSummaryColumns[strings.ToLower(strings.TrimPrefix(record[0], byteOrderMark))]
+
+	return ok
+}
+
+func lastNonEmpty(values []string) string {
+	for i := len(values) - 1; i >= 0; i-- {
+		if values[i] != "" {
+			return values[i]
+		}
+	}
+
+	return ""
+}
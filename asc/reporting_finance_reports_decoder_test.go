@@ -0,0 +1,146 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const financeReportTSV = "Start Date\tEnd Date\tUPC\tISRC/ISBN\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tSales or Return\tApple Identifier\tArtist/Show/Developer/Author\tTitle\tLabel/Studio/Network/Developer/Publisher\tGrid\tProduct Type Identifier\tISAN/Other Identifier\tCountry Of Sale\tPre-order Flag\tPromo Code\tCustomer Price\tCustomer Currency\n" +
+	"01/01/2024\t01/31/2024\t\t\tcom.example.app\t3\t0.70\t2.10\tUSD\tS\t123456789\tExample\tExample App\t\t\t1F\t\tUS\t\t\t0.99\tUSD\n" +
+	"01/01/2024\t01/31/2024\t\t\tcom.example.app\t-1\t0.70\t-0.70\tUSD\tR\t123456789\tExample\tExample App\t\t\t1F\t\tUS\t\t\t0.99\tUSD\n" +
+	"Total_Rows\t\t\t\t\t\t\t2\n" +
+	"Total_Amount\t\t\t\t\t\t\t1.40\n" +
+	"Total_Units\t\t\t\t\t\t\t2\n" +
+	"\n" +
+	"Start Date\tEnd Date\tVendor Identifier\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tCountry Of Sale\n" +
+	"01/01/2024\t01/31/2024\tcom.example.app\t2\t0.1\t0.2\tEUR\tDE\n" +
+	"Total_Rows\t1\n" +
+	"Total_Amount\t0.2\n" +
+	"Total_Units\t2\n"
+
+const financeDetailReportTSV = "Transaction Date\tSettlement Date\tApple Identifier\tSKU\tTitle\tDeveloper Name\tProduct Type Identifier\tCountry of Sale\tQuantity\tPartner Share\tExtended Partner Share\tPartner Share Currency\tCustomer Price\tCustomer Currency\tSale or Return\tPromo Code\tOrder Type\tRegion\n" +
+	"01/05/2024\t01/31/2024\t123456789\tcom.example.app\tExample App\tExample\t1F\tUS\t1\t0.70\t0.70\tUSD\t0.99\tUSD\tS\t\t\tAmericas\n" +
+	"\n" +
+	"Country or Region (Currency)\tUnits\tEarned\tPre-Tax Subtotal\tInput Tax\tAdjustments\tWithholding Tax\tTotal Owed\tExchange Rate\tProceeds\tBank Account Currency\n" +
+	"Americas (USD)\t1\t0.70\t0.70\t0.00\t0.00\t0.00\t0.70\t1.000000\t0.70\tUSD\n" +
+	"Euro-Zone (EUR)\t1\t1,000.10\t1,000.10\t0.00\t0.00\t0.00\t1,000.10\t1.085000\t1,085.11\tUSD\n"
+
+func TestFinanceReportDecoder(t *testing.T) {
+	t.Parallel()
+
+	dec := NewFinanceReportDecoder(gzipString(financeReportTSV))
+
+	var rows []FinanceReportRow
+
+	for {
+		var row FinanceReportRow
+
+		err := dec.Decode(&row)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.NoError(t, err)
+
+		rows = append(rows, row)
+	}
+
+	assert.Len(t, rows, 3)
+	assert.Equal(t, "com.example.app", rows[0].VendorIdentifier)
+	assert.Equal(t, "2.10", rows[0].ExtendedPartnerShare.String())
+	assert.Equal(t, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), rows[0].EndDate.Time)
+	assert.Equal(t, "US", rows[0].CountryOfSale)
+	assert.Equal(t, "R", rows[1].SalesOrReturn)
+	assert.Equal(t, "EUR", rows[2].PartnerShareCurrency)
+
+	assert.Equal(t, []FinanceReportTotals{
+		{Rows: 2, Amount: mustParseDecimal("1.40"), Units: 2},
+		{Rows: 1, Amount: mustParseDecimal("0.2"), Units: 2},
+	}, dec.Totals())
+
+	totals := dec.CurrencyTotals()
+	assert.Len(t, totals, 2)
+	assert.Equal(t, "USD", totals[0].Currency)
+	assert.Equal(t, 2, totals[0].Rows)
+	assert.Equal(t, 2, totals[0].Units)
+	assert.Equal(t, "1.40", totals[0].Amount.String())
+	assert.Equal(t, "EUR", totals[1].Currency)
+	assert.Equal(t, "0.2", totals[1].Amount.String())
+	assert.Empty(t, dec.Summary())
+}
+
+func TestDecodeFinanceDetailReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := DecodeFinanceReport(strings.NewReader(financeDetailReportTSV))
+	assert.NoError(t, err)
+
+	assert.Len(t, report.Rows, 1)
+	assert.Equal(t, "com.example.app", report.Rows[0].VendorIdentifier)
+	assert.Equal(t, "Example", report.Rows[0].ArtistShowDeveloperAuthor)
+	assert.Equal(t, "US", report.Rows[0].CountryOfSale)
+	assert.Equal(t, "S", report.Rows[0].SalesOrReturn)
+	assert.Equal(t, "Americas", report.Rows[0].Region)
+	assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), report.Rows[0].TransactionDate.Time)
+
+	assert.Len(t, report.Summary, 2)
+	assert.Equal(t, "Euro-Zone (EUR)", report.Summary[1].CountryOrRegion)
+	assert.Equal(t, "1000.10", report.Summary[1].Earned.String())
+	assert.Equal(t, "1.085000", report.Summary[1].ExchangeRate.String())
+	assert.Equal(t, "1085.11", report.Summary[1].Proceeds.String())
+	assert.Equal(t, "USD", report.Summary[1].BankAccountCurrency)
+
+	assert.Empty(t, report.Totals)
+	assert.Equal(t, []FinanceReportCurrencyTotal{{Currency: "USD", Rows: 1, Units: 1, Amount: mustParseDecimal("0.70")}}, report.CurrencyTotals)
+}
+
+func TestFinanceReportDecoderUnknownColumn(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeFinanceReport(strings.NewReader("Start Date\tSurprise\n01/01/2024\tx\n"))
+	assert.Equal(t, ErrUnknownReportColumn{Column: "Surprise", RowType: "FinanceReportRow"}, err)
+}
+
+func TestFinanceReportDecoderInvalidTotal(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeFinanceReport(strings.NewReader("Start Date\n01/01/2024\nTotal_Rows\tlots\n"))
+
+	var invalid ErrInvalidReportValue
+
+	assert.True(t, errors.As(err, &invalid))
+	assert.Equal(t, "Total_Rows", invalid.Column)
+	assert.Equal(t, 3, invalid.Line)
+}
+
+func TestFinanceReportDecoderNilRow(t *testing.T) {
+	t.Parallel()
+
+	dec := NewFinanceReportDecoder(strings.NewReader(financeReportTSV))
+	assert.ErrorIs(t, dec.Decode(nil), ErrInvalidReportRow)
+}
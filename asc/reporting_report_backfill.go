@@ -0,0 +1,310 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const (
+	defaultReportBackfillConcurrency = 4
+	reportBackfillMaxRetryTime       = 2 * time.Minute
+)
+
+// ErrRateLimitExhausted is recorded for report dates a backfill did not attempt because the hourly
+// rate limit of the API key was used up.
+var ErrRateLimitExhausted = errors.New("rate limit exhausted for the current hour")
+
+// ReportBackfillResult summarizes a report backfill. Every report date in the range appears in
+// exactly one of its fields, unless the backfill was canceled before reaching it.
+type ReportBackfillResult struct {
+	// Downloaded are the dates of the reports written to the directory by this backfill.
+	Downloaded []string
+	// Skipped are the dates of the reports that were already present in the directory.
+	Skipped []string
+	// Unavailable are the dates Apple has no report for, see ErrReportNotAvailable.
+	Unavailable []string
+	// Failed maps the dates that could not be downloaded to the error that occurred.
+	Failed map[string]error
+}
+
+// SalesReportBackfillOptions are options for BackfillSalesAndTrendsReports.
+type SalesReportBackfillOptions struct {
+	VendorNumber  string
+	ReportType    string
+	ReportSubType string
+	// Version is the report version, or empty to use the latest version.
+	Version   string
+	Frequency ReportFrequency
+	// Start and End are the first and last days to fetch reports for, inclusive.
+	Start time.Time
+	End   time.Time
+	// Dir is the directory reports are written to. It is created if it does not exist.
+	Dir string
+	// Concurrency is the maximum number of reports downloaded at once. Defaults to 4.
+	Concurrency int
+}
+
+// Filename returns the name of the file, within Dir, the report for date is written to.
+func (o *SalesReportBackfillOptions) Filename(date string) string {
+	parts := []string{o.ReportType, o.ReportSubType, string(o.Frequency)}
+	if o.Version != "" {
+		parts = append(parts, o.Version)
+	}
+
+	return strings.Join(append(parts, o.VendorNumber, date), "_") + ".txt.gz"
+}
+
+// FinanceReportBackfillOptions are options for BackfillFinanceReports.
+type FinanceReportBackfillOptions struct {
+	VendorNumber string
+	ReportType   string
+	RegionCode   string
+	// Start and End are the first and last days to fetch reports for, inclusive. Every fiscal month
+	// containing one of these days is fetched.
+	Start time.Time
+	End   time.Time
+	// Dir is the directory reports are written to. It is created if it does not exist.
+	Dir string
+	// Concurrency is the maximum number of reports downloaded at once. Defaults to 4.
+	Concurrency int
+}
+
+// Filename returns the name of the file, within Dir, the report for date is written to.
+func (o *FinanceReportBackfillOptions) Filename(date string) string {
+	return strings.Join([]string{o.ReportType, o.RegionCode, o.VendorNumber, date}, "_") + ".txt.gz"
+}
+
+// BackfillSalesAndTrendsReports downloads the gzip-compressed sales and trends report for every report
+// date of the frequency between the start and end of opts into opts.Dir, skipping reports that were
+// downloaded before. Requests that hit the rate limit are retried with exponential backoff. An error
+// is only returned if the directory cannot be created or ctx is canceled, in which case the result
+// covers the dates processed so far.
+func (s *ReportingService) BackfillSalesAndTrendsReports(ctx context.Context, opts *SalesReportBackfillOptions) (*ReportBackfillResult, error) {
+	dates, err := SalesReportDates(opts.Frequency, opts.Start, opts.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.backfillReports(ctx, reportBackfill{
+		dates:       dates,
+		dir:         opts.Dir,
+		concurrency: opts.Concurrency,
+		filename:    opts.Filename,
+		download: func(ctx context.Context, date string, w io.Writer) (*Response, error) {
+			params := &DownloadSalesAndTrendsReportsQuery{
+				FilterFrequency:     []string{string(opts.Frequency)},
+				FilterReportDate:    []string{date},
+				FilterReportSubType: []string{opts.ReportSubType},
+				FilterReportType:    []string{opts.ReportType},
+				FilterVendorNumber:  []string{opts.VendorNumber},
+			}
+			if opts.Version != "" {
+				params.FilterVersion = []string{opts.Version}
+			}
+
+			return s.StreamSalesAndTrendsReports(ctx, params, w)
+		},
+	})
+}
+
+// BackfillFinanceReports downloads the gzip-compressed finance report for every fiscal month between
+// the start and end of opts into opts.Dir, skipping reports that were downloaded before. It otherwise
+// behaves like BackfillSalesAndTrendsReports.
+func (s *ReportingService) BackfillFinanceReports(ctx context.Context, opts *FinanceReportBackfillOptions) (*ReportBackfillResult, error) {
+	return s.backfillReports(ctx, reportBackfill{
+		dates:       FinanceReportDates(opts.Start, opts.End),
+		dir:         opts.Dir,
+		concurrency: opts.Concurrency,
+		filename:    opts.Filename,
+		download: func(ctx context.Context, date string, w io.Writer) (*Response, error) {
+			return s.StreamFinanceReports(ctx, &DownloadFinanceReportsQuery{
+				FilterRegionCode:   []string{opts.RegionCode},
+				FilterReportDate:   []string{date},
+				FilterReportType:   []string{opts.ReportType},
+				FilterVendorNumber: []string{opts.VendorNumber},
+			}, w)
+		},
+	})
+}
+
+type reportBackfill struct {
+	dates       []string
+	dir         string
+	concurrency int
+	filename    func(date string) string
+	download    func(ctx context.Context, date string, w io.Writer) (*Response, error)
+}
+
+func (s *ReportingService) backfillReports(ctx context.Context, b reportBackfill) (*ReportBackfillResult, error) {
+	if err := os.MkdirAll(b.dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	concurrency := b.concurrency
+	if concurrency <= 0 {
+		concurrency = defaultReportBackfillConcurrency
+	}
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		exhausted bool
+		result    = &ReportBackfillResult{Failed: map[string]error{}}
+		jobs      = make(chan string)
+	)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for date := range jobs {
+				mu.Lock()
+				skip := exhausted
+				mu.Unlock()
+
+				var (
+					resp *Response
+					err  = ErrRateLimitExhausted
+				)
+
+				if !skip {
+					resp, err = b.fetch(ctx, date)
+				}
+
+				mu.Lock()
+
+				var notAvailable ErrReportNotAvailable
+
+				switch {
+				case err == nil:
+					result.Downloaded = append(result.Downloaded, date)
+				case errors.As(err, &notAvailable):
+					result.Unavailable = append(result.Unavailable, date)
+				default:
+					result.Failed[date] = err
+				}
+
+				if isRateLimited(err) || (resp != nil && resp.Rate.Limit > 0 && resp.Rate.Remaining <= 0) {
+					exhausted = true
+				}
+
+				mu.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for _, date := range b.dates {
+		if _, err := os.Stat(filepath.Join(b.dir, b.filename(date))); err == nil {
+			mu.Lock()
+			result.Skipped = append(result.Skipped, date)
+			mu.Unlock()
+
+			continue
+		}
+
+		select {
+		case jobs <- date:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	sort.Strings(result.Downloaded)
+	sort.Strings(result.Skipped)
+	sort.Strings(result.Unavailable)
+
+	return result, ctx.Err()
+}
+
+// fetch downloads the report for date to a temporary file that is moved into place once complete,
+// so an interrupted download is never mistaken for a finished one.
+func (b *reportBackfill) fetch(ctx context.Context, date string) (*Response, error) {
+	path := filepath.Join(b.dir, b.filename(date))
+
+	f, err := os.CreateTemp(b.dir, "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.Remove(f.Name())
+
+	var resp *Response
+
+	op := func() error {
+		if err := f.Truncate(0); err != nil {
+			return backoff.Permanent(err)
+		}
+
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return backoff.Permanent(err)
+		}
+
+		resp, err = b.download(ctx, date, f)
+		if err != nil && !isRateLimited(err) {
+			return backoff.Permanent(err)
+		}
+
+		return err
+	}
+
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxElapsedTime = reportBackfillMaxRetryTime
+
+	err = backoff.Retry(op, backoff.WithContext(policy, ctx))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return resp, err
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return resp, fmt.Errorf("saving report for %s: %w", date, err)
+	}
+
+	return resp, nil
+}
+
+func isRateLimited(err error) bool {
+	var errResp *ErrorResponse
+
+	return errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,157 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newReportBackfillServer(handler func(date string) routeResponse) (*Client, *routedServer) {
+	respond := func(req capturedRequest) routeResponse {
+		return handler(req.Query.Get("filter[reportDate]"))
+	}
+
+	return newRoutedServer(map[string]route{
+		"GET /v1/salesReports":   respond,
+		"GET /v1/financeReports": respond,
+	})
+}
+
+func TestBackfillSalesAndTrendsReports(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu          sync.Mutex
+		rateLimited bool
+	)
+
+	client, server := newReportBackfillServer(func(date string) routeResponse {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch date {
+		case "2020-01-01":
+			t.Error("requested a report that was already downloaded")
+		case "2020-01-02":
+			return routeResponse{Status: http.StatusNotFound, Body: reportNotAvailableJSON}
+		case "2020-01-03":
+			if !rateLimited {
+				rateLimited = true
+
+				return routeResponse{Status: http.StatusTooManyRequests, Body: `{"errors":[{"status":"429"}]}`}
+			}
+		}
+
+		return routeResponse{Body: gzipString(date).String()}
+	})
+	defer server.Close()
+
+	dir := t.TempDir()
+	opts := &SalesReportBackfillOptions{
+		VendorNumber:  "85000000",
+		ReportType:    "SALES",
+		ReportSubType: "SUMMARY",
+		Frequency:     ReportFrequencyDaily,
+		Start:         day(2020, 1, 1),
+		End:           day(2020, 1, 4),
+		Dir:           dir,
+		Concurrency:   2,
+	}
+
+	assert.Equal(t, "SALES_SUMMARY_DAILY_85000000_2020-01-01.txt.gz", opts.Filename("2020-01-01"))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, opts.Filename("2020-01-01")), nil, 0o600))
+
+	got, err := client.Reporting.BackfillSalesAndTrendsReports(context.Background(), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, &ReportBackfillResult{
+		Downloaded:  []string{"2020-01-03", "2020-01-04"},
+		Skipped:     []string{"2020-01-01"},
+		Unavailable: []string{"2020-01-02"},
+		Failed:      map[string]error{},
+	}, got)
+
+	contents, err := os.ReadFile(filepath.Join(dir, opts.Filename("2020-01-03")))
+	assert.NoError(t, err)
+	assert.Equal(t, gzipString("2020-01-03").Bytes(), contents)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+
+	_, err = client.Reporting.BackfillSalesAndTrendsReports(context.Background(), &SalesReportBackfillOptions{Frequency: "HOURLY"})
+	assert.Equal(t, ErrInvalidReportFrequency, err)
+}
+
+func TestBackfillFinanceReports(t *testing.T) {
+	t.Parallel()
+
+	client, server := newReportBackfillServer(func(date string) routeResponse {
+		return routeResponse{
+			Header: http.Header{"X-Rate-Limit": []string{"user-hour-lim:3600;user-hour-rem:0;"}},
+			Body:   gzipString(date).String(),
+		}
+	})
+	defer server.Close()
+
+	dir := t.TempDir()
+	opts := &FinanceReportBackfillOptions{
+		VendorNumber: "85000000",
+		ReportType:   "FINANCIAL",
+		RegionCode:   "ZZ",
+		Start:        day(2022, 9, 1),
+		End:          day(2022, 11, 15),
+		Dir:          dir,
+		Concurrency:  1,
+	}
+
+	got, err := client.Reporting.BackfillFinanceReports(context.Background(), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2022-09"}, got.Downloaded)
+	assert.Equal(t, map[string]error{
+		"2022-10": ErrRateLimitExhausted,
+		"2022-11": ErrRateLimitExhausted,
+	}, got.Failed)
+
+	_, err = os.Stat(filepath.Join(dir, "FINANCIAL_ZZ_85000000_2022-09.txt.gz"))
+	assert.NoError(t, err)
+}
+
+func TestBackfillReportsCanceled(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.Reporting.BackfillFinanceReports(ctx, &FinanceReportBackfillOptions{
+		Start: day(2022, 9, 1),
+		End:   day(2022, 11, 15),
+		Dir:   t.TempDir(),
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
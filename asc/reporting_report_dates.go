@@ -0,0 +1,188 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidReportFrequency happens when report dates are requested for a frequency that is not
+// one of the ReportFrequency constants.
+var ErrInvalidReportFrequency = errors.New("report frequency must be one of DAILY, WEEKLY, MONTHLY or YEARLY")
+
+// ReportFrequency defines the frequencies sales and trends reports are generated at.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_sales_and_trends_reports
+type ReportFrequency string
+
+const (
+	// ReportFrequencyDaily is a report frequency for Daily.
+	ReportFrequencyDaily ReportFrequency = "DAILY"
+	// ReportFrequencyWeekly is a report frequency for Weekly.
+	ReportFrequencyWeekly ReportFrequency = "WEEKLY"
+	// ReportFrequencyMonthly is a report frequency for Monthly.
+	ReportFrequencyMonthly ReportFrequency = "MONTHLY"
+	// ReportFrequencyYearly is a report frequency for Yearly.
+	ReportFrequencyYearly ReportFrequency = "YEARLY"
+)
+
+// SalesReportDates returns the report dates, formatted for DownloadSalesAndTrendsReportsQuery.FilterReportDate,
+// of every report of the given frequency covering a day between start and end, inclusive. Weekly
+// reports are dated by the Sunday that ends their week.
+func SalesReportDates(frequency ReportFrequency, start, end time.Time) ([]string, error) {
+	start, end = truncateToDay(start), truncateToDay(end)
+
+	var (
+		dates  []string
+		layout string
+		next   func(time.Time) time.Time
+	)
+
+	switch frequency {
+	case ReportFrequencyDaily:
+		layout = dateFormat
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case ReportFrequencyWeekly:
+		layout = dateFormat
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+		start = start.AddDate(0, 0, (7-int(start.Weekday()))%7)
+		end = end.AddDate(0, 0, (7-int(end.Weekday()))%7)
+	case ReportFrequencyMonthly:
+		layout = "2006-01"
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+		start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case ReportFrequencyYearly:
+		layout = "2006"
+		next = func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }
+		start = time.Date(start.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return nil, ErrInvalidReportFrequency
+	}
+
+	for t := start; !t.After(end); t = next(t) {
+		dates = append(dates, t.Format(layout))
+	}
+
+	return dates, nil
+}
+
+// FinanceReportDates returns the report dates, formatted for DownloadFinanceReportsQuery.FilterReportDate,
+// of every fiscal month that contains a day between start and end, inclusive.
+func FinanceReportDates(start, end time.Time) []string {
+	var dates []string
+
+	end = truncateToDay(end)
+
+	for m := FiscalMonthOf(start); !m.Start.After(end); m = m.Next() {
+		dates = append(dates, m.ReportDate())
+	}
+
+	return dates
+}
+
+// FiscalMonth is a month of Apple's fiscal calendar, which finance reports are based on. The fiscal
+// year ends on the last Saturday of September, and each quarter is split into months of 5, 4 and 4
+// weeks starting on Sundays. In 53-week years the extra week is added to December, the last month of
+// the first quarter.
+//
+// https://developer.apple.com/help/app-store-connect/reference/apple-fiscal-calendar
+type FiscalMonth struct {
+	// Year is the calendar year of the month the fiscal month is named after.
+	Year  int
+	Month time.Month
+	// Start is the first day of the fiscal month, a Sunday.
+	Start time.Time
+	// End is the last day of the fiscal month, a Saturday.
+	End time.Time
+}
+
+// FiscalMonthOf returns the fiscal month that contains the day of t.
+func FiscalMonthOf(t time.Time) FiscalMonth {
+	day := truncateToDay(t)
+
+	fiscalYear := day.Year()
+	if !day.Before(fiscalYearStart(fiscalYear + 1)) {
+		fiscalYear++
+	}
+
+	for _, m := range fiscalMonths(fiscalYear) {
+		if !day.After(m.End) {
+			return m
+		}
+	}
+
+	return FiscalMonth{}
+}
+
+// Next returns the fiscal month following m.
+func (m FiscalMonth) Next() FiscalMonth {
+	return FiscalMonthOf(m.End.AddDate(0, 0, 1))
+}
+
+// ReportDate formats the fiscal month for DownloadFinanceReportsQuery.FilterReportDate.
+func (m FiscalMonth) ReportDate() string {
+	return time.Date(m.Year, m.Month, 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+}
+
+// fiscalMonths returns the twelve months of the given fiscal year, from October to September.
+func fiscalMonths(fiscalYear int) []FiscalMonth {
+	start := fiscalYearStart(fiscalYear)
+	weeksInYear := int(fiscalYearStart(fiscalYear+1).Sub(start).Hours()) / 24 / 7
+
+	months := make([]FiscalMonth, 0, 12)
+
+	for i := 0; i < 12; i++ {
+		weeks := 4
+		if i%3 == 0 || (i == 2 && weeksInYear == 53) {
+			weeks = 5
+		}
+
+		end := start.AddDate(0, 0, weeks*7-1)
+		named := time.Date(fiscalYear-1, time.October+time.Month(i), 1, 0, 0, 0, 0, time.UTC)
+
+		months = append(months, FiscalMonth{
+			Year:  named.Year(),
+			Month: named.Month(),
+			Start: start,
+			End:   end,
+		})
+
+		start = end.AddDate(0, 0, 1)
+	}
+
+	return months
+}
+
+// fiscalYearStart returns the first day of the given fiscal year, the Sunday after the last Saturday
+// of September of the previous calendar year.
+func fiscalYearStart(fiscalYear int) time.Time {
+	d := time.Date(fiscalYear-1, time.September, 30, 0, 0, 0, 0, time.UTC)
+	for d.Weekday() != time.Saturday {
+		d = d.AddDate(0, 0, -1)
+	}
+
+	return d.AddDate(0, 0, 1)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,84 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSalesReportDates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		frequency  ReportFrequency
+		start, end time.Time
+		want       []string
+	}{
+		{ReportFrequencyDaily, day(2020, 1, 30), day(2020, 2, 2), []string{"2020-01-30", "2020-01-31", "2020-02-01", "2020-02-02"}},
+		{ReportFrequencyWeekly, day(2024, 1, 3), day(2024, 1, 15), []string{"2024-01-07", "2024-01-14", "2024-01-21"}},
+		{ReportFrequencyWeekly, day(2024, 1, 7), day(2024, 1, 7), []string{"2024-01-07"}},
+		{ReportFrequencyMonthly, day(2023, 11, 15), day(2024, 2, 1), []string{"2023-11", "2023-12", "2024-01", "2024-02"}},
+		{ReportFrequencyYearly, day(2022, 6, 1), day(2024, 1, 1), []string{"2022", "2023", "2024"}},
+		{ReportFrequencyDaily, day(2020, 2, 2), day(2020, 1, 30), nil},
+	}
+
+	for _, test := range tests {
+		got, err := SalesReportDates(test.frequency, test.start, test.end)
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, got, test.frequency)
+	}
+
+	_, err := SalesReportDates("HOURLY", day(2020, 1, 1), day(2020, 1, 2))
+	assert.Equal(t, ErrInvalidReportFrequency, err)
+}
+
+func TestFiscalMonthOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, FiscalMonth{Year: 2022, Month: time.September, Start: day(2022, 8, 28), End: day(2022, 9, 24)}, FiscalMonthOf(day(2022, 9, 24)))
+	assert.Equal(t, FiscalMonth{Year: 2022, Month: time.October, Start: day(2022, 9, 25), End: day(2022, 10, 29)}, FiscalMonthOf(day(2022, 9, 25)))
+	assert.Equal(t, FiscalMonth{Year: 2022, Month: time.November, Start: day(2022, 10, 30), End: day(2022, 11, 26)}, FiscalMonthOf(day(2022, 11, 1)))
+	// FY2023 has 53 weeks, so December is five weeks long.
+	assert.Equal(t, FiscalMonth{Year: 2022, Month: time.December, Start: day(2022, 11, 27), End: day(2022, 12, 31)}, FiscalMonthOf(day(2022, 12, 31)))
+	assert.Equal(t, FiscalMonth{Year: 2023, Month: time.January, Start: day(2023, 1, 1), End: day(2023, 2, 4)}, FiscalMonthOf(day(2023, 2, 4)))
+	assert.Equal(t, FiscalMonth{Year: 2023, Month: time.December, Start: day(2023, 12, 3), End: day(2023, 12, 30)}, FiscalMonthOf(day(2023, 12, 30)))
+	assert.Equal(t, FiscalMonth{Year: 2024, Month: time.January, Start: day(2023, 12, 31), End: day(2024, 2, 3)}, FiscalMonthOf(time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC)))
+	assert.Equal(t, FiscalMonth{Year: 2024, Month: time.September, Start: day(2024, 9, 1), End: day(2024, 9, 28)}, FiscalMonthOf(day(2024, 9, 28)))
+
+	m := FiscalMonthOf(day(2023, 9, 30))
+	assert.Equal(t, "2023-09", m.ReportDate())
+	assert.Equal(t, "2023-10", m.Next().ReportDate())
+}
+
+func TestFinanceReportDates(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"2022-09", "2022-10", "2022-11"}, FinanceReportDates(day(2022, 9, 1), day(2022, 11, 15)))
+	assert.Equal(t, []string{"2023-12", "2024-01"}, FinanceReportDates(day(2023, 12, 30), day(2023, 12, 31)))
+	assert.Nil(t, FinanceReportDates(day(2023, 12, 31), day(2023, 12, 1)))
+}
@@ -0,0 +1,308 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidReportRow happens when a report row is decoded into something other than a non-nil pointer to a struct.
+var ErrInvalidReportRow = errors.New("report rows must be decoded into a non-nil pointer to a struct")
+
+// ErrUnknownReportColumn occurs when a report contains a column that the row type it is being
+// decoded into does not define, usually because Apple introduced a new report version.
+type ErrUnknownReportColumn struct {
+	Column  string
+	RowType string
+}
+
+func (e ErrUnknownReportColumn) Error() string {
+	return fmt.Sprintf("report: column %q is not defined by %s", e.Column, e.RowType)
+}
+
+// ErrInvalidReportValue occurs when a cell of a report cannot be parsed into the type of its row field.
+type ErrInvalidReportValue struct {
+	Line   int
+	Column string
+	Value  string
+	Err    error
+}
+
+func (e ErrInvalidReportValue) Error() string {
+	return fmt.Sprintf("report: line %d: invalid value %q for column %q: %v", e.Line, e.Value, e.Column, e.Err)
+}
+
+// Unwrap returns the underlying parse error.
+func (e ErrInvalidReportValue) Unwrap() error {
+	return e.Err
+}
+
+// byteOrderMark is stripped from the first column name of reports saved by spreadsheet applications.
+const byteOrderMark = "\ufeff"
+
+var reportDateFormats = []string{"01/02/2006", dateFormat, "01/02/06"}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// reportReader reads the tab-separated records of a report and maps them onto row structs
+// using the `tsv` struct tag of each field.
+type reportReader struct {
+	src    io.Reader
+	rc     io.ReadCloser
+	csv    *csv.Reader
+	line   int
+	header []string
+	fields map[reflect.Type][]int
+}
+
+func newReportReader(r io.Reader) *reportReader {
+	return &reportReader{
+		src:    r,
+		fields: make(map[reflect.Type][]int),
+	}
+}
+
+// next returns the next record of the report, decompressing the report on first use.
+func (r *reportReader) next() ([]string, error) {
+	if r.csv == nil {
+		rc, err := newDecompressingReader(r.src)
+		if err != nil {
+			return nil, err
+		}
+
+		r.rc = rc
+		r.csv = csv.NewReader(rc)
+		r.csv.Comma = '\t'
+		r.csv.LazyQuotes = true
+		r.csv.FieldsPerRecord = -1
+	}
+
+	record, err := r.csv.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			r.close()
+		}
+
+		return nil, err
+	}
+
+	r.line++
+
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	return record, nil
+}
+
+func (r *reportReader) close() {
+	if r.rc != nil {
+		r.rc.Close()
+	}
+}
+
+// setHeader sets the column names used to decode the records that follow.
+func (r *reportReader) setHeader(header []string) {
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], byteOrderMark)
+	}
+
+	r.header = header
+	r.fields = make(map[reflect.Type][]int)
+}
+
+// decode sets the fields of v, a pointer to a row struct, from the cells of record.
+func (r *reportReader) decode(record []string, v interface{}) error {
+	if err := checkReportRow(v); err != nil {
+		return err
+	}
+
+	row := reflect.ValueOf(v).Elem()
+
+	fields, err := r.fieldsFor(row.Type())
+	if err != nil {
+		return err
+	}
+
+	for i, value := range record {
+		if i >= len(fields) || fields[i] < 0 || value == "" {
+			continue
+		}
+
+		if err := setReportField(row.Field(fields[i]), value); err != nil {
+			return ErrInvalidReportValue{
+				Line:   r.line,
+				Column: r.header[i],
+				Value:  value,
+				Err:    err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkReportRow makes sure v can be decoded into before any record is consumed.
+func checkReportRow(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidReportRow
+	}
+
+	return nil
+}
+
+// fieldsFor returns the index of the field of t each column of the header maps to.
+func (r *reportReader) fieldsFor(t reflect.Type) ([]int, error) {
+	if fields, ok := r.fields[t]; ok {
+		return fields, nil
+	}
+
+	byColumn := reportColumnIndex(t)
+
+	fields := make([]int, len(r.header))
+
+	for i, column := range r.header {
+		if column == "" {
+			fields[i] = -1
+
+			continue
+		}
+
+		index, ok := byColumn[strings.ToLower(column)]
+		if !ok {
+			return nil, ErrUnknownReportColumn{Column: column, RowType: t.Name()}
+		}
+
+		fields[i] = index
+	}
+
+	r.fields[t] = fields
+
+	return fields, nil
+}
+
+// reportColumnIndex maps the lowercased column names of the `tsv` tags of t to their field index.
+// A tag may list several names separated by "|" for columns Apple has named differently across
+// report types or versions.
+func reportColumnIndex(t reflect.Type) map[string]int {
+	byColumn := make(map[string]int)
+
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("tsv")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		for _, column := range strings.Split(tag, "|") {
+			byColumn[strings.ToLower(column)] = i
+		}
+	}
+
+	return byColumn
+}
+
+func setReportField(field reflect.Value, value string) error {
+	if _, ok := field.Interface().(Date); ok {
+		t, err := parseReportDate(value)
+		if err != nil {
+			return err
+		}
+
+		field.Set(reflect.ValueOf(Date{t}))
+
+		return nil
+	}
+
+	if _, ok := field.Interface().(Decimal); ok {
+		d, err := ParseDecimal(strings.ReplaceAll(value, ",", ""))
+		if err != nil {
+			return err
+		}
+
+		field.Set(reflect.ValueOf(d))
+
+		return nil
+	}
+
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		u, _ := field.Addr().Interface().(encoding.TextUnmarshaler)
+
+		return u.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(strings.ReplaceAll(value, ",", ""), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(n)
+	case reflect.Bool:
+		b, err := parseReportBool(value)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
+
+func parseReportDate(value string) (time.Time, error) {
+	var err error
+
+	for _, layout := range reportDateFormats {
+		var t time.Time
+
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+func parseReportBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "y", "true", "1":
+		return true, nil
+	case "no", "n", "false", "0":
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
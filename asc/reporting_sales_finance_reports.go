@@ -23,9 +23,51 @@ package asc
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
 )
 
+// ErrReportNotAvailable occurs when Apple has no report for the requested date, either because it
+// has not been generated yet or because there was no activity to report. It is only returned for a 404
+// that carries Apple's NOT_FOUND error code; any other error is returned unchanged. Err holds the
+// original response from the API.
+type ErrReportNotAvailable struct {
+	Err *ErrorResponse
+}
+
+func (e ErrReportNotAvailable) Error() string {
+	return fmt.Sprintf("report not available: %v", e.Err)
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (e ErrReportNotAvailable) Unwrap() error {
+	return e.Err
+}
+
+// ReportBody is the decompressed body of a report download. It must be closed by the caller.
+type ReportBody struct {
+	io.Reader
+	// ContentLength is the size in bytes of the report as sent by Apple, before decompression,
+	// or -1 if it is unknown.
+	ContentLength int64
+
+	body         io.Closer
+	decompressor io.Closer
+}
+
+// Close closes the report and the underlying response body.
+func (b *ReportBody) Close() error {
+	err := b.decompressor.Close()
+
+	if bodyErr := b.body.Close(); bodyErr != nil {
+		return bodyErr
+	}
+
+	return err
+}
+
 // DownloadFinanceReportsQuery are query options for DownloadFinanceReports
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/download_finance_reports
@@ -55,7 +97,7 @@ func (s *ReportingService) DownloadFinanceReports(ctx context.Context, params *D
 	buffer := new(bytes.Buffer)
 	resp, err := s.client.get(ctx, "financeReports", params, buffer, withAccept("application/a-gzip"))
 
-	return buffer, resp, err
+	return buffer, resp, reportError(err)
 }
 
 // DownloadSalesAndTrendsReports downloads sales and trends reports filtered by your specified criteria.
@@ -65,5 +107,90 @@ func (s *ReportingService) DownloadSalesAndTrendsReports(ctx context.Context, pa
 	buffer := new(bytes.Buffer)
 	resp, err := s.client.get(ctx, "salesReports", params, buffer, withAccept("application/a-gzip"))
 
-	return buffer, resp, err
+	return buffer, resp, reportError(err)
+}
+
+// StreamFinanceReports downloads finance reports filtered by your specified criteria and copies the
+// gzip-compressed report to w as it arrives, without buffering it in memory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_finance_reports
+func (s *ReportingService) StreamFinanceReports(ctx context.Context, params *DownloadFinanceReportsQuery, w io.Writer) (*Response, error) {
+	return s.streamReport(ctx, "financeReports", params, w)
+}
+
+// StreamSalesAndTrendsReports downloads sales and trends reports filtered by your specified criteria and
+// copies the gzip-compressed report to w as it arrives, without buffering it in memory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_sales_and_trends_reports
+func (s *ReportingService) StreamSalesAndTrendsReports(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery, w io.Writer) (*Response, error) {
+	return s.streamReport(ctx, "salesReports", params, w)
+}
+
+// OpenFinanceReports downloads finance reports filtered by your specified criteria and returns the
+// decompressed report to be read as it arrives. The caller must close the returned ReportBody.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_finance_reports
+func (s *ReportingService) OpenFinanceReports(ctx context.Context, params *DownloadFinanceReportsQuery) (*ReportBody, *Response, error) {
+	return s.openReport(ctx, "financeReports", params)
+}
+
+// OpenSalesAndTrendsReports downloads sales and trends reports filtered by your specified criteria and
+// returns the decompressed report to be read as it arrives. The caller must close the returned ReportBody.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_sales_and_trends_reports
+func (s *ReportingService) OpenSalesAndTrendsReports(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery) (*ReportBody, *Response, error) {
+	return s.openReport(ctx, "salesReports", params)
+}
+
+func (s *ReportingService) streamReport(ctx context.Context, url string, params interface{}, w io.Writer) (*Response, error) {
+	resp, err := s.client.stream(ctx, url, params, withAccept("application/a-gzip"))
+	if err != nil {
+		return resp, reportError(err)
+	}
+
+	defer closeDesc(resp.Body)
+
+	_, err = io.Copy(w, resp.Body)
+
+	return resp, err
+}
+
+func (s *ReportingService) openReport(ctx context.Context, url string, params interface{}) (*ReportBody, *Response, error) {
+	resp, err := s.client.stream(ctx, url, params, withAccept("application/a-gzip"))
+	if err != nil {
+		return nil, resp, reportError(err)
+	}
+
+	rc, err := newDecompressingReader(resp.Body)
+	if err != nil {
+		closeDesc(resp.Body)
+
+		return nil, resp, err
+	}
+
+	return &ReportBody{
+		Reader:        rc,
+		ContentLength: resp.ContentLength,
+		body:          resp.Body,
+		decompressor:  rc,
+	}, resp, nil
+}
+
+// reportNotFoundCode is the error code Apple sends with a 404 when there is no report for the requested date.
+const reportNotFoundCode = "NOT_FOUND"
+
+// reportError maps the 404 Apple returns when there is no report for the requested date to ErrReportNotAvailable.
+func reportError(err error) error {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusNotFound {
+		return err
+	}
+
+	for _, e := range errResp.Errors {
+		if e.Code == reportNotFoundCode {
+			return ErrReportNotAvailable{Err: errResp}
+		}
+	}
+
+	return err
 }
@@ -21,10 +21,18 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
+	"net/http"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
+const reportNotAvailableJSON = `{"errors":[{"status":"404","code":"NOT_FOUND","title":"The requested resource does not exist","detail":"There were no sales for the date specified."}]}`
+
 func TestDownloadFinanceReports(t *testing.T) {
 	t.Parallel()
 
@@ -40,3 +48,115 @@ func TestDownloadSalesAndTrendsReports(t *testing.T) {
 		return client.Reporting.DownloadSalesAndTrendsReports(ctx, &DownloadSalesAndTrendsReportsQuery{})
 	})
 }
+
+func TestDownloadSalesAndTrendsReportsNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(reportNotAvailableJSON, http.StatusNotFound, false)
+	defer server.Close()
+
+	_, _, err := client.Reporting.DownloadSalesAndTrendsReports(context.Background(), &DownloadSalesAndTrendsReportsQuery{})
+
+	var notAvailable ErrReportNotAvailable
+
+	assert.True(t, errors.As(err, &notAvailable))
+	assert.Contains(t, err.Error(), "no sales")
+
+	var errResp *ErrorResponse
+
+	assert.True(t, errors.As(err, &errResp))
+	assert.Equal(t, http.StatusNotFound, errResp.Response.StatusCode)
+}
+
+func TestDownloadFinanceReportsError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"400","code":"PARAMETER_ERROR.INVALID"}]}`, http.StatusBadRequest, false)
+	defer server.Close()
+
+	_, _, err := client.Reporting.DownloadFinanceReports(context.Background(), &DownloadFinanceReportsQuery{})
+
+	var notAvailable ErrReportNotAvailable
+
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &notAvailable))
+}
+
+func TestDownloadSalesAndTrendsReportsNotFoundWithoutReportCode(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"404","code":"PATH_ERROR","title":"The URL path is not valid"}]}`, http.StatusNotFound, false)
+	defer server.Close()
+
+	_, _, err := client.Reporting.DownloadSalesAndTrendsReports(context.Background(), &DownloadSalesAndTrendsReportsQuery{})
+
+	var notAvailable ErrReportNotAvailable
+
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &notAvailable))
+
+	var errResp *ErrorResponse
+
+	assert.True(t, errors.As(err, &errResp))
+	assert.Equal(t, http.StatusNotFound, errResp.Response.StatusCode)
+}
+
+func TestStreamFinanceReports(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("ahhhhhhh", http.StatusOK, false)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	resp, err := client.Reporting.StreamFinanceReports(context.Background(), &DownloadFinanceReportsQuery{}, &buf)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "ahhhhhhh\n", buf.String())
+}
+
+func TestStreamSalesAndTrendsReportsNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(reportNotAvailableJSON, http.StatusNotFound, false)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := client.Reporting.StreamSalesAndTrendsReports(context.Background(), &DownloadSalesAndTrendsReportsQuery{}, &buf)
+
+	assert.True(t, errors.As(err, &ErrReportNotAvailable{}))
+	assert.Empty(t, buf.Bytes())
+}
+
+func TestOpenSalesAndTrendsReports(t *testing.T) {
+	t.Parallel()
+
+	compressed := gzipString(salesReportTSV).Bytes()
+	client, server := newRoutedServer(map[string]route{
+		"GET /v1/salesReports": respondWith(http.StatusOK, string(compressed)),
+	})
+	defer server.Close()
+
+	body, resp, err := client.Reporting.OpenSalesAndTrendsReports(context.Background(), &DownloadSalesAndTrendsReportsQuery{})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "application/a-gzip", server.requests("GET /v1/salesReports")[0].Header.Get("Accept"))
+	assert.Equal(t, int64(len(compressed)), body.ContentLength)
+
+	got, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, salesReportTSV, string(got))
+	assert.NoError(t, body.Close())
+}
+
+func TestOpenFinanceReportsNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(reportNotAvailableJSON, http.StatusNotFound, false)
+	defer server.Close()
+
+	body, _, err := client.Reporting.OpenFinanceReports(context.Background(), &DownloadFinanceReportsQuery{})
+
+	assert.Nil(t, body)
+	assert.True(t, errors.As(err, &ErrReportNotAvailable{}))
+}
@@ -0,0 +1,270 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"io"
+)
+
+// SalesReportRow defines a row of a SALES SUMMARY report, in any of its published versions.
+type SalesReportRow struct {
+	Provider              string  `tsv:"Provider"`
+	ProviderCountry       string  `tsv:"Provider Country"`
+	SKU                   string  `tsv:"SKU"`
+	Developer             string  `tsv:"Developer"`
+	Title                 string  `tsv:"Title"`
+	Version               string  `tsv:"Version"`
+	ProductTypeIdentifier string  `tsv:"Product Type Identifier"`
+	Units                 int     `tsv:"Units"`
+	DeveloperProceeds     Decimal `tsv:"Developer Proceeds"`
+	BeginDate             Date    `tsv:"Begin Date"`
+	EndDate               Date    `tsv:"End Date"`
+	CustomerCurrency      string  `tsv:"Customer Currency"`
+	CountryCode           string  `tsv:"Country Code"`
+	CurrencyOfProceeds    string  `tsv:"Currency of Proceeds"`
+	AppleIdentifier       string  `tsv:"Apple Identifier"`
+	CustomerPrice         Decimal `tsv:"Customer Price"`
+	PromoCode             string  `tsv:"Promo Code"`
+	ParentIdentifier      string  `tsv:"Parent Identifier"`
+	Subscription          string  `tsv:"Subscription"`
+	Period                string  `tsv:"Period"`
+	Category              string  `tsv:"Category"`
+	CMB                   string  `tsv:"CMB"`
+	Device                string  `tsv:"Device"`
+	SupportedPlatforms    string  `tsv:"Supported Platforms"`
+	ProceedsReason        string  `tsv:"Proceeds Reason"`
+	PreservedPricing      string  `tsv:"Preserved Pricing"`
+	Client                string  `tsv:"Client"`
+	OrderType             string  `tsv:"Order Type"`
+}
+
+// SubscriptionReportRow defines a row of a SUBSCRIPTION SUMMARY report, in any of its published versions.
+type SubscriptionReportRow struct {
+	AppName                                        string  `tsv:"App Name"`
+	AppAppleID                                     string  `tsv:"App Apple ID"`
+	SubscriptionName                               string  `tsv:"Subscription Name"`
+	SubscriptionAppleID                            string  `tsv:"Subscription Apple ID"`
+	SubscriptionGroupID                            string  `tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration                   string  `tsv:"Standard Subscription Duration"`
+	SubscriptionOfferName                          string  `tsv:"Subscription Offer Name"`
+	PromotionalOfferName                           string  `tsv:"Promotional Offer Name"`
+	PromotionalOfferID                             string  `tsv:"Promotional Offer ID"`
+	CustomerPrice                                  Decimal `tsv:"Customer Price"`
+	CustomerCurrency                               string  `tsv:"Customer Currency"`
+	DeveloperProceeds                              Decimal `tsv:"Developer Proceeds"`
+	ProceedsCurrency                               string  `tsv:"Proceeds Currency"`
+	PreservedPricing                               string  `tsv:"Preserved Pricing"`
+	ProceedsReason                                 string  `tsv:"Proceeds Reason"`
+	Client                                         string  `tsv:"Client"`
+	Device                                         string  `tsv:"Device"`
+	State                                          string  `tsv:"State"`
+	Country                                        string  `tsv:"Country"`
+	ActiveStandardPriceSubscriptions               int     `tsv:"Active Standard Price Subscriptions"`
+	ActiveFreeTrialIntroductoryOfferSubscriptions  int     `tsv:"Active Free Trial Introductory Offer Subscriptions"`
+	ActivePayUpFrontIntroductoryOfferSubscriptions int     `tsv:"Active Pay Up Front Introductory Offer Subscriptions"`
+	ActivePayAsYouGoIntroductoryOfferSubscriptions int     `tsv:"Active Pay As You Go Introductory Offer Subscriptions"`
+	FreeTrialPromotionalOfferSubscriptions         int     `tsv:"Free Trial Promotional Offer Subscriptions"`
+	PayUpFrontPromotionalOfferSubscriptions        int     `tsv:"Pay Up Front Promotional Offer Subscriptions"`
+	PayAsYouGoPromotionalOfferSubscriptions        int     `tsv:"Pay As You Go Promotional Offer Subscriptions"`
+	FreeTrialOfferCodeSubscriptions                int     `tsv:"Free Trial Offer Code Subscriptions"`
+	PayUpFrontOfferCodeSubscriptions               int     `tsv:"Pay Up Front Offer Code Subscriptions"`
+	PayAsYouGoOfferCodeSubscriptions               int     `tsv:"Pay As You Go Offer Code Subscriptions"`
+	MarketingOptIns                                int     `tsv:"Marketing Opt-Ins"`
+	BillingRetry                                   int     `tsv:"Billing Retry"`
+	GracePeriod                                    int     `tsv:"Grace Period"`
+	Subscribers                                    int     `tsv:"Subscribers"`
+}
+
+// SubscriptionEventReportRow defines a row of a SUBSCRIPTION_EVENT SUMMARY report, in any of its published versions.
+type SubscriptionEventReportRow struct {
+	EventDate                    Date   `tsv:"Event Date"`
+	Event                        string `tsv:"Event"`
+	AppName                      string `tsv:"App Name"`
+	AppAppleID                   string `tsv:"App Apple ID"`
+	SubscriptionName             string `tsv:"Subscription Name"`
+	SubscriptionAppleID          string `tsv:"Subscription Apple ID"`
+	SubscriptionGroupID          string `tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration string `tsv:"Standard Subscription Duration"`
+	SubscriptionOfferType        string `tsv:"Subscription Offer Type"`
+	SubscriptionOfferDuration    string `tsv:"Subscription Offer Duration"`
+	SubscriptionOfferName        string `tsv:"Subscription Offer Name"`
+	MarketingOptIn               string `tsv:"Marketing Opt-In"`
+	MarketingOptInDuration       string `tsv:"Marketing Opt-In Duration"`
+	PreservedPricing             string `tsv:"Preserved Pricing"`
+	ProceedsReason               string `tsv:"Proceeds Reason"`
+	PromotionalOfferName         string `tsv:"Promotional Offer Name"`
+	PromotionalOfferID           string `tsv:"Promotional Offer ID"`
+	ConsecutivePaidPeriods       int    `tsv:"Consecutive Paid Periods"`
+	OriginalStartDate            Date   `tsv:"Original Start Date"`
+	Device                       string `tsv:"Device"`
+	Client                       string `tsv:"Client"`
+	State                        string `tsv:"State"`
+	Country                      string `tsv:"Country"`
+	PreviousSubscriptionName     string `tsv:"Previous Subscription Name"`
+	PreviousSubscriptionAppleID  string `tsv:"Previous Subscription Apple ID"`
+	DaysBeforeCanceling          int    `tsv:"Days Before Canceling"`
+	CancellationReason           string `tsv:"Cancellation Reason"`
+	DaysCanceled                 int    `tsv:"Days Canceled"`
+	Quantity                     int    `tsv:"Quantity"`
+	PaidServiceDaysRecovered     int    `tsv:"Paid Service Days Recovered"`
+}
+
+// SubscriberReportRow defines a row of a SUBSCRIBER DETAILED report, in any of its published versions.
+type SubscriberReportRow struct {
+	EventDate                    Date    `tsv:"Event Date"`
+	AppName                      string  `tsv:"App Name"`
+	AppAppleID                   string  `tsv:"App Apple ID"`
+	SubscriptionName             string  `tsv:"Subscription Name"`
+	SubscriptionAppleID          string  `tsv:"Subscription Apple ID"`
+	SubscriptionGroupID          string  `tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration string  `tsv:"Standard Subscription Duration"`
+	SubscriptionOfferType        string  `tsv:"Subscription Offer Type"`
+	SubscriptionOfferDuration    string  `tsv:"Subscription Offer Duration"`
+	SubscriptionOfferName        string  `tsv:"Subscription Offer Name"`
+	PromotionalOfferName         string  `tsv:"Promotional Offer Name"`
+	PromotionalOfferID           string  `tsv:"Promotional Offer ID"`
+	MarketingOptInDuration       string  `tsv:"Marketing Opt-In Duration"`
+	CustomerPrice                Decimal `tsv:"Customer Price"`
+	CustomerCurrency             string  `tsv:"Customer Currency"`
+	DeveloperProceeds            Decimal `tsv:"Developer Proceeds"`
+	ProceedsCurrency             string  `tsv:"Proceeds Currency"`
+	PreservedPricing             string  `tsv:"Preserved Pricing"`
+	ProceedsReason               string  `tsv:"Proceeds Reason"`
+	Client                       string  `tsv:"Client"`
+	Device                       string  `tsv:"Device"`
+	Country                      string  `tsv:"Country"`
+	SubscriberID                 string  `tsv:"Subscriber ID"`
+	SubscriberIDReset            string  `tsv:"Subscriber ID Reset"`
+	Refund                       string  `tsv:"Refund"`
+	PurchaseDate                 Date    `tsv:"Purchase Date"`
+	Units                        int     `tsv:"Units"`
+}
+
+// NewsstandReportRow defines a row of a NEWSSTAND DETAILED report.
+type NewsstandReportRow struct {
+	Provider              string  `tsv:"Provider"`
+	ProviderCountry       string  `tsv:"Provider Country"`
+	SKU                   string  `tsv:"SKU"`
+	Developer             string  `tsv:"Developer"`
+	Title                 string  `tsv:"Title"`
+	Version               string  `tsv:"Version"`
+	ProductTypeIdentifier string  `tsv:"Product Type Identifier"`
+	Units                 int     `tsv:"Units"`
+	DeveloperProceeds     Decimal `tsv:"Developer Proceeds"`
+	CustomerCurrency      string  `tsv:"Customer Currency"`
+	CountryCode           string  `tsv:"Country Code"`
+	CurrencyOfProceeds    string  `tsv:"Currency of Proceeds"`
+	AppleIdentifier       string  `tsv:"Apple Identifier"`
+	CustomerPrice         Decimal `tsv:"Customer Price"`
+	PromoCode             string  `tsv:"Promo Code"`
+	ParentIdentifier      string  `tsv:"Parent Identifier"`
+	Subscription          string  `tsv:"Subscription"`
+	Period                string  `tsv:"Period"`
+	DownloadDate          string  `tsv:"Download Date (PST)"`
+	CustomerIdentifier    string  `tsv:"Customer Identifier"`
+	ReportDate            Date    `tsv:"Report Date (Local)"`
+	SalesOrReturn         string  `tsv:"Sales/Return"`
+	Category              string  `tsv:"Category"`
+}
+
+// PreOrderReportRow defines a row of a PRE_ORDER SUMMARY report.
+type PreOrderReportRow struct {
+	Provider           string `tsv:"Provider"`
+	ProviderCountry    string `tsv:"Provider Country"`
+	Title              string `tsv:"Title"`
+	SKU                string `tsv:"SKU"`
+	Developer          string `tsv:"Developer"`
+	PreOrderStartDate  Date   `tsv:"Pre-Order Start Date"`
+	PreOrderEndDate    Date   `tsv:"Pre-Order End Date"`
+	Ordered            int    `tsv:"Ordered"`
+	Canceled           int    `tsv:"Canceled"`
+	CumulativeOrdered  int    `tsv:"Cumulative Ordered"`
+	CumulativeCanceled int    `tsv:"Cumulative Canceled"`
+	StartDate          Date   `tsv:"Start Date"`
+	EndDate            Date   `tsv:"End Date"`
+	CountryCode        string `tsv:"Country Code"`
+	AppleIdentifier    string `tsv:"Apple Identifier"`
+	Device             string `tsv:"Device"`
+	SupportedPlatforms string `tsv:"Supported Platforms"`
+	Category           string `tsv:"Category"`
+	Client             string `tsv:"Client"`
+}
+
+// SalesReportDecoder reads typed rows from a Sales and Trends report as returned by
+// DownloadSalesAndTrendsReports. Compressed and decompressed reports are both accepted.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_sales_and_trends_reports
+type SalesReportDecoder struct {
+	r   *reportReader
+	err error
+}
+
+// NewSalesReportDecoder returns a decoder that reads a Sales and Trends report from r.
+func NewSalesReportDecoder(r io.Reader) *SalesReportDecoder {
+	return &SalesReportDecoder{r: newReportReader(r)}
+}
+
+// Columns returns the column names of the report, reading its header if it has not been read yet.
+func (d *SalesReportDecoder) Columns() ([]string, error) {
+	if err := d.readHeader(); err != nil {
+		return nil, err
+	}
+
+	return d.r.header, nil
+}
+
+// Decode reads the next row of the report into row, which must be a pointer to one of the report row
+// types such as *SalesReportRow, or to another struct whose fields are tagged with `tsv:"<column>"`.
+// It returns io.EOF when there are no more rows, and ErrUnknownReportColumn if the report contains a
+// column the row type does not define.
+func (d *SalesReportDecoder) Decode(row interface{}) error {
+	if err := checkReportRow(row); err != nil {
+		return err
+	}
+
+	if err := d.readHeader(); err != nil {
+		return err
+	}
+
+	record, err := d.r.next()
+	if err != nil {
+		return err
+	}
+
+	return d.r.decode(record, row)
+}
+
+func (d *SalesReportDecoder) readHeader() error {
+	if d.r.header != nil || d.err != nil {
+		return d.err
+	}
+
+	header, err := d.r.next()
+	if err != nil {
+		d.err = err
+
+		return err
+	}
+
+	d.r.setHeader(header)
+
+	return nil
+}
@@ -0,0 +1,199 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const salesReportTSV = "Provider\tProvider Country\tSKU\tDeveloper\tTitle\tVersion\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCustomer Currency\tCountry Code\tCurrency of Proceeds\tApple Identifier\tCustomer Price\tPromo Code\tParent Identifier\tSubscription\tPeriod\tCategory\tCMB\tDevice\tSupported Platforms\tProceeds Reason\tPreserved Pricing\tClient\tOrder Type\n" +
+	"APPLE\tUS\tcom.example.app\tExample\tExample App\t1.0\t1F\t3\t0.70\t01/01/2024\t01/01/2024\tUSD\tUS\tUSD\t123456789\t0.99\t\t\t\t\tGames\t\tiPhone\tiOS\t\t\t\t\n" +
+	"APPLE\tUS\tcom.example.iap\tExample\tCoins\t\tIA1\t-1\t-6.99\t01/01/2024\t01/01/2024\tEUR\tDE\tEUR\t987654321\t-9.99\t\tcom.example.app\t\t\tGames\t\tiPad\tiOS\t\t\t\t\n"
+
+func gzipString(s string) *bytes.Buffer {
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write([]byte(s))
+	_ = zw.Close()
+
+	return &buf
+}
+
+func TestSalesReportDecoder(t *testing.T) {
+	t.Parallel()
+
+	dec := NewSalesReportDecoder(gzipString(salesReportTSV))
+
+	columns, err := dec.Columns()
+	assert.NoError(t, err)
+	assert.Len(t, columns, 28)
+
+	var rows []SalesReportRow
+
+	for {
+		var row SalesReportRow
+
+		err := dec.Decode(&row)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.NoError(t, err)
+
+		rows = append(rows, row)
+	}
+
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "com.example.app", rows[0].SKU)
+	assert.Equal(t, 3, rows[0].Units)
+	assert.Equal(t, "0.70", rows[0].DeveloperProceeds.String())
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), rows[0].BeginDate.Time)
+	assert.Equal(t, "Games", rows[0].Category)
+	assert.Equal(t, -1, rows[1].Units)
+	assert.Equal(t, "-9.99", rows[1].CustomerPrice.String())
+	assert.Equal(t, "com.example.app", rows[1].ParentIdentifier)
+}
+
+func TestSalesReportDecoderUncompressed(t *testing.T) {
+	t.Parallel()
+
+	dec := NewSalesReportDecoder(strings.NewReader("Provider\tUnits\nAPPLE\t4\n"))
+
+	var row SalesReportRow
+
+	assert.NoError(t, dec.Decode(&row))
+	assert.Equal(t, "APPLE", row.Provider)
+	assert.Equal(t, 4, row.Units)
+	assert.ErrorIs(t, dec.Decode(&row), io.EOF)
+}
+
+func TestSalesReportDecoderRowTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		report string
+		row    interface{}
+	}{
+		{"App Name\tSubscribers\tDeveloper Proceeds\nExample\t12\t4.99\n", &SubscriptionReportRow{AppName: "Example", Subscribers: 12, DeveloperProceeds: mustParseDecimal("4.99")}},
+		{"Event Date\tEvent\tQuantity\n2024-01-02\tRenew\t2\n", &SubscriptionEventReportRow{EventDate: Date{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}, Event: "Renew", Quantity: 2}},
+		{"Subscriber ID\tUnits\tRefund\n42\t1\tYes\n", &SubscriberReportRow{SubscriberID: "42", Units: 1, Refund: "Yes"}},
+		{"Title\tSales/Return\nDaily\tS\n", &NewsstandReportRow{Title: "Daily", SalesOrReturn: "S"}},
+		{"Title\tOrdered\tCanceled\nGame\t10\t1\n", &PreOrderReportRow{Title: "Game", Ordered: 10, Canceled: 1}},
+	}
+
+	for _, test := range tests {
+		dec := NewSalesReportDecoder(strings.NewReader(test.report))
+		got := newZeroLike(test.row)
+
+		assert.NoError(t, dec.Decode(got))
+		assert.Equal(t, test.row, got)
+	}
+}
+
+func TestSalesReportDecoderUnknownColumn(t *testing.T) {
+	t.Parallel()
+
+	dec := NewSalesReportDecoder(strings.NewReader("Provider\tNew Column\nAPPLE\tx\n"))
+
+	var row SalesReportRow
+
+	err := dec.Decode(&row)
+	assert.Equal(t, ErrUnknownReportColumn{Column: "New Column", RowType: "SalesReportRow"}, err)
+	assert.Contains(t, err.Error(), "New Column")
+}
+
+func TestSalesReportDecoderInvalidValue(t *testing.T) {
+	t.Parallel()
+
+	dec := NewSalesReportDecoder(strings.NewReader("Provider\tUnits\nAPPLE\tmany\n"))
+
+	var row SalesReportRow
+
+	err := dec.Decode(&row)
+
+	var invalid ErrInvalidReportValue
+
+	assert.True(t, errors.As(err, &invalid))
+	assert.Equal(t, 2, invalid.Line)
+	assert.Equal(t, "Units", invalid.Column)
+	assert.Equal(t, "many", invalid.Value)
+	assert.NotEmpty(t, invalid.Error())
+	assert.Error(t, errors.Unwrap(invalid))
+}
+
+func TestSalesReportDecoderInvalidRow(t *testing.T) {
+	t.Parallel()
+
+	dec := NewSalesReportDecoder(strings.NewReader("Provider\nAPPLE\n"))
+
+	var row SalesReportRow
+
+	assert.ErrorIs(t, dec.Decode(row), ErrInvalidReportRow)
+	assert.ErrorIs(t, dec.Decode((*SalesReportRow)(nil)), ErrInvalidReportRow)
+}
+
+func TestSalesReportDecoderEmpty(t *testing.T) {
+	t.Parallel()
+
+	dec := NewSalesReportDecoder(strings.NewReader(""))
+
+	_, err := dec.Columns()
+	assert.ErrorIs(t, err, io.EOF)
+
+	var row SalesReportRow
+
+	assert.ErrorIs(t, dec.Decode(&row), io.EOF)
+}
+
+func mustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func newZeroLike(v interface{}) interface{} {
+	switch v.(type) {
+	case *SubscriptionReportRow:
+		return &SubscriptionReportRow{}
+	case *SubscriptionEventReportRow:
+		return &SubscriptionEventReportRow{}
+	case *SubscriberReportRow:
+		return &SubscriberReportRow{}
+	case *NewsstandReportRow:
+		return &NewsstandReportRow{}
+	case *PreOrderReportRow:
+		return &PreOrderReportRow{}
+	}
+
+	return nil
+}
@@ -0,0 +1,332 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+const xcodeMetricsMediaType = "application/vnd.apple.xcode-metrics+json"
+
+// MetricCategory defines the categories of power and performance metrics.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/metriccategory
+type MetricCategory string
+
+const (
+	// MetricCategoryAnimation is a metric category for Animation.
+	MetricCategoryAnimation MetricCategory = "ANIMATION"
+	// MetricCategoryBattery is a metric category for Battery.
+	MetricCategoryBattery MetricCategory = "BATTERY"
+	// MetricCategoryDisk is a metric category for Disk.
+	MetricCategoryDisk MetricCategory = "DISK"
+	// MetricCategoryHang is a metric category for Hang.
+	MetricCategoryHang MetricCategory = "HANG"
+	// MetricCategoryLaunch is a metric category for Launch.
+	MetricCategoryLaunch MetricCategory = "LAUNCH"
+	// MetricCategoryMemory is a metric category for Memory.
+	MetricCategoryMemory MetricCategory = "MEMORY"
+	// MetricCategoryTermination is a metric category for Termination.
+	MetricCategoryTermination MetricCategory = "TERMINATION"
+)
+
+// XcodeMetricPercentile defines the percentiles a metric dataset can be filtered to.
+type XcodeMetricPercentile string
+
+const (
+	// XcodeMetricPercentileFifty is a metric percentile for the 50th percentile, the typical experience.
+	XcodeMetricPercentileFifty XcodeMetricPercentile = "percentile.fifty"
+	// XcodeMetricPercentileNinety is a metric percentile for the 90th percentile, the worst experiences.
+	XcodeMetricPercentileNinety XcodeMetricPercentile = "percentile.ninety"
+)
+
+// XcodeMetrics defines model for the power and performance metrics payload returned with the
+// application/vnd.apple.xcode-metrics+json media type.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics
+type XcodeMetrics struct {
+	Insights    *XcodeMetricsInsights     `json:"insights,omitempty"`
+	ProductData []XcodeMetricsProductData `json:"productData,omitempty"`
+	Version     *string                   `json:"version,omitempty"`
+}
+
+// XcodeMetricsInsights defines model for XcodeMetrics.Insights
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/insights
+type XcodeMetricsInsights struct {
+	Regressions []MetricsInsight `json:"regressions,omitempty"`
+	TrendingUp  []MetricsInsight `json:"trendingUp,omitempty"`
+}
+
+// MetricsInsight defines model for MetricsInsight.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/metricsinsight
+type MetricsInsight struct {
+	HighImpact            *bool                      `json:"highImpact,omitempty"`
+	LatestVersion         *string                    `json:"latestVersion,omitempty"`
+	MaxLatestVersionValue *float64                   `json:"maxLatestVersionValue,omitempty"`
+	Metric                *string                    `json:"metric,omitempty"`
+	MetricCategory        *MetricCategory            `json:"metricCategory,omitempty"`
+	Populations           []MetricsInsightPopulation `json:"populations,omitempty"`
+	ReferenceVersions     *string                    `json:"referenceVersions,omitempty"`
+	SubSystemLabel        *string                    `json:"subSystemLabel,omitempty"`
+	SummaryString         *string                    `json:"summaryString,omitempty"`
+}
+
+// MetricsInsightPopulation defines model for MetricsInsight.Populations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/metricsinsight/populations
+type MetricsInsightPopulation struct {
+	DeltaPercentage       *float64 `json:"deltaPercentage,omitempty"`
+	Device                *string  `json:"device,omitempty"`
+	LatestVersionValue    *float64 `json:"latestVersionValue,omitempty"`
+	Percentile            *string  `json:"percentile,omitempty"`
+	ReferenceAverageValue *float64 `json:"referenceAverageValue,omitempty"`
+	SummaryString         *string  `json:"summaryString,omitempty"`
+}
+
+// XcodeMetricsProductData defines model for XcodeMetrics.ProductData
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata
+type XcodeMetricsProductData struct {
+	MetricCategories []XcodeMetricsCategory `json:"metricCategories,omitempty"`
+	Platform         *string                `json:"platform,omitempty"`
+}
+
+// XcodeMetricsCategory defines model for XcodeMetrics.ProductData.MetricCategories
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories
+type XcodeMetricsCategory struct {
+	Identifier *MetricCategory `json:"identifier,omitempty"`
+	Metrics    []XcodeMetric   `json:"metrics,omitempty"`
+}
+
+// XcodeMetric defines model for XcodeMetrics.ProductData.MetricCategories.Metrics
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics
+type XcodeMetric struct {
+	Datasets   []XcodeMetricDataset `json:"datasets,omitempty"`
+	GoalKeys   []XcodeMetricGoalKey `json:"goalKeys,omitempty"`
+	Identifier *string              `json:"identifier,omitempty"`
+	Unit       *XcodeMetricUnit     `json:"unit,omitempty"`
+}
+
+// XcodeMetricGoalKey defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.GoalKeys
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/goalkeys
+type XcodeMetricGoalKey struct {
+	GoalKey    *string  `json:"goalKey,omitempty"`
+	LowerBound *float64 `json:"lowerBound,omitempty"`
+	UpperBound *float64 `json:"upperBound,omitempty"`
+}
+
+// XcodeMetricUnit defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.Unit
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/unit
+type XcodeMetricUnit struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Identifier  *string `json:"identifier,omitempty"`
+}
+
+// XcodeMetricDataset defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.Datasets
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/datasets
+type XcodeMetricDataset struct {
+	FilterCriteria        *XcodeMetricFilterCriteria  `json:"filterCriteria,omitempty"`
+	Points                []XcodeMetricPoint          `json:"points,omitempty"`
+	RecommendedMetricGoal *XcodeMetricRecommendedGoal `json:"recommendedMetricGoal,omitempty"`
+}
+
+// XcodeMetricFilterCriteria defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.Datasets.FilterCriteria
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/datasets/filtercriteria
+type XcodeMetricFilterCriteria struct {
+	Device              *string                `json:"device,omitempty"`
+	DeviceMarketingName *string                `json:"deviceMarketingName,omitempty"`
+	Percentile          *XcodeMetricPercentile `json:"percentile,omitempty"`
+}
+
+// XcodeMetricPoint defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.Datasets.Points
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/datasets/points
+type XcodeMetricPoint struct {
+	ErrorMargin         *float64                        `json:"errorMargin,omitempty"`
+	Goal                *string                         `json:"goal,omitempty"`
+	PercentageBreakdown *XcodeMetricPercentageBreakdown `json:"percentageBreakdown,omitempty"`
+	Value               *float64                        `json:"value,omitempty"`
+	Version             *string                         `json:"version,omitempty"`
+}
+
+// XcodeMetricPercentageBreakdown defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.Datasets.Points.PercentageBreakdown
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/datasets/points/percentagebreakdown
+type XcodeMetricPercentageBreakdown struct {
+	SubSystemLabel *string  `json:"subSystemLabel,omitempty"`
+	Value          *float64 `json:"value,omitempty"`
+}
+
+// XcodeMetricRecommendedGoal defines model for XcodeMetrics.ProductData.MetricCategories.Metrics.Datasets.RecommendedMetricGoal
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/datasets/recommendedmetricgoal
+type XcodeMetricRecommendedGoal struct {
+	Detail *string  `json:"detail,omitempty"`
+	Value  *float64 `json:"value,omitempty"`
+}
+
+// XcodeMetricComparison compares the value of a metric between two versions of an app for one
+// platform, device and percentile.
+type XcodeMetricComparison struct {
+	Platform       string
+	Category       MetricCategory
+	Metric         string
+	Unit           string
+	Device         string
+	Percentile     XcodeMetricPercentile
+	BaseVersion    string
+	BaseValue      float64
+	CompareVersion string
+	CompareValue   float64
+}
+
+// Delta returns the change of the metric from the base version to the compared version, in the unit
+// of the metric.
+func (c XcodeMetricComparison) Delta() float64 {
+	return c.CompareValue - c.BaseValue
+}
+
+// DeltaPercentage returns the change of the metric from the base version to the compared version, as
+// a percentage of the base value. It returns 0 if the base value is 0.
+func (c XcodeMetricComparison) DeltaPercentage() float64 {
+	if c.BaseValue == 0 {
+		return 0
+	}
+
+	return c.Delta() / c.BaseValue * 100
+}
+
+// IsRegression reports whether the value of the metric increased by more than threshold percent between
+// the base and compared versions. It only means a regression for metrics that are worse when higher.
+func (c XcodeMetricComparison) IsRegression(threshold float64) bool {
+	return c.DeltaPercentage() > threshold
+}
+
+// Point returns the data point of the dataset for the given app version, or nil if there is none.
+func (d *XcodeMetricDataset) Point(version string) *XcodeMetricPoint {
+	for i, point := range d.Points {
+		if point.Version != nil && *point.Version == version {
+			return &d.Points[i]
+		}
+	}
+
+	return nil
+}
+
+// CompareVersions compares the metric with the given identifier, such as "launchTime", between the
+// base and compared app versions. One comparison is returned for every platform, device and percentile
+// that has a value for both versions. If percentile is empty, every percentile is compared.
+func (m *XcodeMetrics) CompareVersions(metric string, percentile XcodeMetricPercentile, baseVersion, compareVersion string) []XcodeMetricComparison {
+	var comparisons []XcodeMetricComparison
+
+	for _, product := range m.ProductData {
+		for _, category := range product.MetricCategories {
+			for _, candidate := range category.Metrics {
+				if candidate.Identifier == nil || *candidate.Identifier != metric {
+					continue
+				}
+
+				for i := range candidate.Datasets {
+					dataset := &candidate.Datasets[i]
+
+					criteria := XcodeMetricFilterCriteria{}
+					if dataset.FilterCriteria != nil {
+						criteria = *dataset.FilterCriteria
+					}
+
+					if percentile != "" && (criteria.Percentile == nil || *criteria.Percentile != percentile) {
+						continue
+					}
+
+					base, compare := dataset.Point(baseVersion), dataset.Point(compareVersion)
+					if base == nil || base.Value == nil || compare == nil || compare.Value == nil {
+						continue
+					}
+
+					comparison := XcodeMetricComparison{
+						Metric:         metric,
+						BaseVersion:    baseVersion,
+						BaseValue:      *base.Value,
+						CompareVersion: compareVersion,
+						CompareValue:   *compare.Value,
+					}
+
+					if product.Platform != nil {
+						comparison.Platform = *product.Platform
+					}
+
+					if category.Identifier != nil {
+						comparison.Category = *category.Identifier
+					}
+
+					if candidate.Unit != nil && candidate.Unit.Identifier != nil {
+						comparison.Unit = *candidate.Unit.Identifier
+					}
+
+					if criteria.Device != nil {
+						comparison.Device = *criteria.Device
+					}
+
+					if criteria.Percentile != nil {
+						comparison.Percentile = *criteria.Percentile
+					}
+
+					comparisons = append(comparisons, comparison)
+				}
+			}
+		}
+	}
+
+	return comparisons
+}
+
+// GetXcodeMetricsForApp gets the power and performance metrics data, with the datasets and insights Xcode
+// displays in its Organizer, for the most recent versions of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_power_and_performance_metrics_for_an_app
+func (s *ReportingService) GetXcodeMetricsForApp(ctx context.Context, id string, params *GetPerfPowerMetricsQuery) (*XcodeMetrics, *Response, error) {
+	url := fmt.Sprintf("apps/%s/perfPowerMetrics", id)
+	res := new(XcodeMetrics)
+	resp, err := s.client.get(ctx, url, params, res, withAccept(xcodeMetricsMediaType))
+
+	return res, resp, err
+}
+
+// GetXcodeMetricsForBuild gets the power and performance metrics data, with the datasets and insights Xcode
+// displays in its Organizer, for a specific build.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_power_and_performance_metrics_for_a_build
+func (s *ReportingService) GetXcodeMetricsForBuild(ctx context.Context, id string, params *GetPerfPowerMetricsQuery) (*XcodeMetrics, *Response, error) {
+	url := fmt.Sprintf("builds/%s/perfPowerMetrics", id)
+	res := new(XcodeMetrics)
+	resp, err := s.client.get(ctx, url, params, res, withAccept(xcodeMetricsMediaType))
+
+	return res, resp, err
+}
@@ -0,0 +1,138 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const xcodeMetricsJSON = `{
+	"version": "1.0",
+	"insights": {
+		"regressions": [{
+			"metricCategory": "LAUNCH",
+			"latestVersion": "1.1",
+			"metric": "launchTime",
+			"summaryString": "Launch time regressed",
+			"referenceVersions": "1.0",
+			"highImpact": true,
+			"populations": [{"deltaPercentage": 25, "percentile": "percentile.fifty", "device": "all_iphones"}]
+		}]
+	},
+	"productData": [{
+		"platform": "IOS",
+		"metricCategories": [{
+			"identifier": "LAUNCH",
+			"metrics": [{
+				"identifier": "launchTime",
+				"unit": {"identifier": "ms", "displayName": "Milliseconds"},
+				"goalKeys": [{"goalKey": "good", "lowerBound": 0, "upperBound": 400}],
+				"datasets": [{
+					"filterCriteria": {"percentile": "percentile.fifty", "device": "all_iphones", "deviceMarketingName": "All iPhones"},
+					"points": [
+						{"version": "1.0", "value": 400, "errorMargin": 5},
+						{"version": "1.1", "value": 500, "errorMargin": 5, "percentageBreakdown": {"value": 10, "subSystemLabel": "Other"}}
+					],
+					"recommendedMetricGoal": {"value": 400, "detail": "Typical"}
+				}, {
+					"filterCriteria": {"percentile": "percentile.ninety", "device": "all_iphones"},
+					"points": [
+						{"version": "1.0", "value": 900},
+						{"version": "1.1", "value": 850}
+					]
+				}, {
+					"filterCriteria": {"percentile": "percentile.fifty", "device": "iPhone14,2"},
+					"points": [{"version": "1.1", "value": 450}]
+				}]
+			}]
+		}, {
+			"identifier": "MEMORY",
+			"metrics": [{"identifier": "peakMemory", "datasets": []}]
+		}]
+	}]
+}`
+
+func TestGetXcodeMetricsForApp(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(xcodeMetricsJSON, http.StatusOK, true)
+	defer server.Close()
+
+	got, resp, err := client.Reporting.GetXcodeMetricsForApp(context.Background(), "10", &GetPerfPowerMetricsQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, "application/vnd.apple.xcode-metrics+json", resp.Request.Header.Get("Accept"))
+
+	var want XcodeMetrics
+
+	assert.NoError(t, json.Unmarshal([]byte(xcodeMetricsJSON), &want))
+	assert.Equal(t, &want, got)
+	assert.Equal(t, MetricCategoryLaunch, *got.Insights.Regressions[0].MetricCategory)
+	assert.Equal(t, "Other", *got.ProductData[0].MetricCategories[0].Metrics[0].Datasets[0].Points[1].PercentageBreakdown.SubSystemLabel)
+}
+
+func TestGetXcodeMetricsForBuild(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &XcodeMetrics{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetXcodeMetricsForBuild(ctx, "10", &GetPerfPowerMetricsQuery{})
+	})
+}
+
+func TestXcodeMetricsCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	var metrics XcodeMetrics
+
+	assert.NoError(t, json.Unmarshal([]byte(xcodeMetricsJSON), &metrics))
+
+	got := metrics.CompareVersions("launchTime", XcodeMetricPercentileFifty, "1.0", "1.1")
+	assert.Equal(t, []XcodeMetricComparison{
+		{
+			Platform:       "IOS",
+			Category:       MetricCategoryLaunch,
+			Metric:         "launchTime",
+			Unit:           "ms",
+			Device:         "all_iphones",
+			Percentile:     XcodeMetricPercentileFifty,
+			BaseVersion:    "1.0",
+			BaseValue:      400,
+			CompareVersion: "1.1",
+			CompareValue:   500,
+		},
+	}, got)
+	assert.InDelta(t, 100, got[0].Delta(), 0)
+	assert.InDelta(t, 25, got[0].DeltaPercentage(), 0)
+	assert.True(t, got[0].IsRegression(10))
+	assert.False(t, got[0].IsRegression(30))
+
+	got = metrics.CompareVersions("launchTime", "", "1.0", "1.1")
+	assert.Len(t, got, 2)
+	assert.False(t, got[1].IsRegression(0))
+
+	assert.Empty(t, metrics.CompareVersions("peakMemory", "", "1.0", "1.1"))
+	assert.Empty(t, metrics.CompareVersions("launchTime", "", "1.0", "2.0"))
+	assert.Zero(t, XcodeMetricComparison{CompareValue: 1}.DeltaPercentage())
+}
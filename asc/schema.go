@@ -23,7 +23,9 @@ package asc
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"regexp"
+	"strings"
 	"time"
 )
 
@@ -34,7 +36,8 @@ const (
 )
 
 var (
-	emailRegex = regexp.MustCompile(emailRegexString)
+	emailRegex   = regexp.MustCompile(emailRegexString)
+	decimalRegex = regexp.MustCompile(`^[-+]?(\d+\.?\d*|\.\d+)$`)
 )
 
 // ErrInvalidEmail occurs when the value does not conform to the library author's understanding of
@@ -47,6 +50,15 @@ func (e ErrInvalidEmail) Error() string {
 	return fmt.Sprintf("email: %s failed to pass regex validation", e.Value)
 }
 
+// ErrInvalidDecimal occurs when the value is not a plain decimal number and cannot be parsed as a Decimal.
+type ErrInvalidDecimal struct {
+	Value string
+}
+
+func (e ErrInvalidDecimal) Error() string {
+	return fmt.Sprintf("decimal: %s is not a valid decimal number", e.Value)
+}
+
 // Date represents a date with no time component.
 type Date struct {
 	time.Time
@@ -76,6 +88,11 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// today returns the current date in UTC, the time zone time-less dates are parsed in.
+func today() Date {
+	return Date{time.Now().UTC().Truncate(24 * time.Hour)}
+}
+
 // DateTime represents a date with an ISO8601-like date-time.
 type DateTime struct {
 	time.Time
@@ -137,6 +154,91 @@ func (e *Email) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Decimal is an exact decimal number, used for monetary amounts where float64 would lose precision.
+// The zero value is 0.
+type Decimal struct {
+	rat   *big.Rat
+	scale int
+}
+
+// ParseDecimal parses a plain decimal string such as "-12.50". The number of digits after the decimal
+// point is preserved when formatting the value back into a string.
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalRegex.MatchString(s) {
+		return Decimal{}, ErrInvalidDecimal{Value: s}
+	}
+
+	rat, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, ErrInvalidDecimal{Value: s}
+	}
+
+	scale := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = len(s) - i - 1
+	}
+
+	return Decimal{rat: rat, scale: scale}, nil
+}
+
+// Rat returns the value of the decimal as a new big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+
+	return new(big.Rat).Set(d.rat)
+}
+
+// Add returns the sum of d and o, keeping the larger scale of the two.
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := d.scale
+	if o.scale > scale {
+		scale = o.scale
+	}
+
+	return Decimal{rat: new(big.Rat).Add(d.Rat(), o.Rat()), scale: scale}
+}
+
+// Cmp compares d and o and returns -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	return d.Rat().Cmp(o.Rat())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.Rat().Sign()
+}
+
+// Float64 returns the nearest float64 value for d.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+
+	return f
+}
+
+// String formats the decimal with the scale it was parsed with.
+func (d Decimal) String() string {
+	return d.Rat().FloatString(d.scale)
+}
+
+// MarshalText formats the decimal as text.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the decimal from text.
+func (d *Decimal) UnmarshalText(data []byte) error {
+	v, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = v
+
+	return nil
+}
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool {
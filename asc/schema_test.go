@@ -210,6 +210,58 @@ func TestEmailUnmarshalInvalidEmail(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestParseDecimal(t *testing.T) {
+	t.Parallel()
+
+	d, err := ParseDecimal("-12.50")
+	assert.NoError(t, err)
+	assert.Equal(t, "-12.50", d.String())
+	assert.Equal(t, -1, d.Sign())
+	assert.InDelta(t, -12.5, d.Float64(), 0)
+
+	d, err = ParseDecimal("3")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", d.String())
+
+	for _, invalid := range []string{"", "abc", "1/3", "1e5", "1.2.3"} {
+		_, err = ParseDecimal(invalid)
+		assert.Equal(t, ErrInvalidDecimal{Value: invalid}, err)
+		assert.NotEmpty(t, err.Error())
+	}
+}
+
+func TestDecimalAdd(t *testing.T) {
+	t.Parallel()
+
+	var sum Decimal
+
+	assert.Equal(t, "0", sum.String())
+
+	for _, s := range []string{"0.1", "0.2", "1.005"} {
+		d, err := ParseDecimal(s)
+		assert.NoError(t, err)
+
+		sum = sum.Add(d)
+	}
+
+	want, _ := ParseDecimal("1.305")
+	assert.Equal(t, "1.305", sum.String())
+	assert.Equal(t, 0, sum.Cmp(want))
+}
+
+func TestDecimalText(t *testing.T) {
+	t.Parallel()
+
+	var d Decimal
+
+	assert.NoError(t, d.UnmarshalText([]byte("0.70")))
+
+	got, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.70", string(got))
+	assert.Error(t, d.UnmarshalText([]byte("seventy")))
+}
+
 func TestBool(t *testing.T) {
 	t.Parallel()
 
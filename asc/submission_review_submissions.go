@@ -0,0 +1,512 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidReviewSubmissionItemContent happens when a review submission item is created with zero or more than one
+// of the resources in ReviewSubmissionItemContent set.
+var ErrInvalidReviewSubmissionItemContent = errors.New("review submission item content must identify exactly one resource")
+
+// ReviewSubmissionState defines model for ReviewSubmissionState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission/attributes
+type ReviewSubmissionState string
+
+const (
+	// ReviewSubmissionStateCanceling is a review submission state for Canceling.
+	ReviewSubmissionStateCanceling ReviewSubmissionState = "CANCELING"
+	// ReviewSubmissionStateComplete is a review submission state for Complete.
+	ReviewSubmissionStateComplete ReviewSubmissionState = "COMPLETE"
+	// ReviewSubmissionStateCompleting is a review submission state for Completing.
+	ReviewSubmissionStateCompleting ReviewSubmissionState = "COMPLETING"
+	// ReviewSubmissionStateInReview is a review submission state for InReview.
+	ReviewSubmissionStateInReview ReviewSubmissionState = "IN_REVIEW"
+	// ReviewSubmissionStateReadyForReview is a review submission state for ReadyForReview.
+	ReviewSubmissionStateReadyForReview ReviewSubmissionState = "READY_FOR_REVIEW"
+	// ReviewSubmissionStateUnresolvedIssues is a review submission state for UnresolvedIssues.
+	ReviewSubmissionStateUnresolvedIssues ReviewSubmissionState = "UNRESOLVED_ISSUES"
+	// ReviewSubmissionStateWaitingForReview is a review submission state for WaitingForReview.
+	ReviewSubmissionStateWaitingForReview ReviewSubmissionState = "WAITING_FOR_REVIEW"
+)
+
+// ReviewSubmissionItemState defines model for ReviewSubmissionItemState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem/attributes
+type ReviewSubmissionItemState string
+
+const (
+	// ReviewSubmissionItemStateAccepted is a review submission item state for Accepted.
+	ReviewSubmissionItemStateAccepted ReviewSubmissionItemState = "ACCEPTED"
+	// ReviewSubmissionItemStateApproved is a review submission item state for Approved.
+	ReviewSubmissionItemStateApproved ReviewSubmissionItemState = "APPROVED"
+	// ReviewSubmissionItemStateReadyForReview is a review submission item state for ReadyForReview.
+	ReviewSubmissionItemStateReadyForReview ReviewSubmissionItemState = "READY_FOR_REVIEW"
+	// ReviewSubmissionItemStateRejected is a review submission item state for Rejected.
+	ReviewSubmissionItemStateRejected ReviewSubmissionItemState = "REJECTED"
+	// ReviewSubmissionItemStateRemoved is a review submission item state for Removed.
+	ReviewSubmissionItemStateRemoved ReviewSubmissionItemState = "REMOVED"
+)
+
+// ReviewSubmission defines model for ReviewSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission
+type ReviewSubmission struct {
+	Attributes    *ReviewSubmissionAttributes    `json:"attributes,omitempty"`
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *ReviewSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// ReviewSubmissionAttributes defines model for ReviewSubmission.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission/attributes
+type ReviewSubmissionAttributes struct {
+	Platform      *Platform              `json:"platform,omitempty"`
+	State         *ReviewSubmissionState `json:"state,omitempty"`
+	SubmittedDate *DateTime              `json:"submittedDate,omitempty"`
+}
+
+// ReviewSubmissionRelationships defines model for ReviewSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission/relationships
+type ReviewSubmissionRelationships struct {
+	App                      *Relationship      `json:"app,omitempty"`
+	AppStoreVersionForReview *Relationship      `json:"appStoreVersionForReview,omitempty"`
+	Items                    *PagedRelationship `json:"items,omitempty"`
+	LastUpdatedByActor       *Relationship      `json:"lastUpdatedByActor,omitempty"`
+	SubmittedByActor         *Relationship      `json:"submittedByActor,omitempty"`
+}
+
+// reviewSubmissionCreateRequest defines model for ReviewSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissioncreaterequest/data
+type reviewSubmissionCreateRequest struct {
+	Attributes    reviewSubmissionCreateRequestAttributes    `json:"attributes"`
+	Relationships reviewSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// reviewSubmissionCreateRequestAttributes are attributes for ReviewSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissioncreaterequest/data/attributes
+type reviewSubmissionCreateRequestAttributes struct {
+	Platform Platform `json:"platform"`
+}
+
+// reviewSubmissionCreateRequestRelationships are relationships for ReviewSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissioncreaterequest/data/relationships
+type reviewSubmissionCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// reviewSubmissionUpdateRequest defines model for ReviewSubmissionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionupdaterequest/data
+type reviewSubmissionUpdateRequest struct {
+	Attributes *ReviewSubmissionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                   `json:"id"`
+	Type       string                                   `json:"type"`
+}
+
+// ReviewSubmissionUpdateRequestAttributes are attributes for ReviewSubmissionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionupdaterequest/data/attributes
+type ReviewSubmissionUpdateRequestAttributes struct {
+	Canceled  *bool     `json:"canceled,omitempty"`
+	Platform  *Platform `json:"platform,omitempty"`
+	Submitted *bool     `json:"submitted,omitempty"`
+}
+
+// ReviewSubmissionResponse defines model for ReviewSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionresponse
+type ReviewSubmissionResponse struct {
+	Data     ReviewSubmission                   `json:"data"`
+	Included []ReviewSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// ReviewSubmissionsResponse defines model for ReviewSubmissionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionsresponse
+type ReviewSubmissionsResponse struct {
+	Data     []ReviewSubmission                 `json:"data"`
+	Included []ReviewSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                 `json:"links"`
+	Meta     *PagingInformation                 `json:"meta,omitempty"`
+}
+
+// ReviewSubmissionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a ReviewSubmissionResponse or ReviewSubmissionsResponse.
+type ReviewSubmissionResponseIncluded included
+
+// ReviewSubmissionItem defines model for ReviewSubmissionItem.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem
+type ReviewSubmissionItem struct {
+	Attributes    *ReviewSubmissionItemAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *ReviewSubmissionItemRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// ReviewSubmissionItemAttributes defines model for ReviewSubmissionItem.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem/attributes
+type ReviewSubmissionItemAttributes struct {
+	State *ReviewSubmissionItemState `json:"state,omitempty"`
+}
+
+// ReviewSubmissionItemRelationships defines model for ReviewSubmissionItem.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem/relationships
+type ReviewSubmissionItemRelationships struct {
+	AppCustomProductPageVersion *Relationship `json:"appCustomProductPageVersion,omitempty"`
+	AppEvent                    *Relationship `json:"appEvent,omitempty"`
+	AppStoreVersion             *Relationship `json:"appStoreVersion,omitempty"`
+	AppStoreVersionExperiment   *Relationship `json:"appStoreVersionExperiment,omitempty"`
+	ReviewSubmission            *Relationship `json:"reviewSubmission,omitempty"`
+}
+
+// ReviewSubmissionItemContent identifies the resource a review submission item submits for review.
+// Exactly one of its fields must be set.
+type ReviewSubmissionItemContent struct {
+	AppCustomProductPageVersionID *string
+	AppEventID                    *string
+	AppStoreVersionID             *string
+	AppStoreVersionExperimentID   *string
+}
+
+// count returns how many of the resources in the content are set.
+func (c ReviewSubmissionItemContent) count() int {
+	count := 0
+
+	for _, id := range []*string{c.AppCustomProductPageVersionID, c.AppEventID, c.AppStoreVersionID, c.AppStoreVersionExperimentID} {
+		if id != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
+// reviewSubmissionItemCreateRequest defines model for ReviewSubmissionItemCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemcreaterequest/data
+type reviewSubmissionItemCreateRequest struct {
+	Relationships reviewSubmissionItemCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// reviewSubmissionItemCreateRequestRelationships are relationships for ReviewSubmissionItemCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemcreaterequest/data/relationships
+type reviewSubmissionItemCreateRequestRelationships struct {
+	AppCustomProductPageVersion *relationshipDeclaration `json:"appCustomProductPageVersion,omitempty"`
+	AppEvent                    *relationshipDeclaration `json:"appEvent,omitempty"`
+	AppStoreVersion             *relationshipDeclaration `json:"appStoreVersion,omitempty"`
+	AppStoreVersionExperiment   *relationshipDeclaration `json:"appStoreVersionExperiment,omitempty"`
+	ReviewSubmission            relationshipDeclaration  `json:"reviewSubmission"`
+}
+
+// reviewSubmissionItemUpdateRequest defines model for ReviewSubmissionItemUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemupdaterequest/data
+type reviewSubmissionItemUpdateRequest struct {
+	Attributes *ReviewSubmissionItemUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                       `json:"id"`
+	Type       string                                       `json:"type"`
+}
+
+// ReviewSubmissionItemUpdateRequestAttributes are attributes for ReviewSubmissionItemUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemupdaterequest/data/attributes
+type ReviewSubmissionItemUpdateRequestAttributes struct {
+	Removed  *bool `json:"removed,omitempty"`
+	Resolved *bool `json:"resolved,omitempty"`
+}
+
+// ReviewSubmissionItemResponse defines model for ReviewSubmissionItemResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemresponse
+type ReviewSubmissionItemResponse struct {
+	Data     ReviewSubmissionItem                   `json:"data"`
+	Included []ReviewSubmissionItemResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// ReviewSubmissionItemsResponse defines model for ReviewSubmissionItemsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemsresponse
+type ReviewSubmissionItemsResponse struct {
+	Data     []ReviewSubmissionItem                 `json:"data"`
+	Included []ReviewSubmissionItemResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                     `json:"links"`
+	Meta     *PagingInformation                     `json:"meta,omitempty"`
+}
+
+// ReviewSubmissionItemResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a ReviewSubmissionItemResponse or ReviewSubmissionItemsResponse.
+type ReviewSubmissionItemResponseIncluded included
+
+// ListReviewSubmissionsQuery are query options for ListReviewSubmissions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_review_submissions
+type ListReviewSubmissionsQuery struct {
+	FieldsApps                  []string `url:"fields[apps],omitempty"`
+	FieldsAppStoreVersions      []string `url:"fields[appStoreVersions],omitempty"`
+	FieldsReviewSubmissionItems []string `url:"fields[reviewSubmissionItems],omitempty"`
+	FieldsReviewSubmissions     []string `url:"fields[reviewSubmissions],omitempty"`
+	FilterApp                   []string `url:"filter[app],omitempty"`
+	FilterPlatform              []string `url:"filter[platform],omitempty"`
+	FilterState                 []string `url:"filter[state],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitItems                  int      `url:"limit[items],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetReviewSubmissionQuery are query options for GetReviewSubmission
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_review_submission_information
+type GetReviewSubmissionQuery struct {
+	FieldsApps                  []string `url:"fields[apps],omitempty"`
+	FieldsAppStoreVersions      []string `url:"fields[appStoreVersions],omitempty"`
+	FieldsReviewSubmissionItems []string `url:"fields[reviewSubmissionItems],omitempty"`
+	FieldsReviewSubmissions     []string `url:"fields[reviewSubmissions],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitItems                  int      `url:"limit[items],omitempty"`
+}
+
+// ListItemsForReviewSubmissionQuery are query options for ListItemsForReviewSubmission
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_the_items_in_a_review_submission
+type ListItemsForReviewSubmissionQuery struct {
+	FieldsAppStoreVersions      []string `url:"fields[appStoreVersions],omitempty"`
+	FieldsReviewSubmissionItems []string `url:"fields[reviewSubmissionItems],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// ListReviewSubmissions lists the review submissions for an app, the history of everything it has sent to
+// App Review. Set FilterApp in params to the ID of the app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_review_submissions
+func (s *SubmissionService) ListReviewSubmissions(ctx context.Context, params *ListReviewSubmissionsQuery) (*ReviewSubmissionsResponse, *Response, error) {
+	res := new(ReviewSubmissionsResponse)
+	resp, err := s.client.get(ctx, "reviewSubmissions", params, res)
+
+	return res, resp, err
+}
+
+// GetReviewSubmission gets a specific review submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_review_submission_information
+func (s *SubmissionService) GetReviewSubmission(ctx context.Context, id string, params *GetReviewSubmissionQuery) (*ReviewSubmissionResponse, *Response, error) {
+	url := fmt.Sprintf("reviewSubmissions/%s", id)
+	res := new(ReviewSubmissionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateReviewSubmission creates a review submission for an app on a platform, which items can then be
+// added to before it is submitted.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_review_submission
+func (s *SubmissionService) CreateReviewSubmission(ctx context.Context, platform Platform, appID string) (*ReviewSubmissionResponse, *Response, error) {
+	req := reviewSubmissionCreateRequest{
+		Attributes: reviewSubmissionCreateRequestAttributes{
+			Platform: platform,
+		},
+		Relationships: reviewSubmissionCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "reviewSubmissions",
+	}
+	res := new(ReviewSubmissionResponse)
+	resp, err := s.client.post(ctx, "reviewSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateReviewSubmission updates a review submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_review_submission
+func (s *SubmissionService) UpdateReviewSubmission(ctx context.Context, id string, attributes *ReviewSubmissionUpdateRequestAttributes) (*ReviewSubmissionResponse, *Response, error) {
+	req := reviewSubmissionUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "reviewSubmissions",
+	}
+	url := fmt.Sprintf("reviewSubmissions/%s", id)
+	res := new(ReviewSubmissionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// SubmitReviewSubmission submits a review submission and all of its items to App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_review_submission
+func (s *SubmissionService) SubmitReviewSubmission(ctx context.Context, id string) (*ReviewSubmissionResponse, *Response, error) {
+	return s.UpdateReviewSubmission(ctx, id, &ReviewSubmissionUpdateRequestAttributes{
+		Submitted: Bool(true),
+	})
+}
+
+// CancelReviewSubmission withdraws a submitted review submission from App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_review_submission
+func (s *SubmissionService) CancelReviewSubmission(ctx context.Context, id string) (*ReviewSubmissionResponse, *Response, error) {
+	return s.UpdateReviewSubmission(ctx, id, &ReviewSubmissionUpdateRequestAttributes{
+		Canceled: Bool(true),
+	})
+}
+
+// ListItemsForReviewSubmission lists the items in a review submission along with their review state.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_the_items_in_a_review_submission
+func (s *SubmissionService) ListItemsForReviewSubmission(ctx context.Context, id string, params *ListItemsForReviewSubmissionQuery) (*ReviewSubmissionItemsResponse, *Response, error) {
+	url := fmt.Sprintf("reviewSubmissions/%s/items", id)
+	res := new(ReviewSubmissionItemsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateReviewSubmissionItem adds an App Store version, in-app event, custom product page version or
+// product page optimization experiment to a review submission. Exactly one resource must be set in content.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_review_submission_item
+func (s *SubmissionService) CreateReviewSubmissionItem(ctx context.Context, reviewSubmissionID string, content ReviewSubmissionItemContent) (*ReviewSubmissionItemResponse, *Response, error) {
+	if content.count() != 1 {
+		return nil, nil, ErrInvalidReviewSubmissionItemContent
+	}
+
+	req := reviewSubmissionItemCreateRequest{
+		Relationships: reviewSubmissionItemCreateRequestRelationships{
+			AppCustomProductPageVersion: newRelationshipDeclaration(content.AppCustomProductPageVersionID, "appCustomProductPageVersions"),
+			AppEvent:                    newRelationshipDeclaration(content.AppEventID, "appEvents"),
+			AppStoreVersion:             newRelationshipDeclaration(content.AppStoreVersionID, "appStoreVersions"),
+			AppStoreVersionExperiment:   newRelationshipDeclaration(content.AppStoreVersionExperimentID, "appStoreVersionExperiments"),
+			ReviewSubmission:            *newRelationshipDeclaration(&reviewSubmissionID, "reviewSubmissions"),
+		},
+		Type: "reviewSubmissionItems",
+	}
+	res := new(ReviewSubmissionItemResponse)
+	resp, err := s.client.post(ctx, "reviewSubmissionItems", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateReviewSubmissionItem updates a review submission item, marking it removed from its submission or
+// its rejection resolved.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_review_submission_item
+func (s *SubmissionService) UpdateReviewSubmissionItem(ctx context.Context, id string, attributes *ReviewSubmissionItemUpdateRequestAttributes) (*ReviewSubmissionItemResponse, *Response, error) {
+	req := reviewSubmissionItemUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "reviewSubmissionItems",
+	}
+	url := fmt.Sprintf("reviewSubmissionItems/%s", id)
+	res := new(ReviewSubmissionItemResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteReviewSubmissionItem removes an item from a review submission that has not been submitted yet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_review_submission_item
+func (s *SubmissionService) DeleteReviewSubmissionItem(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("reviewSubmissionItems/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in ReviewSubmissionResponseIncluded.
+func (i *ReviewSubmissionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *ReviewSubmissionResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *ReviewSubmissionResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
+
+// ReviewSubmissionItem returns the ReviewSubmissionItem stored within, if one is present.
+func (i *ReviewSubmissionResponseIncluded) ReviewSubmissionItem() *ReviewSubmissionItem {
+	return extractIncludedReviewSubmissionItem(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in ReviewSubmissionItemResponseIncluded.
+func (i *ReviewSubmissionItemResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppCustomProductPageVersion returns the AppCustomProductPageVersion stored within, if one is present.
+func (i *ReviewSubmissionItemResponseIncluded) AppCustomProductPageVersion() *AppCustomProductPageVersion {
+	return extractIncludedAppCustomProductPageVersion(i.inner)
+}
+
+// AppEvent returns the AppEvent stored within, if one is present.
+func (i *ReviewSubmissionItemResponseIncluded) AppEvent() *AppEvent {
+	return extractIncludedAppEvent(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *ReviewSubmissionItemResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
+
+// AppStoreVersionExperiment returns the AppStoreVersionExperiment stored within, if one is present.
+func (i *ReviewSubmissionItemResponseIncluded) AppStoreVersionExperiment() *AppStoreVersionExperiment {
+	return extractIncludedAppStoreVersionExperiment(i.inner)
+}
+
+// ReviewSubmission returns the ReviewSubmission stored within, if one is present.
+func (i *ReviewSubmissionItemResponseIncluded) ReviewSubmission() *ReviewSubmission {
+	return extractIncludedReviewSubmission(i.inner)
+}
@@ -0,0 +1,363 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionsService handles communication with auto-renewable subscription-related methods of the App Store Connect API
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptions
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription_groups
+type SubscriptionsService service
+
+// SubscriptionPeriod defines model for SubscriptionPeriod.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/attributes
+type SubscriptionPeriod string
+
+const (
+	// SubscriptionPeriodOneWeek is a subscription period for OneWeek.
+	SubscriptionPeriodOneWeek SubscriptionPeriod = "ONE_WEEK"
+	// SubscriptionPeriodOneMonth is a subscription period for OneMonth.
+	SubscriptionPeriodOneMonth SubscriptionPeriod = "ONE_MONTH"
+	// SubscriptionPeriodTwoMonths is a subscription period for TwoMonths.
+	SubscriptionPeriodTwoMonths SubscriptionPeriod = "TWO_MONTHS"
+	// SubscriptionPeriodThreeMonths is a subscription period for ThreeMonths.
+	SubscriptionPeriodThreeMonths SubscriptionPeriod = "THREE_MONTHS"
+	// SubscriptionPeriodSixMonths is a subscription period for SixMonths.
+	SubscriptionPeriodSixMonths SubscriptionPeriod = "SIX_MONTHS"
+	// SubscriptionPeriodOneYear is a subscription period for OneYear.
+	SubscriptionPeriodOneYear SubscriptionPeriod = "ONE_YEAR"
+)
+
+// SubscriptionState defines model for SubscriptionState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/attributes
+type SubscriptionState string
+
+const (
+	// SubscriptionStateMissingMetadata is a subscription state for MissingMetadata.
+	SubscriptionStateMissingMetadata SubscriptionState = "MISSING_METADATA"
+	// SubscriptionStateReadyToSubmit is a subscription state for ReadyToSubmit.
+	SubscriptionStateReadyToSubmit SubscriptionState = "READY_TO_SUBMIT"
+	// SubscriptionStateWaitingForReview is a subscription state for WaitingForReview.
+	SubscriptionStateWaitingForReview SubscriptionState = "WAITING_FOR_REVIEW"
+	// SubscriptionStateInReview is a subscription state for InReview.
+	SubscriptionStateInReview SubscriptionState = "IN_REVIEW"
+	// SubscriptionStateDeveloperActionNeeded is a subscription state for DeveloperActionNeeded.
+	SubscriptionStateDeveloperActionNeeded SubscriptionState = "DEVELOPER_ACTION_NEEDED"
+	// SubscriptionStatePendingBinaryApproval is a subscription state for PendingBinaryApproval.
+	SubscriptionStatePendingBinaryApproval SubscriptionState = "PENDING_BINARY_APPROVAL"
+	// SubscriptionStateApproved is a subscription state for Approved.
+	SubscriptionStateApproved SubscriptionState = "APPROVED"
+	// SubscriptionStateDeveloperRemovedFromSale is a subscription state for DeveloperRemovedFromSale.
+	SubscriptionStateDeveloperRemovedFromSale SubscriptionState = "DEVELOPER_REMOVED_FROM_SALE"
+	// SubscriptionStateRemovedFromSale is a subscription state for RemovedFromSale.
+	SubscriptionStateRemovedFromSale SubscriptionState = "REMOVED_FROM_SALE"
+	// SubscriptionStateRejected is a subscription state for Rejected.
+	SubscriptionStateRejected SubscriptionState = "REJECTED"
+)
+
+// Subscription defines model for Subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription
+type Subscription struct {
+	Attributes    *SubscriptionAttributes    `json:"attributes,omitempty"`
+	ID            string                     `json:"id"`
+	Links         ResourceLinks              `json:"links"`
+	Relationships *SubscriptionRelationships `json:"relationships,omitempty"`
+	Type          string                     `json:"type"`
+}
+
+// SubscriptionAttributes defines model for Subscription.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/attributes
+type SubscriptionAttributes struct {
+	FamilySharable     *bool               `json:"familySharable,omitempty"`
+	GroupLevel         *int                `json:"groupLevel,omitempty"`
+	Name               *string             `json:"name,omitempty"`
+	ProductID          *string             `json:"productId,omitempty"`
+	ReviewNote         *string             `json:"reviewNote,omitempty"`
+	State              *SubscriptionState  `json:"state,omitempty"`
+	SubscriptionPeriod *SubscriptionPeriod `json:"subscriptionPeriod,omitempty"`
+}
+
+// SubscriptionRelationships defines model for Subscription.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/relationships
+type SubscriptionRelationships struct {
+	AppStoreReviewScreenshot  *Relationship      `json:"appStoreReviewScreenshot,omitempty"`
+	Group                     *Relationship      `json:"group,omitempty"`
+	IntroductoryOffers        *PagedRelationship `json:"introductoryOffers,omitempty"`
+	OfferCodes                *PagedRelationship `json:"offerCodes,omitempty"`
+	PricePoints               *PagedRelationship `json:"pricePoints,omitempty"`
+	Prices                    *PagedRelationship `json:"prices,omitempty"`
+	PromotionalOffers         *PagedRelationship `json:"promotionalOffers,omitempty"`
+	SubscriptionAvailability  *Relationship      `json:"subscriptionAvailability,omitempty"`
+	SubscriptionLocalizations *PagedRelationship `json:"subscriptionLocalizations,omitempty"`
+	WinBackOffers             *PagedRelationship `json:"winBackOffers,omitempty"`
+}
+
+// subscriptionCreateRequest defines model for SubscriptionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptioncreaterequest/data
+type subscriptionCreateRequest struct {
+	Attributes    SubscriptionCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionCreateRequestRelationships `json:"relationships"`
+	Type          string                                 `json:"type"`
+}
+
+// SubscriptionCreateRequestAttributes are attributes for SubscriptionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptioncreaterequest/data/attributes
+type SubscriptionCreateRequestAttributes struct {
+	FamilySharable     *bool               `json:"familySharable,omitempty"`
+	GroupLevel         *int                `json:"groupLevel,omitempty"`
+	Name               string              `json:"name"`
+	ProductID          string              `json:"productId"`
+	ReviewNote         *string             `json:"reviewNote,omitempty"`
+	SubscriptionPeriod *SubscriptionPeriod `json:"subscriptionPeriod,omitempty"`
+}
+
+// subscriptionCreateRequestRelationships are relationships for SubscriptionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptioncreaterequest/data/relationships
+type subscriptionCreateRequestRelationships struct {
+	Group relationshipDeclaration `json:"group"`
+}
+
+// subscriptionUpdateRequest defines model for SubscriptionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionupdaterequest/data
+type subscriptionUpdateRequest struct {
+	Attributes *SubscriptionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                               `json:"id"`
+	Type       string                               `json:"type"`
+}
+
+// SubscriptionUpdateRequestAttributes are attributes for SubscriptionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionupdaterequest/data/attributes
+type SubscriptionUpdateRequestAttributes struct {
+	FamilySharable     *bool               `json:"familySharable,omitempty"`
+	GroupLevel         *int                `json:"groupLevel,omitempty"`
+	Name               *string             `json:"name,omitempty"`
+	ReviewNote         *string             `json:"reviewNote,omitempty"`
+	SubscriptionPeriod *SubscriptionPeriod `json:"subscriptionPeriod,omitempty"`
+}
+
+// SubscriptionResponse defines model for SubscriptionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionresponse
+type SubscriptionResponse struct {
+	Data     Subscription                   `json:"data"`
+	Included []SubscriptionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                  `json:"links"`
+}
+
+// SubscriptionsResponse defines model for SubscriptionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsresponse
+type SubscriptionsResponse struct {
+	Data     []Subscription                 `json:"data"`
+	Included []SubscriptionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks             `json:"links"`
+	Meta     *PagingInformation             `json:"meta,omitempty"`
+}
+
+// SubscriptionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionResponse or SubscriptionsResponse.
+type SubscriptionResponseIncluded included
+
+// ListSubscriptionsForGroupQuery are query options for ListSubscriptionsForGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscriptions_for_a_subscription_group
+type ListSubscriptionsForGroupQuery struct {
+	FieldsSubscriptions                         []string `url:"fields[subscriptions],omitempty"`
+	FieldsSubscriptionGroups                    []string `url:"fields[subscriptionGroups],omitempty"`
+	FieldsSubscriptionLocalizations             []string `url:"fields[subscriptionLocalizations],omitempty"`
+	FieldsSubscriptionAppStoreReviewScreenshots []string `url:"fields[subscriptionAppStoreReviewScreenshots],omitempty"`
+	FieldsSubscriptionAvailabilities            []string `url:"fields[subscriptionAvailabilities],omitempty"`
+	FieldsSubscriptionIntroductoryOffers        []string `url:"fields[subscriptionIntroductoryOffers],omitempty"`
+	FieldsSubscriptionOfferCodes                []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	FieldsSubscriptionPromotionalOffers         []string `url:"fields[subscriptionPromotionalOffers],omitempty"`
+	FieldsWinBackOffers                         []string `url:"fields[winBackOffers],omitempty"`
+	FieldsSubscriptionPrices                    []string `url:"fields[subscriptionPrices],omitempty"`
+	FilterName                                  []string `url:"filter[name],omitempty"`
+	FilterProductID                             []string `url:"filter[productId],omitempty"`
+	FilterState                                 []string `url:"filter[state],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	LimitIntroductoryOffers                     int      `url:"limit[introductoryOffers],omitempty"`
+	LimitOfferCodes                             int      `url:"limit[offerCodes],omitempty"`
+	LimitPrices                                 int      `url:"limit[prices],omitempty"`
+	LimitPromotionalOffers                      int      `url:"limit[promotionalOffers],omitempty"`
+	LimitSubscriptionLocalizations              int      `url:"limit[subscriptionLocalizations],omitempty"`
+	LimitWinBackOffers                          int      `url:"limit[winBackOffers],omitempty"`
+	Sort                                        []string `url:"sort,omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionQuery are query options for GetSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_information
+type GetSubscriptionQuery struct {
+	FieldsSubscriptions                         []string `url:"fields[subscriptions],omitempty"`
+	FieldsSubscriptionGroups                    []string `url:"fields[subscriptionGroups],omitempty"`
+	FieldsSubscriptionLocalizations             []string `url:"fields[subscriptionLocalizations],omitempty"`
+	FieldsSubscriptionAppStoreReviewScreenshots []string `url:"fields[subscriptionAppStoreReviewScreenshots],omitempty"`
+	FieldsSubscriptionAvailabilities            []string `url:"fields[subscriptionAvailabilities],omitempty"`
+	FieldsSubscriptionIntroductoryOffers        []string `url:"fields[subscriptionIntroductoryOffers],omitempty"`
+	FieldsSubscriptionOfferCodes                []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	FieldsSubscriptionPromotionalOffers         []string `url:"fields[subscriptionPromotionalOffers],omitempty"`
+	FieldsWinBackOffers                         []string `url:"fields[winBackOffers],omitempty"`
+	FieldsSubscriptionPrices                    []string `url:"fields[subscriptionPrices],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	LimitIntroductoryOffers                     int      `url:"limit[introductoryOffers],omitempty"`
+	LimitOfferCodes                             int      `url:"limit[offerCodes],omitempty"`
+	LimitPrices                                 int      `url:"limit[prices],omitempty"`
+	LimitPromotionalOffers                      int      `url:"limit[promotionalOffers],omitempty"`
+	LimitSubscriptionLocalizations              int      `url:"limit[subscriptionLocalizations],omitempty"`
+	LimitWinBackOffers                          int      `url:"limit[winBackOffers],omitempty"`
+}
+
+// ListSubscriptionsForGroup lists the auto-renewable subscriptions in a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscriptions_for_a_subscription_group
+func (s *SubscriptionsService) ListSubscriptionsForGroup(ctx context.Context, id string, params *ListSubscriptionsForGroupQuery) (*SubscriptionsResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionGroups/%s/subscriptions", id)
+	res := new(SubscriptionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscription gets information about a specific auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_information
+func (s *SubscriptionsService) GetSubscription(ctx context.Context, id string, params *GetSubscriptionQuery) (*SubscriptionResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s", id)
+	res := new(SubscriptionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscription creates an auto-renewable subscription in a subscription group.
+//
+// GroupLevel orders the subscription within its group, with level 1 being the highest level of service.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_auto-renewable_subscription
+func (s *SubscriptionsService) CreateSubscription(ctx context.Context, attributes SubscriptionCreateRequestAttributes, groupID string) (*SubscriptionResponse, *Response, error) {
+	req := subscriptionCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionCreateRequestRelationships{
+			Group: *newRelationshipDeclaration(&groupID, "subscriptionGroups"),
+		},
+		Type: "subscriptions",
+	}
+	res := new(SubscriptionResponse)
+	resp, err := s.client.post(ctx, "subscriptions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscription modifies the name, period, family sharing setting, review note, or group level of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_auto-renewable_subscription
+func (s *SubscriptionsService) UpdateSubscription(ctx context.Context, id string, attributes *SubscriptionUpdateRequestAttributes) (*SubscriptionResponse, *Response, error) {
+	req := subscriptionUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "subscriptions",
+	}
+	url := fmt.Sprintf("subscriptions/%s", id)
+	res := new(SubscriptionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscription deletes an auto-renewable subscription that has not yet been submitted for review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_auto-renewable_subscription
+func (s *SubscriptionsService) DeleteSubscription(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptions/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionResponseIncluded.
+func (i *SubscriptionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// SubscriptionGroup returns the SubscriptionGroup stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionGroup() *SubscriptionGroup {
+	return extractIncludedSubscriptionGroup(i.inner)
+}
+
+// SubscriptionLocalization returns the SubscriptionLocalization stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionLocalization() *SubscriptionLocalization {
+	return extractIncludedSubscriptionLocalization(i.inner)
+}
+
+// SubscriptionAppStoreReviewScreenshot returns the SubscriptionAppStoreReviewScreenshot stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionAppStoreReviewScreenshot() *SubscriptionAppStoreReviewScreenshot {
+	return extractIncludedSubscriptionAppStoreReviewScreenshot(i.inner)
+}
+
+// SubscriptionAvailability returns the SubscriptionAvailability stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionAvailability() *SubscriptionAvailability {
+	return extractIncludedSubscriptionAvailability(i.inner)
+}
+
+// SubscriptionPrice returns the SubscriptionPrice stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionPrice() *SubscriptionPrice {
+	return extractIncludedSubscriptionPrice(i.inner)
+}
+
+// SubscriptionIntroductoryOffer returns the SubscriptionIntroductoryOffer stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionIntroductoryOffer() *SubscriptionIntroductoryOffer {
+	return extractIncludedSubscriptionIntroductoryOffer(i.inner)
+}
+
+// SubscriptionPromotionalOffer returns the SubscriptionPromotionalOffer stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionPromotionalOffer() *SubscriptionPromotionalOffer {
+	return extractIncludedSubscriptionPromotionalOffer(i.inner)
+}
+
+// SubscriptionOfferCode returns the SubscriptionOfferCode stored within, if one is present.
+func (i *SubscriptionResponseIncluded) SubscriptionOfferCode() *SubscriptionOfferCode {
+	return extractIncludedSubscriptionOfferCode(i.inner)
+}
+
+// WinBackOffer returns the WinBackOffer stored within, if one is present.
+func (i *SubscriptionResponseIncluded) WinBackOffer() *WinBackOffer {
+	return extractIncludedWinBackOffer(i.inner)
+}
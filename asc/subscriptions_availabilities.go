@@ -0,0 +1,147 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionAvailability defines model for SubscriptionAvailability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailability
+type SubscriptionAvailability struct {
+	Attributes    *SubscriptionAvailabilityAttributes    `json:"attributes,omitempty"`
+	ID            string                                 `json:"id"`
+	Links         ResourceLinks                          `json:"links"`
+	Relationships *SubscriptionAvailabilityRelationships `json:"relationships,omitempty"`
+	Type          string                                 `json:"type"`
+}
+
+// SubscriptionAvailabilityAttributes defines model for SubscriptionAvailability.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailability/attributes
+type SubscriptionAvailabilityAttributes struct {
+	AvailableInNewTerritories *bool `json:"availableInNewTerritories,omitempty"`
+}
+
+// SubscriptionAvailabilityRelationships defines model for SubscriptionAvailability.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailability/relationships
+type SubscriptionAvailabilityRelationships struct {
+	AvailableTerritories *PagedRelationship `json:"availableTerritories,omitempty"`
+}
+
+// subscriptionAvailabilityCreateRequest defines model for SubscriptionAvailabilityCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailabilitycreaterequest/data
+type subscriptionAvailabilityCreateRequest struct {
+	Attributes    subscriptionAvailabilityCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionAvailabilityCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// subscriptionAvailabilityCreateRequestAttributes are attributes for SubscriptionAvailabilityCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailabilitycreaterequest/data/attributes
+type subscriptionAvailabilityCreateRequestAttributes struct {
+	AvailableInNewTerritories bool `json:"availableInNewTerritories"`
+}
+
+// subscriptionAvailabilityCreateRequestRelationships are relationships for SubscriptionAvailabilityCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailabilitycreaterequest/data/relationships
+type subscriptionAvailabilityCreateRequestRelationships struct {
+	AvailableTerritories pagedRelationshipDeclaration `json:"availableTerritories"`
+	Subscription         relationshipDeclaration      `json:"subscription"`
+}
+
+// SubscriptionAvailabilityResponse defines model for SubscriptionAvailabilityResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionavailabilityresponse
+type SubscriptionAvailabilityResponse struct {
+	Data     SubscriptionAvailability `json:"data"`
+	Included []Territory              `json:"included,omitempty"`
+	Links    DocumentLinks            `json:"links"`
+}
+
+// GetSubscriptionAvailabilityQuery are query options for GetSubscriptionAvailability
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_of_a_subscription
+type GetSubscriptionAvailabilityQuery struct {
+	FieldsSubscriptionAvailabilities []string `url:"fields[subscriptionAvailabilities],omitempty"`
+	FieldsTerritories                []string `url:"fields[territories],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+	LimitAvailableTerritories        int      `url:"limit[availableTerritories],omitempty"`
+}
+
+// GetAvailabilityForSubscription gets the territories in which an auto-renewable subscription is available.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_for_a_subscription
+func (s *SubscriptionsService) GetAvailabilityForSubscription(ctx context.Context, id string, params *GetSubscriptionAvailabilityQuery) (*SubscriptionAvailabilityResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/subscriptionAvailability", id)
+	res := new(SubscriptionAvailabilityResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionAvailability gets a specific subscription availability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_availability_of_a_subscription
+func (s *SubscriptionsService) GetSubscriptionAvailability(ctx context.Context, id string, params *GetSubscriptionAvailabilityQuery) (*SubscriptionAvailabilityResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionAvailabilities/%s", id)
+	res := new(SubscriptionAvailabilityResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionAvailability sets the territories in which an auto-renewable subscription is available, replacing any previous availability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_the_territory_availability_of_a_subscription
+func (s *SubscriptionsService) CreateSubscriptionAvailability(ctx context.Context, subscriptionID string, availableInNewTerritories bool, availableTerritoryIDs []string) (*SubscriptionAvailabilityResponse, *Response, error) {
+	req := subscriptionAvailabilityCreateRequest{
+		Attributes: subscriptionAvailabilityCreateRequestAttributes{
+			AvailableInNewTerritories: availableInNewTerritories,
+		},
+		Relationships: subscriptionAvailabilityCreateRequestRelationships{
+			AvailableTerritories: newPagedRelationshipDeclaration(availableTerritoryIDs, "territories"),
+			Subscription:         *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionAvailabilities",
+	}
+	res := new(SubscriptionAvailabilityResponse)
+	resp, err := s.client.post(ctx, "subscriptionAvailabilities", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListAvailableTerritoriesForSubscriptionAvailability lists the territories in which an auto-renewable subscription is available.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_the_territory_availability_of_a_subscription
+func (s *SubscriptionsService) ListAvailableTerritoriesForSubscriptionAvailability(ctx context.Context, id string, params *ListTerritoriesQuery) (*TerritoriesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionAvailabilities/%s/availableTerritories", id)
+	res := new(TerritoriesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,407 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionGroup defines model for SubscriptionGroup.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroup
+type SubscriptionGroup struct {
+	Attributes    *SubscriptionGroupAttributes    `json:"attributes,omitempty"`
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *SubscriptionGroupRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// SubscriptionGroupAttributes defines model for SubscriptionGroup.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroup/attributes
+type SubscriptionGroupAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// SubscriptionGroupRelationships defines model for SubscriptionGroup.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroup/relationships
+type SubscriptionGroupRelationships struct {
+	SubscriptionGroupLocalizations *PagedRelationship `json:"subscriptionGroupLocalizations,omitempty"`
+	Subscriptions                  *PagedRelationship `json:"subscriptions,omitempty"`
+}
+
+// subscriptionGroupCreateRequest defines model for SubscriptionGroupCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupcreaterequest/data
+type subscriptionGroupCreateRequest struct {
+	Attributes    subscriptionGroupCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionGroupCreateRequestRelationships `json:"relationships"`
+	Type          string                                      `json:"type"`
+}
+
+// subscriptionGroupCreateRequestAttributes are attributes for SubscriptionGroupCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupcreaterequest/data/attributes
+type subscriptionGroupCreateRequestAttributes struct {
+	ReferenceName string `json:"referenceName"`
+}
+
+// subscriptionGroupCreateRequestRelationships are relationships for SubscriptionGroupCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupcreaterequest/data/relationships
+type subscriptionGroupCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// subscriptionGroupUpdateRequest defines model for SubscriptionGroupUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupupdaterequest/data
+type subscriptionGroupUpdateRequest struct {
+	Attributes *subscriptionGroupUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                    `json:"id"`
+	Type       string                                    `json:"type"`
+}
+
+// subscriptionGroupUpdateRequestAttributes are attributes for SubscriptionGroupUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupupdaterequest/data/attributes
+type subscriptionGroupUpdateRequestAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// SubscriptionGroupResponse defines model for SubscriptionGroupResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupresponse
+type SubscriptionGroupResponse struct {
+	Data     SubscriptionGroup                   `json:"data"`
+	Included []SubscriptionGroupResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionGroupsResponse defines model for SubscriptionGroupsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsresponse
+type SubscriptionGroupsResponse struct {
+	Data     []SubscriptionGroup                 `json:"data"`
+	Included []SubscriptionGroupResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionGroupResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionGroupResponse or SubscriptionGroupsResponse.
+type SubscriptionGroupResponseIncluded included
+
+// SubscriptionGroupLocalization defines model for SubscriptionGroupLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalization
+type SubscriptionGroupLocalization struct {
+	Attributes    *SubscriptionGroupLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Links         ResourceLinks                               `json:"links"`
+	Relationships *SubscriptionGroupLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// SubscriptionGroupLocalizationAttributes defines model for SubscriptionGroupLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalization/attributes
+type SubscriptionGroupLocalizationAttributes struct {
+	CustomAppName *string `json:"customAppName,omitempty"`
+	Locale        *string `json:"locale,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	State         *string `json:"state,omitempty"`
+}
+
+// SubscriptionGroupLocalizationRelationships defines model for SubscriptionGroupLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalization/relationships
+type SubscriptionGroupLocalizationRelationships struct {
+	SubscriptionGroup *Relationship `json:"subscriptionGroup,omitempty"`
+}
+
+// subscriptionGroupLocalizationCreateRequest defines model for SubscriptionGroupLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationcreaterequest/data
+type subscriptionGroupLocalizationCreateRequest struct {
+	Attributes    SubscriptionGroupLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionGroupLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                  `json:"type"`
+}
+
+// SubscriptionGroupLocalizationCreateRequestAttributes are attributes for SubscriptionGroupLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationcreaterequest/data/attributes
+type SubscriptionGroupLocalizationCreateRequestAttributes struct {
+	CustomAppName *string `json:"customAppName,omitempty"`
+	Locale        string  `json:"locale"`
+	Name          string  `json:"name"`
+}
+
+// subscriptionGroupLocalizationCreateRequestRelationships are relationships for SubscriptionGroupLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationcreaterequest/data/relationships
+type subscriptionGroupLocalizationCreateRequestRelationships struct {
+	SubscriptionGroup relationshipDeclaration `json:"subscriptionGroup"`
+}
+
+// subscriptionGroupLocalizationUpdateRequest defines model for SubscriptionGroupLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationupdaterequest/data
+type subscriptionGroupLocalizationUpdateRequest struct {
+	Attributes *SubscriptionGroupLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                `json:"id"`
+	Type       string                                                `json:"type"`
+}
+
+// SubscriptionGroupLocalizationUpdateRequestAttributes are attributes for SubscriptionGroupLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationupdaterequest/data/attributes
+type SubscriptionGroupLocalizationUpdateRequestAttributes struct {
+	CustomAppName *string `json:"customAppName,omitempty"`
+	Name          *string `json:"name,omitempty"`
+}
+
+// SubscriptionGroupLocalizationResponse defines model for SubscriptionGroupLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationresponse
+type SubscriptionGroupLocalizationResponse struct {
+	Data     SubscriptionGroupLocalization `json:"data"`
+	Included []SubscriptionGroup           `json:"included,omitempty"`
+	Links    DocumentLinks                 `json:"links"`
+}
+
+// SubscriptionGroupLocalizationsResponse defines model for SubscriptionGroupLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongrouplocalizationsresponse
+type SubscriptionGroupLocalizationsResponse struct {
+	Data     []SubscriptionGroupLocalization `json:"data"`
+	Included []SubscriptionGroup             `json:"included,omitempty"`
+	Links    PagedDocumentLinks              `json:"links"`
+	Meta     *PagingInformation              `json:"meta,omitempty"`
+}
+
+// ListSubscriptionGroupsForAppQuery are query options for ListSubscriptionGroupsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_groups_for_an_app
+type ListSubscriptionGroupsForAppQuery struct {
+	FieldsSubscriptionGroups             []string `url:"fields[subscriptionGroups],omitempty"`
+	FieldsSubscriptionGroupLocalizations []string `url:"fields[subscriptionGroupLocalizations],omitempty"`
+	FieldsSubscriptions                  []string `url:"fields[subscriptions],omitempty"`
+	FilterReferenceName                  []string `url:"filter[referenceName],omitempty"`
+	FilterSubscriptionsState             []string `url:"filter[subscriptions.state],omitempty"`
+	Include                              []string `url:"include,omitempty"`
+	Limit                                int      `url:"limit,omitempty"`
+	LimitSubscriptionGroupLocalizations  int      `url:"limit[subscriptionGroupLocalizations],omitempty"`
+	LimitSubscriptions                   int      `url:"limit[subscriptions],omitempty"`
+	Sort                                 []string `url:"sort,omitempty"`
+	Cursor                               string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionGroupQuery are query options for GetSubscriptionGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_group_information
+type GetSubscriptionGroupQuery struct {
+	FieldsSubscriptionGroups             []string `url:"fields[subscriptionGroups],omitempty"`
+	FieldsSubscriptionGroupLocalizations []string `url:"fields[subscriptionGroupLocalizations],omitempty"`
+	FieldsSubscriptions                  []string `url:"fields[subscriptions],omitempty"`
+	Include                              []string `url:"include,omitempty"`
+	LimitSubscriptionGroupLocalizations  int      `url:"limit[subscriptionGroupLocalizations],omitempty"`
+	LimitSubscriptions                   int      `url:"limit[subscriptions],omitempty"`
+}
+
+// ListSubscriptionGroupLocalizationsQuery are query options for ListSubscriptionGroupLocalizations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_group_localizations_for_a_subscription_group
+type ListSubscriptionGroupLocalizationsQuery struct {
+	FieldsSubscriptionGroupLocalizations []string `url:"fields[subscriptionGroupLocalizations],omitempty"`
+	FieldsSubscriptionGroups             []string `url:"fields[subscriptionGroups],omitempty"`
+	Include                              []string `url:"include,omitempty"`
+	Limit                                int      `url:"limit,omitempty"`
+	Cursor                               string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionGroupLocalizationQuery are query options for GetSubscriptionGroupLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_group_localization_information
+type GetSubscriptionGroupLocalizationQuery struct {
+	FieldsSubscriptionGroupLocalizations []string `url:"fields[subscriptionGroupLocalizations],omitempty"`
+	FieldsSubscriptionGroups             []string `url:"fields[subscriptionGroups],omitempty"`
+	Include                              []string `url:"include,omitempty"`
+}
+
+// ListSubscriptionGroupsForApp lists the subscription groups of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_groups_for_an_app
+func (s *SubscriptionsService) ListSubscriptionGroupsForApp(ctx context.Context, id string, params *ListSubscriptionGroupsForAppQuery) (*SubscriptionGroupsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/subscriptionGroups", id)
+	res := new(SubscriptionGroupsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionGroup gets information about a specific subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_group_information
+func (s *SubscriptionsService) GetSubscriptionGroup(ctx context.Context, id string, params *GetSubscriptionGroupQuery) (*SubscriptionGroupResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionGroups/%s", id)
+	res := new(SubscriptionGroupResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionGroup creates a subscription group for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_group
+func (s *SubscriptionsService) CreateSubscriptionGroup(ctx context.Context, referenceName string, appID string) (*SubscriptionGroupResponse, *Response, error) {
+	req := subscriptionGroupCreateRequest{
+		Attributes: subscriptionGroupCreateRequestAttributes{
+			ReferenceName: referenceName,
+		},
+		Relationships: subscriptionGroupCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "subscriptionGroups",
+	}
+	res := new(SubscriptionGroupResponse)
+	resp, err := s.client.post(ctx, "subscriptionGroups", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionGroup modifies the reference name of a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_subscription_group
+func (s *SubscriptionsService) UpdateSubscriptionGroup(ctx context.Context, id string, referenceName *string) (*SubscriptionGroupResponse, *Response, error) {
+	req := subscriptionGroupUpdateRequest{
+		ID:   id,
+		Type: "subscriptionGroups",
+	}
+
+	if referenceName != nil {
+		req.Attributes = &subscriptionGroupUpdateRequestAttributes{
+			ReferenceName: referenceName,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionGroups/%s", id)
+	res := new(SubscriptionGroupResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionGroup deletes a subscription group that doesn't contain any subscriptions.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_subscription_group
+func (s *SubscriptionsService) DeleteSubscriptionGroup(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionGroups/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListSubscriptionGroupLocalizations lists the localized names of a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_group_localizations_for_a_subscription_group
+func (s *SubscriptionsService) ListSubscriptionGroupLocalizations(ctx context.Context, id string, params *ListSubscriptionGroupLocalizationsQuery) (*SubscriptionGroupLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionGroups/%s/subscriptionGroupLocalizations", id)
+	res := new(SubscriptionGroupLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionGroupLocalization gets a specific localized name of a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_group_localization_information
+func (s *SubscriptionsService) GetSubscriptionGroupLocalization(ctx context.Context, id string, params *GetSubscriptionGroupLocalizationQuery) (*SubscriptionGroupLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionGroupLocalizations/%s", id)
+	res := new(SubscriptionGroupLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionGroupLocalization adds a localized name and custom app name to a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_group_localization
+func (s *SubscriptionsService) CreateSubscriptionGroupLocalization(ctx context.Context, attributes SubscriptionGroupLocalizationCreateRequestAttributes, subscriptionGroupID string) (*SubscriptionGroupLocalizationResponse, *Response, error) {
+	req := subscriptionGroupLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionGroupLocalizationCreateRequestRelationships{
+			SubscriptionGroup: *newRelationshipDeclaration(&subscriptionGroupID, "subscriptionGroups"),
+		},
+		Type: "subscriptionGroupLocalizations",
+	}
+	res := new(SubscriptionGroupLocalizationResponse)
+	resp, err := s.client.post(ctx, "subscriptionGroupLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionGroupLocalization modifies the localized name or custom app name of a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_subscription_group_localization
+func (s *SubscriptionsService) UpdateSubscriptionGroupLocalization(ctx context.Context, id string, attributes *SubscriptionGroupLocalizationUpdateRequestAttributes) (*SubscriptionGroupLocalizationResponse, *Response, error) {
+	req := subscriptionGroupLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "subscriptionGroupLocalizations",
+	}
+	url := fmt.Sprintf("subscriptionGroupLocalizations/%s", id)
+	res := new(SubscriptionGroupLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionGroupLocalization deletes a localized name from a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_subscription_group_localization
+func (s *SubscriptionsService) DeleteSubscriptionGroupLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionGroupLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionGroupResponseIncluded.
+func (i *SubscriptionGroupResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Subscription returns the Subscription stored within, if one is present.
+func (i *SubscriptionGroupResponseIncluded) Subscription() *Subscription {
+	return extractIncludedSubscription(i.inner)
+}
+
+// SubscriptionGroupLocalization returns the SubscriptionGroupLocalization stored within, if one is present.
+func (i *SubscriptionGroupResponseIncluded) SubscriptionGroupLocalization() *SubscriptionGroupLocalization {
+	return extractIncludedSubscriptionGroupLocalization(i.inner)
+}
@@ -0,0 +1,124 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSubscriptionGroupsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListSubscriptionGroupsForApp(ctx, "10", &ListSubscriptionGroupsForAppQuery{})
+	})
+}
+
+func TestGetSubscriptionGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionGroup(ctx, "10", &GetSubscriptionGroupQuery{})
+	})
+}
+
+func TestGetSubscriptionGroupIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptions"},{"type":"subscriptionGroupLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		group, _, err := client.Subscriptions.GetSubscriptionGroup(ctx, "10", &GetSubscriptionGroupQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, group.Included)
+
+		assert.NotNil(t, group.Included[0].Subscription())
+		assert.NotNil(t, group.Included[1].SubscriptionGroupLocalization())
+
+		assert.Nil(t, group.Included[0].SubscriptionGroupLocalization())
+		assert.Nil(t, group.Included[1].Subscription())
+	})
+}
+
+func TestCreateSubscriptionGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionGroup(ctx, "Premium", "10")
+	})
+}
+
+func TestUpdateSubscriptionGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscriptionGroup(ctx, "10", String("Premium"))
+	})
+}
+
+func TestDeleteSubscriptionGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteSubscriptionGroup(ctx, "10")
+	})
+}
+
+func TestListSubscriptionGroupLocalizations(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListSubscriptionGroupLocalizations(ctx, "10", &ListSubscriptionGroupLocalizationsQuery{})
+	})
+}
+
+func TestGetSubscriptionGroupLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionGroupLocalization(ctx, "10", &GetSubscriptionGroupLocalizationQuery{})
+	})
+}
+
+func TestCreateSubscriptionGroupLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionGroupLocalization(ctx, SubscriptionGroupLocalizationCreateRequestAttributes{}, "10")
+	})
+}
+
+func TestUpdateSubscriptionGroupLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionGroupLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscriptionGroupLocalization(ctx, "10", &SubscriptionGroupLocalizationUpdateRequestAttributes{})
+	})
+}
+
+func TestDeleteSubscriptionGroupLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteSubscriptionGroupLocalization(ctx, "10")
+	})
+}
@@ -0,0 +1,199 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionIntroductoryOffer defines model for SubscriptionIntroductoryOffer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffer
+type SubscriptionIntroductoryOffer struct {
+	Attributes    *SubscriptionIntroductoryOfferAttributes    `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Links         ResourceLinks                               `json:"links"`
+	Relationships *SubscriptionIntroductoryOfferRelationships `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// SubscriptionIntroductoryOfferAttributes defines model for SubscriptionIntroductoryOffer.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffer/attributes
+type SubscriptionIntroductoryOfferAttributes struct {
+	Duration        *SubscriptionOfferDuration `json:"duration,omitempty"`
+	EndDate         *Date                      `json:"endDate,omitempty"`
+	NumberOfPeriods *int                       `json:"numberOfPeriods,omitempty"`
+	OfferMode       *SubscriptionOfferMode     `json:"offerMode,omitempty"`
+	StartDate       *Date                      `json:"startDate,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferRelationships defines model for SubscriptionIntroductoryOffer.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffer/relationships
+type SubscriptionIntroductoryOfferRelationships struct {
+	Subscription           *Relationship `json:"subscription,omitempty"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// subscriptionIntroductoryOfferCreateRequest defines model for SubscriptionIntroductoryOfferCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffercreaterequest/data
+type subscriptionIntroductoryOfferCreateRequest struct {
+	Attributes    SubscriptionIntroductoryOfferCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionIntroductoryOfferCreateRequestRelationships `json:"relationships"`
+	Type          string                                                  `json:"type"`
+}
+
+// SubscriptionIntroductoryOfferCreateRequestAttributes are attributes for SubscriptionIntroductoryOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffercreaterequest/data/attributes
+type SubscriptionIntroductoryOfferCreateRequestAttributes struct {
+	Duration        SubscriptionOfferDuration `json:"duration"`
+	EndDate         *Date                     `json:"endDate,omitempty"`
+	NumberOfPeriods int                       `json:"numberOfPeriods"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode"`
+	StartDate       *Date                     `json:"startDate,omitempty"`
+}
+
+// subscriptionIntroductoryOfferCreateRequestRelationships are relationships for SubscriptionIntroductoryOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffercreaterequest/data/relationships
+type subscriptionIntroductoryOfferCreateRequestRelationships struct {
+	Subscription           relationshipDeclaration  `json:"subscription"`
+	SubscriptionPricePoint *relationshipDeclaration `json:"subscriptionPricePoint,omitempty"`
+	Territory              *relationshipDeclaration `json:"territory,omitempty"`
+}
+
+// subscriptionIntroductoryOfferUpdateRequest defines model for SubscriptionIntroductoryOfferUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryofferupdaterequest/data
+type subscriptionIntroductoryOfferUpdateRequest struct {
+	Attributes *subscriptionIntroductoryOfferUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                `json:"id"`
+	Type       string                                                `json:"type"`
+}
+
+// subscriptionIntroductoryOfferUpdateRequestAttributes are attributes for SubscriptionIntroductoryOfferUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryofferupdaterequest/data/attributes
+type subscriptionIntroductoryOfferUpdateRequestAttributes struct {
+	EndDate *Date `json:"endDate,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferResponse defines model for SubscriptionIntroductoryOfferResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryofferresponse
+type SubscriptionIntroductoryOfferResponse struct {
+	Data     SubscriptionIntroductoryOffer       `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionIntroductoryOffersResponse defines model for SubscriptionIntroductoryOffersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffersresponse
+type SubscriptionIntroductoryOffersResponse struct {
+	Data     []SubscriptionIntroductoryOffer     `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// ListIntroductoryOffersForSubscriptionQuery are query options for ListIntroductoryOffersForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_introductory_offers_for_a_subscription
+type ListIntroductoryOffersForSubscriptionQuery struct {
+	FieldsSubscriptionIntroductoryOffers []string `url:"fields[subscriptionIntroductoryOffers],omitempty"`
+	FieldsSubscriptionPricePoints        []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsSubscriptions                  []string `url:"fields[subscriptions],omitempty"`
+	FieldsTerritories                    []string `url:"fields[territories],omitempty"`
+	FilterTerritory                      []string `url:"filter[territory],omitempty"`
+	Include                              []string `url:"include,omitempty"`
+	Limit                                int      `url:"limit,omitempty"`
+	Cursor                               string   `url:"cursor,omitempty"`
+}
+
+// ListIntroductoryOffersForSubscription lists the introductory offers of an auto-renewable subscription in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_introductory_offers_for_a_subscription
+func (s *SubscriptionsService) ListIntroductoryOffersForSubscription(ctx context.Context, id string, params *ListIntroductoryOffersForSubscriptionQuery) (*SubscriptionIntroductoryOffersResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/introductoryOffers", id)
+	res := new(SubscriptionIntroductoryOffersResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionIntroductoryOffer creates an introductory offer for an auto-renewable subscription in a territory.
+//
+// subscriptionPricePointID may be nil for free trials. territoryID may be nil when a price point is given, since the
+// territory is implied by the price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_introductory_offer
+func (s *SubscriptionsService) CreateSubscriptionIntroductoryOffer(ctx context.Context, attributes SubscriptionIntroductoryOfferCreateRequestAttributes, subscriptionID string, subscriptionPricePointID *string, territoryID *string) (*SubscriptionIntroductoryOfferResponse, *Response, error) {
+	req := subscriptionIntroductoryOfferCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionIntroductoryOfferCreateRequestRelationships{
+			Subscription:           *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+			SubscriptionPricePoint: newRelationshipDeclaration(subscriptionPricePointID, "subscriptionPricePoints"),
+			Territory:              newRelationshipDeclaration(territoryID, "territories"),
+		},
+		Type: "subscriptionIntroductoryOffers",
+	}
+	res := new(SubscriptionIntroductoryOfferResponse)
+	resp, err := s.client.post(ctx, "subscriptionIntroductoryOffers", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionIntroductoryOffer modifies the end date of an introductory offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_introductory_offer
+func (s *SubscriptionsService) UpdateSubscriptionIntroductoryOffer(ctx context.Context, id string, endDate *Date) (*SubscriptionIntroductoryOfferResponse, *Response, error) {
+	req := subscriptionIntroductoryOfferUpdateRequest{
+		ID:   id,
+		Type: "subscriptionIntroductoryOffers",
+	}
+
+	if endDate != nil {
+		req.Attributes = &subscriptionIntroductoryOfferUpdateRequestAttributes{
+			EndDate: endDate,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionIntroductoryOffers/%s", id)
+	res := new(SubscriptionIntroductoryOfferResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionIntroductoryOffer deletes an introductory offer from an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_introductory_offer
+func (s *SubscriptionsService) DeleteSubscriptionIntroductoryOffer(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionIntroductoryOffers/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,199 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionLocalization defines model for SubscriptionLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalization
+type SubscriptionLocalization struct {
+	Attributes    *SubscriptionLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                 `json:"id"`
+	Links         ResourceLinks                          `json:"links"`
+	Relationships *SubscriptionLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                 `json:"type"`
+}
+
+// SubscriptionLocalizationAttributes defines model for SubscriptionLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalization/attributes
+type SubscriptionLocalizationAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	State       *string `json:"state,omitempty"`
+}
+
+// SubscriptionLocalizationRelationships defines model for SubscriptionLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalization/relationships
+type SubscriptionLocalizationRelationships struct {
+	Subscription *Relationship `json:"subscription,omitempty"`
+}
+
+// subscriptionLocalizationCreateRequest defines model for SubscriptionLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationcreaterequest/data
+type subscriptionLocalizationCreateRequest struct {
+	Attributes    SubscriptionLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// SubscriptionLocalizationCreateRequestAttributes are attributes for SubscriptionLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationcreaterequest/data/attributes
+type SubscriptionLocalizationCreateRequestAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Locale      string  `json:"locale"`
+	Name        string  `json:"name"`
+}
+
+// subscriptionLocalizationCreateRequestRelationships are relationships for SubscriptionLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationcreaterequest/data/relationships
+type subscriptionLocalizationCreateRequestRelationships struct {
+	Subscription relationshipDeclaration `json:"subscription"`
+}
+
+// subscriptionLocalizationUpdateRequest defines model for SubscriptionLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationupdaterequest/data
+type subscriptionLocalizationUpdateRequest struct {
+	Attributes *SubscriptionLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Type       string                                           `json:"type"`
+}
+
+// SubscriptionLocalizationUpdateRequestAttributes are attributes for SubscriptionLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationupdaterequest/data/attributes
+type SubscriptionLocalizationUpdateRequestAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// SubscriptionLocalizationResponse defines model for SubscriptionLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationresponse
+type SubscriptionLocalizationResponse struct {
+	Data     SubscriptionLocalization `json:"data"`
+	Included []Subscription           `json:"included,omitempty"`
+	Links    DocumentLinks            `json:"links"`
+}
+
+// SubscriptionLocalizationsResponse defines model for SubscriptionLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionlocalizationsresponse
+type SubscriptionLocalizationsResponse struct {
+	Data     []SubscriptionLocalization `json:"data"`
+	Included []Subscription             `json:"included,omitempty"`
+	Links    PagedDocumentLinks         `json:"links"`
+	Meta     *PagingInformation         `json:"meta,omitempty"`
+}
+
+// ListSubscriptionLocalizationsQuery are query options for ListSubscriptionLocalizations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_localizations_for_a_subscription
+type ListSubscriptionLocalizationsQuery struct {
+	FieldsSubscriptionLocalizations []string `url:"fields[subscriptionLocalizations],omitempty"`
+	FieldsSubscriptions             []string `url:"fields[subscriptions],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+	Limit                           int      `url:"limit,omitempty"`
+	Cursor                          string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionLocalizationQuery are query options for GetSubscriptionLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_localization_information
+type GetSubscriptionLocalizationQuery struct {
+	FieldsSubscriptionLocalizations []string `url:"fields[subscriptionLocalizations],omitempty"`
+	FieldsSubscriptions             []string `url:"fields[subscriptions],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+}
+
+// ListSubscriptionLocalizations lists the localized names and descriptions of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_localizations_for_a_subscription
+func (s *SubscriptionsService) ListSubscriptionLocalizations(ctx context.Context, id string, params *ListSubscriptionLocalizationsQuery) (*SubscriptionLocalizationsResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/subscriptionLocalizations", id)
+	res := new(SubscriptionLocalizationsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionLocalization gets a specific localized name and description of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_localization_information
+func (s *SubscriptionsService) GetSubscriptionLocalization(ctx context.Context, id string, params *GetSubscriptionLocalizationQuery) (*SubscriptionLocalizationResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionLocalizations/%s", id)
+	res := new(SubscriptionLocalizationResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionLocalization adds a localized name and description to an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_localization
+func (s *SubscriptionsService) CreateSubscriptionLocalization(ctx context.Context, attributes SubscriptionLocalizationCreateRequestAttributes, subscriptionID string) (*SubscriptionLocalizationResponse, *Response, error) {
+	req := subscriptionLocalizationCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionLocalizationCreateRequestRelationships{
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionLocalizations",
+	}
+	res := new(SubscriptionLocalizationResponse)
+	resp, err := s.client.post(ctx, "subscriptionLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionLocalization modifies the localized name or description of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_subscription_localization
+func (s *SubscriptionsService) UpdateSubscriptionLocalization(ctx context.Context, id string, attributes *SubscriptionLocalizationUpdateRequestAttributes) (*SubscriptionLocalizationResponse, *Response, error) {
+	req := subscriptionLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "subscriptionLocalizations",
+	}
+	url := fmt.Sprintf("subscriptionLocalizations/%s", id)
+	res := new(SubscriptionLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionLocalization deletes a localized name and description from an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_subscription_localization
+func (s *SubscriptionsService) DeleteSubscriptionLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,592 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// SubscriptionOfferCode defines model for SubscriptionOfferCode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercode
+type SubscriptionOfferCode struct {
+	Attributes    *SubscriptionOfferCodeAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *SubscriptionOfferCodeRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// SubscriptionOfferCodeAttributes defines model for SubscriptionOfferCode.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercode/attributes
+type SubscriptionOfferCodeAttributes struct {
+	Active                *bool                             `json:"active,omitempty"`
+	CustomerEligibilities []SubscriptionCustomerEligibility `json:"customerEligibilities,omitempty"`
+	Duration              *SubscriptionOfferDuration        `json:"duration,omitempty"`
+	Name                  *string                           `json:"name,omitempty"`
+	NumberOfPeriods       *int                              `json:"numberOfPeriods,omitempty"`
+	OfferEligibility      *SubscriptionOfferEligibility     `json:"offerEligibility,omitempty"`
+	OfferMode             *SubscriptionOfferMode            `json:"offerMode,omitempty"`
+	TotalNumberOfCodes    *int                              `json:"totalNumberOfCodes,omitempty"`
+}
+
+// SubscriptionOfferCodeRelationships defines model for SubscriptionOfferCode.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercode/relationships
+type SubscriptionOfferCodeRelationships struct {
+	CustomCodes     *PagedRelationship `json:"customCodes,omitempty"`
+	OneTimeUseCodes *PagedRelationship `json:"oneTimeUseCodes,omitempty"`
+	Prices          *PagedRelationship `json:"prices,omitempty"`
+	Subscription    *Relationship      `json:"subscription,omitempty"`
+}
+
+// subscriptionOfferCodeCreateRequest defines model for SubscriptionOfferCodeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecreaterequest/data
+type subscriptionOfferCodeCreateRequest struct {
+	Attributes    SubscriptionOfferCodeCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionOfferCodeCreateRequestRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+// SubscriptionOfferCodeCreateRequestAttributes are attributes for SubscriptionOfferCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecreaterequest/data/attributes
+type SubscriptionOfferCodeCreateRequestAttributes struct {
+	CustomerEligibilities []SubscriptionCustomerEligibility `json:"customerEligibilities"`
+	Duration              SubscriptionOfferDuration         `json:"duration"`
+	Name                  string                            `json:"name"`
+	NumberOfPeriods       int                               `json:"numberOfPeriods"`
+	OfferEligibility      SubscriptionOfferEligibility      `json:"offerEligibility"`
+	OfferMode             SubscriptionOfferMode             `json:"offerMode"`
+}
+
+// subscriptionOfferCodeCreateRequestRelationships are relationships for SubscriptionOfferCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecreaterequest/data/relationships
+type subscriptionOfferCodeCreateRequestRelationships struct {
+	Prices       pagedRelationshipDeclaration `json:"prices"`
+	Subscription relationshipDeclaration      `json:"subscription"`
+}
+
+// subscriptionOfferCodeUpdateRequest defines model for SubscriptionOfferCodeUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeupdaterequest/data
+type subscriptionOfferCodeUpdateRequest struct {
+	Attributes *subscriptionOfferCodeUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// subscriptionOfferCodeUpdateRequestAttributes are attributes for SubscriptionOfferCodeUpdateRequest and the
+// update requests of one-time use and custom codes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeupdaterequest/data/attributes
+type subscriptionOfferCodeUpdateRequestAttributes struct {
+	Active *bool `json:"active,omitempty"`
+}
+
+// SubscriptionOfferCodeResponse defines model for SubscriptionOfferCodeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercoderesponse
+type SubscriptionOfferCodeResponse struct {
+	Data     SubscriptionOfferCode               `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionOfferCodesResponse defines model for SubscriptionOfferCodesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodesresponse
+type SubscriptionOfferCodesResponse struct {
+	Data     []SubscriptionOfferCode             `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionOfferCodePrice defines model for SubscriptionOfferCodePrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeprice
+type SubscriptionOfferCodePrice struct {
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *SubscriptionOfferCodePriceRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// SubscriptionOfferCodePriceRelationships defines model for SubscriptionOfferCodePrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeprice/relationships
+type SubscriptionOfferCodePriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionOfferCodePricesResponse defines model for SubscriptionOfferCodePricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodepricesresponse
+type SubscriptionOfferCodePricesResponse struct {
+	Data     []SubscriptionOfferCodePrice        `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCode defines model for SubscriptionOfferCodeOneTimeUseCode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecode
+type SubscriptionOfferCodeOneTimeUseCode struct {
+	Attributes    *SubscriptionOfferCodeOneTimeUseCodeAttributes    `json:"attributes,omitempty"`
+	ID            string                                            `json:"id"`
+	Links         ResourceLinks                                     `json:"links"`
+	Relationships *SubscriptionOfferCodeOneTimeUseCodeRelationships `json:"relationships,omitempty"`
+	Type          string                                            `json:"type"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodeAttributes defines model for SubscriptionOfferCodeOneTimeUseCode.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecode/attributes
+type SubscriptionOfferCodeOneTimeUseCodeAttributes struct {
+	Active         *bool `json:"active,omitempty"`
+	CreatedDate    *Date `json:"createdDate,omitempty"`
+	ExpirationDate *Date `json:"expirationDate,omitempty"`
+	NumberOfCodes  *int  `json:"numberOfCodes,omitempty"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodeRelationships defines model for SubscriptionOfferCodeOneTimeUseCode.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecode/relationships
+type SubscriptionOfferCodeOneTimeUseCodeRelationships struct {
+	OfferCode *Relationship `json:"offerCode,omitempty"`
+}
+
+// subscriptionOfferCodeOneTimeUseCodeCreateRequest defines model for SubscriptionOfferCodeOneTimeUseCodeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodecreaterequest/data
+type subscriptionOfferCodeOneTimeUseCodeCreateRequest struct {
+	Attributes    subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes `json:"attributes"`
+	Relationships subscriptionOfferCodeChildCreateRequestRelationships       `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes are attributes for SubscriptionOfferCodeOneTimeUseCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodecreaterequest/data/attributes
+type subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes struct {
+	ExpirationDate Date `json:"expirationDate"`
+	NumberOfCodes  int  `json:"numberOfCodes"`
+}
+
+// subscriptionOfferCodeChildCreateRequestRelationships are relationships for SubscriptionOfferCodeOneTimeUseCodeCreateRequest
+// and SubscriptionOfferCodeCustomCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodecreaterequest/data/relationships
+type subscriptionOfferCodeChildCreateRequestRelationships struct {
+	OfferCode relationshipDeclaration `json:"offerCode"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodeResponse defines model for SubscriptionOfferCodeOneTimeUseCodeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecoderesponse
+type SubscriptionOfferCodeOneTimeUseCodeResponse struct {
+	Data     SubscriptionOfferCodeOneTimeUseCode `json:"data"`
+	Included []SubscriptionOfferCode             `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodesResponse defines model for SubscriptionOfferCodeOneTimeUseCodesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodesresponse
+type SubscriptionOfferCodeOneTimeUseCodesResponse struct {
+	Data     []SubscriptionOfferCodeOneTimeUseCode `json:"data"`
+	Included []SubscriptionOfferCode               `json:"included,omitempty"`
+	Links    PagedDocumentLinks                    `json:"links"`
+	Meta     *PagingInformation                    `json:"meta,omitempty"`
+}
+
+// SubscriptionOfferCodeCustomCode defines model for SubscriptionOfferCodeCustomCode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcode
+type SubscriptionOfferCodeCustomCode struct {
+	Attributes    *SubscriptionOfferCodeCustomCodeAttributes    `json:"attributes,omitempty"`
+	ID            string                                        `json:"id"`
+	Links         ResourceLinks                                 `json:"links"`
+	Relationships *SubscriptionOfferCodeCustomCodeRelationships `json:"relationships,omitempty"`
+	Type          string                                        `json:"type"`
+}
+
+// SubscriptionOfferCodeCustomCodeAttributes defines model for SubscriptionOfferCodeCustomCode.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcode/attributes
+type SubscriptionOfferCodeCustomCodeAttributes struct {
+	Active         *bool   `json:"active,omitempty"`
+	CreatedDate    *Date   `json:"createdDate,omitempty"`
+	CustomCode     *string `json:"customCode,omitempty"`
+	ExpirationDate *Date   `json:"expirationDate,omitempty"`
+	NumberOfCodes  *int    `json:"numberOfCodes,omitempty"`
+}
+
+// SubscriptionOfferCodeCustomCodeRelationships defines model for SubscriptionOfferCodeCustomCode.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcode/relationships
+type SubscriptionOfferCodeCustomCodeRelationships struct {
+	OfferCode *Relationship `json:"offerCode,omitempty"`
+}
+
+// subscriptionOfferCodeCustomCodeCreateRequest defines model for SubscriptionOfferCodeCustomCodeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcodecreaterequest/data
+type subscriptionOfferCodeCustomCodeCreateRequest struct {
+	Attributes    SubscriptionOfferCodeCustomCodeCreateRequestAttributes `json:"attributes"`
+	Relationships subscriptionOfferCodeChildCreateRequestRelationships   `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// SubscriptionOfferCodeCustomCodeCreateRequestAttributes are attributes for SubscriptionOfferCodeCustomCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcodecreaterequest/data/attributes
+type SubscriptionOfferCodeCustomCodeCreateRequestAttributes struct {
+	CustomCode     string `json:"customCode"`
+	ExpirationDate *Date  `json:"expirationDate,omitempty"`
+	NumberOfCodes  int    `json:"numberOfCodes"`
+}
+
+// SubscriptionOfferCodeCustomCodeResponse defines model for SubscriptionOfferCodeCustomCodeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcoderesponse
+type SubscriptionOfferCodeCustomCodeResponse struct {
+	Data     SubscriptionOfferCodeCustomCode `json:"data"`
+	Included []SubscriptionOfferCode         `json:"included,omitempty"`
+	Links    DocumentLinks                   `json:"links"`
+}
+
+// SubscriptionOfferCodeCustomCodesResponse defines model for SubscriptionOfferCodeCustomCodesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcodesresponse
+type SubscriptionOfferCodeCustomCodesResponse struct {
+	Data     []SubscriptionOfferCodeCustomCode `json:"data"`
+	Included []SubscriptionOfferCode           `json:"included,omitempty"`
+	Links    PagedDocumentLinks                `json:"links"`
+	Meta     *PagingInformation                `json:"meta,omitempty"`
+}
+
+// ListOfferCodesForSubscriptionQuery are query options for ListOfferCodesForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_offer_codes_for_a_subscription
+type ListOfferCodesForSubscriptionQuery struct {
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	FieldsSubscriptionOfferCodeCustomCodes     []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodePrices          []string `url:"fields[subscriptionOfferCodePrices],omitempty"`
+	FieldsSubscriptions                        []string `url:"fields[subscriptions],omitempty"`
+	FilterTerritory                            []string `url:"filter[territory],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+	Limit                                      int      `url:"limit,omitempty"`
+	LimitCustomCodes                           int      `url:"limit[customCodes],omitempty"`
+	LimitOneTimeUseCodes                       int      `url:"limit[oneTimeUseCodes],omitempty"`
+	LimitPrices                                int      `url:"limit[prices],omitempty"`
+	Cursor                                     string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionOfferCodeQuery are query options for GetSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_offer_code_information
+type GetSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	FieldsSubscriptionOfferCodeCustomCodes     []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodePrices          []string `url:"fields[subscriptionOfferCodePrices],omitempty"`
+	FieldsSubscriptions                        []string `url:"fields[subscriptions],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+	LimitCustomCodes                           int      `url:"limit[customCodes],omitempty"`
+	LimitOneTimeUseCodes                       int      `url:"limit[oneTimeUseCodes],omitempty"`
+	LimitPrices                                int      `url:"limit[prices],omitempty"`
+}
+
+// ListPricesForSubscriptionOfferCodeQuery are query options for ListPricesForSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_a_subscription_offer_code
+type ListPricesForSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodePrices []string `url:"fields[subscriptionOfferCodePrices],omitempty"`
+	FieldsSubscriptionPricePoints     []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories                 []string `url:"fields[territories],omitempty"`
+	FilterTerritory                   []string `url:"filter[territory],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	Limit                             int      `url:"limit,omitempty"`
+	Cursor                            string   `url:"cursor,omitempty"`
+}
+
+// ListOneTimeUseCodesForSubscriptionOfferCodeQuery are query options for ListOneTimeUseCodesForSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_one-time_use_offer_codes_for_a_subscription_offer
+type ListOneTimeUseCodesForSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+	Limit                                      int      `url:"limit,omitempty"`
+	Cursor                                     string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionOfferCodeOneTimeUseCodeQuery are query options for GetSubscriptionOfferCodeOneTimeUseCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_one-time_use_offer_code_information
+type GetSubscriptionOfferCodeOneTimeUseCodeQuery struct {
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+}
+
+// ListCustomCodesForSubscriptionOfferCodeQuery are query options for ListCustomCodesForSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_offer_codes_for_a_subscription_offer
+type ListCustomCodesForSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodeCustomCodes []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodes           []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	Limit                                  int      `url:"limit,omitempty"`
+	Cursor                                 string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionOfferCodeCustomCodeQuery are query options for GetSubscriptionOfferCodeCustomCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_offer_code_information
+type GetSubscriptionOfferCodeCustomCodeQuery struct {
+	FieldsSubscriptionOfferCodeCustomCodes []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodes           []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+}
+
+// ListOfferCodesForSubscription lists the offer code campaigns of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_offer_codes_for_a_subscription
+func (s *SubscriptionsService) ListOfferCodesForSubscription(ctx context.Context, id string, params *ListOfferCodesForSubscriptionQuery) (*SubscriptionOfferCodesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/offerCodes", id)
+	res := new(SubscriptionOfferCodesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionOfferCode gets information about a specific offer code campaign.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_offer_code_information
+func (s *SubscriptionsService) GetSubscriptionOfferCode(ctx context.Context, id string, params *GetSubscriptionOfferCodeQuery) (*SubscriptionOfferCodeResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodes/%s", id)
+	res := new(SubscriptionOfferCodeResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionOfferCode creates an offer code campaign for an auto-renewable subscription with a price in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_offer_code
+func (s *SubscriptionsService) CreateSubscriptionOfferCode(ctx context.Context, attributes SubscriptionOfferCodeCreateRequestAttributes, subscriptionID string, prices []NewSubscriptionOfferPrice) (*SubscriptionOfferCodeResponse, *Response, error) {
+	newPrices, priceRelationships := newSubscriptionOfferPrices(prices, "subscriptionOfferCodePrices")
+	req := subscriptionOfferCodeCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionOfferCodeCreateRequestRelationships{
+			Prices:       priceRelationships,
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionOfferCodes",
+	}
+	res := new(SubscriptionOfferCodeResponse)
+	resp, err := s.client.post(ctx, "subscriptionOfferCodes", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionOfferCode activates or deactivates an offer code campaign.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_subscription_offer_code
+func (s *SubscriptionsService) UpdateSubscriptionOfferCode(ctx context.Context, id string, active *bool) (*SubscriptionOfferCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeUpdateRequest{
+		ID:   id,
+		Type: "subscriptionOfferCodes",
+	}
+
+	if active != nil {
+		req.Attributes = &subscriptionOfferCodeUpdateRequestAttributes{
+			Active: active,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionOfferCodes/%s", id)
+	res := new(SubscriptionOfferCodeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListPricesForSubscriptionOfferCode lists the prices of an offer code campaign in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_a_subscription_offer_code
+func (s *SubscriptionsService) ListPricesForSubscriptionOfferCode(ctx context.Context, id string, params *ListPricesForSubscriptionOfferCodeQuery) (*SubscriptionOfferCodePricesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodes/%s/prices", id)
+	res := new(SubscriptionOfferCodePricesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListOneTimeUseCodesForSubscriptionOfferCode lists the batches of one-time use codes generated for an offer code campaign.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_one-time_use_offer_codes_for_a_subscription_offer
+func (s *SubscriptionsService) ListOneTimeUseCodesForSubscriptionOfferCode(ctx context.Context, id string, params *ListOneTimeUseCodesForSubscriptionOfferCodeQuery) (*SubscriptionOfferCodeOneTimeUseCodesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodes/%s/oneTimeUseCodes", id)
+	res := new(SubscriptionOfferCodeOneTimeUseCodesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionOfferCodeOneTimeUseCode gets information about a specific batch of one-time use codes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_one-time_use_offer_code_information
+func (s *SubscriptionsService) GetSubscriptionOfferCodeOneTimeUseCode(ctx context.Context, id string, params *GetSubscriptionOfferCodeOneTimeUseCodeQuery) (*SubscriptionOfferCodeOneTimeUseCodeResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodeOneTimeUseCodes/%s", id)
+	res := new(SubscriptionOfferCodeOneTimeUseCodeResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionOfferCodeOneTimeUseCodes generates a batch of one-time use codes for an offer code campaign.
+//
+// Code generation happens asynchronously. Once it completes, download the codes with DownloadSubscriptionOfferCodeOneTimeUseCodeValues.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_one-time_use_offer_codes
+func (s *SubscriptionsService) CreateSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, offerCodeID string, numberOfCodes int, expirationDate Date) (*SubscriptionOfferCodeOneTimeUseCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeOneTimeUseCodeCreateRequest{
+		Attributes: subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes{
+			ExpirationDate: expirationDate,
+			NumberOfCodes:  numberOfCodes,
+		},
+		Relationships: subscriptionOfferCodeChildCreateRequestRelationships{
+			OfferCode: *newRelationshipDeclaration(&offerCodeID, "subscriptionOfferCodes"),
+		},
+		Type: "subscriptionOfferCodeOneTimeUseCodes",
+	}
+	res := new(SubscriptionOfferCodeOneTimeUseCodeResponse)
+	resp, err := s.client.post(ctx, "subscriptionOfferCodeOneTimeUseCodes", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionOfferCodeOneTimeUseCode activates or deactivates a batch of one-time use codes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_one-time_use_offer_code
+func (s *SubscriptionsService) UpdateSubscriptionOfferCodeOneTimeUseCode(ctx context.Context, id string, active *bool) (*SubscriptionOfferCodeOneTimeUseCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeUpdateRequest{
+		ID:   id,
+		Type: "subscriptionOfferCodeOneTimeUseCodes",
+	}
+
+	if active != nil {
+		req.Attributes = &subscriptionOfferCodeUpdateRequestAttributes{
+			Active: active,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionOfferCodeOneTimeUseCodes/%s", id)
+	res := new(SubscriptionOfferCodeOneTimeUseCodeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DownloadSubscriptionOfferCodeOneTimeUseCodeValues downloads the codes in a batch of one-time use codes as CSV.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_one-time_use_offer_code_values
+func (s *SubscriptionsService) DownloadSubscriptionOfferCodeOneTimeUseCodeValues(ctx context.Context, id string) (io.Reader, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodeOneTimeUseCodes/%s/values", id)
+	buffer := new(bytes.Buffer)
+	resp, err := s.client.get(ctx, url, nil, buffer, withAccept("text/csv"))
+
+	return buffer, resp, err
+}
+
+// ListCustomCodesForSubscriptionOfferCode lists the custom codes of an offer code campaign.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_offer_codes_for_a_subscription_offer
+func (s *SubscriptionsService) ListCustomCodesForSubscriptionOfferCode(ctx context.Context, id string, params *ListCustomCodesForSubscriptionOfferCodeQuery) (*SubscriptionOfferCodeCustomCodesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodes/%s/customCodes", id)
+	res := new(SubscriptionOfferCodeCustomCodesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionOfferCodeCustomCode gets information about a specific custom code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_offer_code_information
+func (s *SubscriptionsService) GetSubscriptionOfferCodeCustomCode(ctx context.Context, id string, params *GetSubscriptionOfferCodeCustomCodeQuery) (*SubscriptionOfferCodeCustomCodeResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodeCustomCodes/%s", id)
+	res := new(SubscriptionOfferCodeCustomCodeResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionOfferCodeCustomCode creates a custom code, such as a memorable word, for an offer code campaign.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_offer_code
+func (s *SubscriptionsService) CreateSubscriptionOfferCodeCustomCode(ctx context.Context, attributes SubscriptionOfferCodeCustomCodeCreateRequestAttributes, offerCodeID string) (*SubscriptionOfferCodeCustomCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeCustomCodeCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionOfferCodeChildCreateRequestRelationships{
+			OfferCode: *newRelationshipDeclaration(&offerCodeID, "subscriptionOfferCodes"),
+		},
+		Type: "subscriptionOfferCodeCustomCodes",
+	}
+	res := new(SubscriptionOfferCodeCustomCodeResponse)
+	resp, err := s.client.post(ctx, "subscriptionOfferCodeCustomCodes", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionOfferCodeCustomCode activates or deactivates a custom code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_offer_code
+func (s *SubscriptionsService) UpdateSubscriptionOfferCodeCustomCode(ctx context.Context, id string, active *bool) (*SubscriptionOfferCodeCustomCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeUpdateRequest{
+		ID:   id,
+		Type: "subscriptionOfferCodeCustomCodes",
+	}
+
+	if active != nil {
+		req.Attributes = &subscriptionOfferCodeUpdateRequestAttributes{
+			Active: active,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionOfferCodeCustomCodes/%s", id)
+	res := new(SubscriptionOfferCodeCustomCodeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
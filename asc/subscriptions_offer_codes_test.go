@@ -0,0 +1,157 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListOfferCodesForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListOfferCodesForSubscription(ctx, "10", &ListOfferCodesForSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionOfferCode(ctx, "10", &GetSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestCreateSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionOfferCode(ctx, SubscriptionOfferCodeCreateRequestAttributes{
+			CustomerEligibilities: []SubscriptionCustomerEligibility{SubscriptionCustomerEligibilityNew},
+			Duration:              SubscriptionOfferDurationOneMonth,
+			NumberOfPeriods:       1,
+			OfferEligibility:      SubscriptionOfferEligibilityStackWithIntroOffers,
+			OfferMode:             SubscriptionOfferModeFreeTrial,
+		}, "10", []NewSubscriptionOfferPrice{{TerritoryID: "USA"}})
+	})
+}
+
+func TestUpdateSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscriptionOfferCode(ctx, "10", Bool(false))
+	})
+}
+
+func TestListPricesForSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodePricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListPricesForSubscriptionOfferCode(ctx, "10", &ListPricesForSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestListOneTimeUseCodesForSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListOneTimeUseCodesForSubscriptionOfferCode(ctx, "10", &ListOneTimeUseCodesForSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCodeOneTimeUseCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionOfferCodeOneTimeUseCode(ctx, "10", &GetSubscriptionOfferCodeOneTimeUseCodeQuery{})
+	})
+}
+
+func TestCreateSubscriptionOfferCodeOneTimeUseCodes(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionOfferCodeOneTimeUseCodes(ctx, "10", 500, Date{time.Now()})
+	})
+}
+
+func TestUpdateSubscriptionOfferCodeOneTimeUseCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscriptionOfferCodeOneTimeUseCode(ctx, "10", Bool(false))
+	})
+}
+
+func TestDownloadSubscriptionOfferCodeOneTimeUseCodeValues(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("CODE1\nCODE2", http.StatusOK, false)
+	defer server.Close()
+
+	values, resp, err := client.Subscriptions.DownloadSubscriptionOfferCodeOneTimeUseCodeValues(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "text/csv", resp.Request.Header.Get("Accept"))
+
+	b, err := io.ReadAll(values)
+	assert.NoError(t, err)
+	assert.Equal(t, "CODE1\nCODE2\n", string(b))
+}
+
+func TestListCustomCodesForSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListCustomCodesForSubscriptionOfferCode(ctx, "10", &ListCustomCodesForSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCodeCustomCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionOfferCodeCustomCode(ctx, "10", &GetSubscriptionOfferCodeCustomCodeQuery{})
+	})
+}
+
+func TestCreateSubscriptionOfferCodeCustomCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionOfferCodeCustomCode(ctx, SubscriptionOfferCodeCustomCodeCreateRequestAttributes{CustomCode: "SPRING", NumberOfCodes: 1000}, "10")
+	})
+}
+
+func TestUpdateSubscriptionOfferCodeCustomCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscriptionOfferCodeCustomCode(ctx, "10", Bool(false))
+	})
+}
@@ -0,0 +1,192 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"fmt"
+)
+
+// SubscriptionOfferDuration defines model for SubscriptionOfferDuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionofferduration
+type SubscriptionOfferDuration string
+
+const (
+	// SubscriptionOfferDurationThreeDays is a subscription offer duration for ThreeDays.
+	SubscriptionOfferDurationThreeDays SubscriptionOfferDuration = "THREE_DAYS"
+	// SubscriptionOfferDurationOneWeek is a subscription offer duration for OneWeek.
+	SubscriptionOfferDurationOneWeek SubscriptionOfferDuration = "ONE_WEEK"
+	// SubscriptionOfferDurationTwoWeeks is a subscription offer duration for TwoWeeks.
+	SubscriptionOfferDurationTwoWeeks SubscriptionOfferDuration = "TWO_WEEKS"
+	// SubscriptionOfferDurationOneMonth is a subscription offer duration for OneMonth.
+	SubscriptionOfferDurationOneMonth SubscriptionOfferDuration = "ONE_MONTH"
+	// SubscriptionOfferDurationTwoMonths is a subscription offer duration for TwoMonths.
+	SubscriptionOfferDurationTwoMonths SubscriptionOfferDuration = "TWO_MONTHS"
+	// SubscriptionOfferDurationThreeMonths is a subscription offer duration for ThreeMonths.
+	SubscriptionOfferDurationThreeMonths SubscriptionOfferDuration = "THREE_MONTHS"
+	// SubscriptionOfferDurationSixMonths is a subscription offer duration for SixMonths.
+	SubscriptionOfferDurationSixMonths SubscriptionOfferDuration = "SIX_MONTHS"
+	// SubscriptionOfferDurationOneYear is a subscription offer duration for OneYear.
+	SubscriptionOfferDurationOneYear SubscriptionOfferDuration = "ONE_YEAR"
+)
+
+// SubscriptionOfferMode defines model for SubscriptionOfferMode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffermode
+type SubscriptionOfferMode string
+
+const (
+	// SubscriptionOfferModePayAsYouGo is a subscription offer mode for PayAsYouGo.
+	SubscriptionOfferModePayAsYouGo SubscriptionOfferMode = "PAY_AS_YOU_GO"
+	// SubscriptionOfferModePayUpFront is a subscription offer mode for PayUpFront.
+	SubscriptionOfferModePayUpFront SubscriptionOfferMode = "PAY_UP_FRONT"
+	// SubscriptionOfferModeFreeTrial is a subscription offer mode for FreeTrial.
+	SubscriptionOfferModeFreeTrial SubscriptionOfferMode = "FREE_TRIAL"
+)
+
+// SubscriptionCustomerEligibility defines model for SubscriptionCustomerEligibility.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptioncustomereligibility
+type SubscriptionCustomerEligibility string
+
+const (
+	// SubscriptionCustomerEligibilityNew is a subscription customer eligibility for New.
+	SubscriptionCustomerEligibilityNew SubscriptionCustomerEligibility = "NEW"
+	// SubscriptionCustomerEligibilityExisting is a subscription customer eligibility for Existing.
+	SubscriptionCustomerEligibilityExisting SubscriptionCustomerEligibility = "EXISTING"
+	// SubscriptionCustomerEligibilityExpired is a subscription customer eligibility for Expired.
+	SubscriptionCustomerEligibilityExpired SubscriptionCustomerEligibility = "EXPIRED"
+)
+
+// SubscriptionOfferEligibility defines model for SubscriptionOfferEligibility.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffereligibility
+type SubscriptionOfferEligibility string
+
+const (
+	// SubscriptionOfferEligibilityStackWithIntroOffers is a subscription offer eligibility for StackWithIntroOffers.
+	SubscriptionOfferEligibilityStackWithIntroOffers SubscriptionOfferEligibility = "STACK_WITH_INTRO_OFFERS"
+	// SubscriptionOfferEligibilityReplaceIntroOffers is a subscription offer eligibility for ReplaceIntroOffers.
+	SubscriptionOfferEligibilityReplaceIntroOffers SubscriptionOfferEligibility = "REPLACE_INTRO_OFFERS"
+)
+
+// NewSubscriptionOfferPrice models the price of a promotional offer, offer code, or win-back offer in a single territory.
+//
+// Use ListPricePointsForSubscription to find a PricePointID. Leave PricePointID empty for free trial offers.
+type NewSubscriptionOfferPrice struct {
+	TerritoryID  string
+	PricePointID string
+}
+
+type subscriptionOfferPriceInlineCreate struct {
+	ID            string                                          `json:"id"`
+	Relationships subscriptionOfferPriceInlineCreateRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+type subscriptionOfferPriceInlineCreateRelationships struct {
+	SubscriptionPricePoint *relationshipDeclaration `json:"subscriptionPricePoint,omitempty"`
+	Territory              relationshipDeclaration  `json:"territory"`
+}
+
+func (p NewSubscriptionOfferPrice) inlineCreate(index int, priceType string) subscriptionOfferPriceInlineCreate {
+	var pricePointID *string
+	if p.PricePointID != "" {
+		pricePointID = &p.PricePointID
+	}
+
+	return subscriptionOfferPriceInlineCreate{
+		ID: fmt.Sprintf("${new-price-%d}", index),
+		Relationships: subscriptionOfferPriceInlineCreateRelationships{
+			SubscriptionPricePoint: newRelationshipDeclaration(pricePointID, "subscriptionPricePoints"),
+			Territory:              *newRelationshipDeclaration(&p.TerritoryID, "territories"),
+		},
+		Type: priceType,
+	}
+}
+
+// newSubscriptionOfferPrices builds the inline resources for a set of offer prices, along with the
+// relationship declaration that references them from the offer.
+func newSubscriptionOfferPrices(prices []NewSubscriptionOfferPrice, priceType string) ([]subscriptionOfferPriceInlineCreate, pagedRelationshipDeclaration) {
+	newPrices := make([]subscriptionOfferPriceInlineCreate, len(prices))
+	priceIDs := make([]string, len(prices))
+
+	for i, price := range prices {
+		newPrice := price.inlineCreate(i, priceType)
+		newPrices[i] = newPrice
+		priceIDs[i] = newPrice.ID
+	}
+
+	return newPrices, newPagedRelationshipDeclaration(priceIDs, priceType)
+}
+
+// SubscriptionOfferResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in responses for introductory offers, promotional offers, offer codes, win-back offers, and their prices.
+type SubscriptionOfferResponseIncluded included
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionOfferResponseIncluded.
+func (i *SubscriptionOfferResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Subscription returns the Subscription stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) Subscription() *Subscription {
+	return extractIncludedSubscription(i.inner)
+}
+
+// SubscriptionPricePoint returns the SubscriptionPricePoint stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) SubscriptionPricePoint() *SubscriptionPricePoint {
+	return extractIncludedSubscriptionPricePoint(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
+
+// SubscriptionPromotionalOfferPrice returns the SubscriptionPromotionalOfferPrice stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) SubscriptionPromotionalOfferPrice() *SubscriptionPromotionalOfferPrice {
+	return extractIncludedSubscriptionPromotionalOfferPrice(i.inner)
+}
+
+// SubscriptionOfferCodePrice returns the SubscriptionOfferCodePrice stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) SubscriptionOfferCodePrice() *SubscriptionOfferCodePrice {
+	return extractIncludedSubscriptionOfferCodePrice(i.inner)
+}
+
+// SubscriptionOfferCodeOneTimeUseCode returns the SubscriptionOfferCodeOneTimeUseCode stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) SubscriptionOfferCodeOneTimeUseCode() *SubscriptionOfferCodeOneTimeUseCode {
+	return extractIncludedSubscriptionOfferCodeOneTimeUseCode(i.inner)
+}
+
+// SubscriptionOfferCodeCustomCode returns the SubscriptionOfferCodeCustomCode stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) SubscriptionOfferCodeCustomCode() *SubscriptionOfferCodeCustomCode {
+	return extractIncludedSubscriptionOfferCodeCustomCode(i.inner)
+}
+
+// WinBackOfferPrice returns the WinBackOfferPrice stored within, if one is present.
+func (i *SubscriptionOfferResponseIncluded) WinBackOfferPrice() *WinBackOfferPrice {
+	return extractIncludedWinBackOfferPrice(i.inner)
+}
@@ -0,0 +1,86 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSubscriptionOfferPrices(t *testing.T) {
+	t.Parallel()
+
+	newPrices, relationships := newSubscriptionOfferPrices([]NewSubscriptionOfferPrice{
+		{TerritoryID: "USA", PricePointID: "10"},
+		{TerritoryID: "CAN"},
+	}, "subscriptionOfferCodePrices")
+
+	assert.Len(t, newPrices, 2)
+	assert.Equal(t, "${new-price-0}", newPrices[0].ID)
+	assert.Equal(t, "subscriptionOfferCodePrices", newPrices[0].Type)
+	assert.NotNil(t, newPrices[0].Relationships.SubscriptionPricePoint)
+	assert.Nil(t, newPrices[1].Relationships.SubscriptionPricePoint)
+	assert.Equal(t, "CAN", newPrices[1].Relationships.Territory.Data.ID)
+	assert.Equal(t, []RelationshipData{
+		{ID: "${new-price-0}", Type: "subscriptionOfferCodePrices"},
+		{ID: "${new-price-1}", Type: "subscriptionOfferCodePrices"},
+	}, relationships.Data)
+
+	b, err := json.Marshal(newPrices[1])
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "subscriptionPricePoint")
+}
+
+func TestSubscriptionOfferIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[
+		{"type":"subscriptions"},{"type":"subscriptionPricePoints"},{"type":"territories"},
+		{"type":"subscriptionPromotionalOfferPrices"},{"type":"subscriptionOfferCodePrices"},
+		{"type":"subscriptionOfferCodeOneTimeUseCodes"},{"type":"subscriptionOfferCodeCustomCodes"},
+		{"type":"winBackOfferPrices"}
+		]}`, func(ctx context.Context, client *Client) {
+		offer, _, err := client.Subscriptions.GetSubscriptionOfferCode(ctx, "10", &GetSubscriptionOfferCodeQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, offer.Included)
+
+		assert.NotNil(t, offer.Included[0].Subscription())
+		assert.NotNil(t, offer.Included[1].SubscriptionPricePoint())
+		assert.NotNil(t, offer.Included[2].Territory())
+		assert.NotNil(t, offer.Included[3].SubscriptionPromotionalOfferPrice())
+		assert.NotNil(t, offer.Included[4].SubscriptionOfferCodePrice())
+		assert.NotNil(t, offer.Included[5].SubscriptionOfferCodeOneTimeUseCode())
+		assert.NotNil(t, offer.Included[6].SubscriptionOfferCodeCustomCode())
+		assert.NotNil(t, offer.Included[7].WinBackOfferPrice())
+
+		assert.Nil(t, offer.Included[0].SubscriptionPricePoint())
+		assert.Nil(t, offer.Included[0].Territory())
+		assert.Nil(t, offer.Included[0].SubscriptionPromotionalOfferPrice())
+		assert.Nil(t, offer.Included[0].SubscriptionOfferCodePrice())
+		assert.Nil(t, offer.Included[0].SubscriptionOfferCodeOneTimeUseCode())
+		assert.Nil(t, offer.Included[0].SubscriptionOfferCodeCustomCode())
+		assert.Nil(t, offer.Included[0].WinBackOfferPrice())
+		assert.Nil(t, offer.Included[1].Subscription())
+	})
+}
@@ -0,0 +1,290 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionPricePoint defines model for SubscriptionPricePoint.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepoint
+type SubscriptionPricePoint struct {
+	Attributes    *SubscriptionPricePointAttributes    `json:"attributes,omitempty"`
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *SubscriptionPricePointRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// SubscriptionPricePointAttributes defines model for SubscriptionPricePoint.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepoint/attributes
+type SubscriptionPricePointAttributes struct {
+	CustomerPrice *string `json:"customerPrice,omitempty"`
+	Proceeds      *string `json:"proceeds,omitempty"`
+	ProceedsYear2 *string `json:"proceedsYear2,omitempty"`
+}
+
+// SubscriptionPricePointRelationships defines model for SubscriptionPricePoint.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepoint/relationships
+type SubscriptionPricePointRelationships struct {
+	Territory *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionPricePointResponse defines model for SubscriptionPricePointResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepointresponse
+type SubscriptionPricePointResponse struct {
+	Data     SubscriptionPricePoint `json:"data"`
+	Included []Territory            `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// SubscriptionPricePointsResponse defines model for SubscriptionPricePointsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepointsresponse
+type SubscriptionPricePointsResponse struct {
+	Data     []SubscriptionPricePoint `json:"data"`
+	Included []Territory              `json:"included,omitempty"`
+	Links    PagedDocumentLinks       `json:"links"`
+	Meta     *PagingInformation       `json:"meta,omitempty"`
+}
+
+// SubscriptionPrice defines model for SubscriptionPrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionprice
+type SubscriptionPrice struct {
+	Attributes    *SubscriptionPriceAttributes    `json:"attributes,omitempty"`
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *SubscriptionPriceRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// SubscriptionPriceAttributes defines model for SubscriptionPrice.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionprice/attributes
+type SubscriptionPriceAttributes struct {
+	Preserved *bool `json:"preserved,omitempty"`
+	StartDate *Date `json:"startDate,omitempty"`
+}
+
+// SubscriptionPriceRelationships defines model for SubscriptionPrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionprice/relationships
+type SubscriptionPriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// subscriptionPriceCreateRequest defines model for SubscriptionPriceCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricecreaterequest/data
+type subscriptionPriceCreateRequest struct {
+	Attributes    *SubscriptionPriceCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships subscriptionPriceCreateRequestRelationships `json:"relationships"`
+	Type          string                                      `json:"type"`
+}
+
+// SubscriptionPriceCreateRequestAttributes are attributes for SubscriptionPriceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricecreaterequest/data/attributes
+type SubscriptionPriceCreateRequestAttributes struct {
+	PreserveCurrentPrice *bool `json:"preserveCurrentPrice,omitempty"`
+	StartDate            *Date `json:"startDate,omitempty"`
+}
+
+// subscriptionPriceCreateRequestRelationships are relationships for SubscriptionPriceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricecreaterequest/data/relationships
+type subscriptionPriceCreateRequestRelationships struct {
+	Subscription           relationshipDeclaration  `json:"subscription"`
+	SubscriptionPricePoint relationshipDeclaration  `json:"subscriptionPricePoint"`
+	Territory              *relationshipDeclaration `json:"territory,omitempty"`
+}
+
+// SubscriptionPriceResponse defines model for SubscriptionPriceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpriceresponse
+type SubscriptionPriceResponse struct {
+	Data     SubscriptionPrice                   `json:"data"`
+	Included []SubscriptionPriceResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionPricesResponse defines model for SubscriptionPricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricesresponse
+type SubscriptionPricesResponse struct {
+	Data     []SubscriptionPrice                 `json:"data"`
+	Included []SubscriptionPriceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionPriceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionPriceResponse or SubscriptionPricesResponse.
+type SubscriptionPriceResponseIncluded included
+
+// ListPricePointsForSubscriptionQuery are query options for ListPricePointsForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_a_subscription
+type ListPricePointsForSubscriptionQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionPricePointQuery are query options for GetSubscriptionPricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_price_point_information
+type GetSubscriptionPricePointQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+}
+
+// ListEqualizationsForSubscriptionPricePointQuery are query options for ListEqualizationsForSubscriptionPricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_price_point_equalizations
+type ListEqualizationsForSubscriptionPricePointQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FilterSubscription            []string `url:"filter[subscription],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// ListPricesForSubscriptionQuery are query options for ListPricesForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_a_subscription
+type ListPricesForSubscriptionQuery struct {
+	FieldsSubscriptionPrices      []string `url:"fields[subscriptionPrices],omitempty"`
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FilterSubscriptionPricePoint  []string `url:"filter[subscriptionPricePoint],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// ListPricePointsForSubscription lists the price points available for an auto-renewable subscription, including customer price and proceeds per territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_a_subscription
+func (s *SubscriptionsService) ListPricePointsForSubscription(ctx context.Context, id string, params *ListPricePointsForSubscriptionQuery) (*SubscriptionPricePointsResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/pricePoints", id)
+	res := new(SubscriptionPricePointsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionPricePoint gets a specific subscription price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_price_point_information
+func (s *SubscriptionsService) GetSubscriptionPricePoint(ctx context.Context, id string, params *GetSubscriptionPricePointQuery) (*SubscriptionPricePointResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionPricePoints/%s", id)
+	res := new(SubscriptionPricePointResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListEqualizationsForSubscriptionPricePoint lists the price points in other territories that are equalized to a given subscription price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_subscription_price_point_equalizations
+func (s *SubscriptionsService) ListEqualizationsForSubscriptionPricePoint(ctx context.Context, id string, params *ListEqualizationsForSubscriptionPricePointQuery) (*SubscriptionPricePointsResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionPricePoints/%s/equalizations", id)
+	res := new(SubscriptionPricePointsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// ListPricesForSubscription lists the current and scheduled prices of an auto-renewable subscription in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_a_subscription
+func (s *SubscriptionsService) ListPricesForSubscription(ctx context.Context, id string, params *ListPricesForSubscriptionQuery) (*SubscriptionPricesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/prices", id)
+	res := new(SubscriptionPricesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionPrice schedules a price for an auto-renewable subscription in the territory of the given price point.
+//
+// Set StartDate in attributes to nil if you want the price to take effect immediately. Set PreserveCurrentPrice to keep
+// existing subscribers at their current price. territoryID is optional, since the territory is implied by the price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_price_change
+func (s *SubscriptionsService) CreateSubscriptionPrice(ctx context.Context, attributes *SubscriptionPriceCreateRequestAttributes, subscriptionID string, subscriptionPricePointID string, territoryID *string) (*SubscriptionPriceResponse, *Response, error) {
+	req := subscriptionPriceCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionPriceCreateRequestRelationships{
+			Subscription:           *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+			SubscriptionPricePoint: *newRelationshipDeclaration(&subscriptionPricePointID, "subscriptionPricePoints"),
+			Territory:              newRelationshipDeclaration(territoryID, "territories"),
+		},
+		Type: "subscriptionPrices",
+	}
+	res := new(SubscriptionPriceResponse)
+	resp, err := s.client.post(ctx, "subscriptionPrices", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionPrice deletes a scheduled subscription price change that has not yet taken effect.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_subscription_price_change
+func (s *SubscriptionsService) DeleteSubscriptionPrice(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionPrices/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionPriceResponseIncluded.
+func (i *SubscriptionPriceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// SubscriptionPricePoint returns the SubscriptionPricePoint stored within, if one is present.
+func (i *SubscriptionPriceResponseIncluded) SubscriptionPricePoint() *SubscriptionPricePoint {
+	return extractIncludedSubscriptionPricePoint(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *SubscriptionPriceResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
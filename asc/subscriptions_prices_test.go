@@ -0,0 +1,93 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPricePointsForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListPricePointsForSubscription(ctx, "10", &ListPricePointsForSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricePointResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionPricePoint(ctx, "10", &GetSubscriptionPricePointQuery{})
+	})
+}
+
+func TestListEqualizationsForSubscriptionPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListEqualizationsForSubscriptionPricePoint(ctx, "10", &ListEqualizationsForSubscriptionPricePointQuery{})
+	})
+}
+
+func TestListPricesForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListPricesForSubscription(ctx, "10", &ListPricesForSubscriptionQuery{})
+	})
+}
+
+func TestListPricesForSubscriptionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptionPricePoints"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		prices, _, err := client.Subscriptions.ListPricesForSubscription(ctx, "10", &ListPricesForSubscriptionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, prices.Included)
+
+		assert.NotNil(t, prices.Included[0].SubscriptionPricePoint())
+		assert.NotNil(t, prices.Included[1].Territory())
+
+		assert.Nil(t, prices.Included[0].Territory())
+		assert.Nil(t, prices.Included[1].SubscriptionPricePoint())
+	})
+}
+
+func TestCreateSubscriptionPrice(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPriceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionPrice(ctx, &SubscriptionPriceCreateRequestAttributes{StartDate: &Date{time.Now()}, PreserveCurrentPrice: Bool(true)}, "10", "11", String("USA"))
+	})
+}
+
+func TestDeleteSubscriptionPrice(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteSubscriptionPrice(ctx, "10")
+	})
+}
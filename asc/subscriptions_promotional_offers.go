@@ -0,0 +1,265 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionPromotionalOffer defines model for SubscriptionPromotionalOffer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffer
+type SubscriptionPromotionalOffer struct {
+	Attributes    *SubscriptionPromotionalOfferAttributes    `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *SubscriptionPromotionalOfferRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// SubscriptionPromotionalOfferAttributes defines model for SubscriptionPromotionalOffer.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffer/attributes
+type SubscriptionPromotionalOfferAttributes struct {
+	Duration        *SubscriptionOfferDuration `json:"duration,omitempty"`
+	Name            *string                    `json:"name,omitempty"`
+	NumberOfPeriods *int                       `json:"numberOfPeriods,omitempty"`
+	OfferCode       *string                    `json:"offerCode,omitempty"`
+	OfferMode       *SubscriptionOfferMode     `json:"offerMode,omitempty"`
+}
+
+// SubscriptionPromotionalOfferRelationships defines model for SubscriptionPromotionalOffer.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffer/relationships
+type SubscriptionPromotionalOfferRelationships struct {
+	Prices       *PagedRelationship `json:"prices,omitempty"`
+	Subscription *Relationship      `json:"subscription,omitempty"`
+}
+
+// subscriptionPromotionalOfferCreateRequest defines model for SubscriptionPromotionalOfferCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffercreaterequest/data
+type subscriptionPromotionalOfferCreateRequest struct {
+	Attributes    SubscriptionPromotionalOfferCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionPromotionalOfferCreateRequestRelationships `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// SubscriptionPromotionalOfferCreateRequestAttributes are attributes for SubscriptionPromotionalOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffercreaterequest/data/attributes
+type SubscriptionPromotionalOfferCreateRequestAttributes struct {
+	Duration        SubscriptionOfferDuration `json:"duration"`
+	Name            string                    `json:"name"`
+	NumberOfPeriods int                       `json:"numberOfPeriods"`
+	OfferCode       string                    `json:"offerCode"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode"`
+}
+
+// subscriptionPromotionalOfferCreateRequestRelationships are relationships for SubscriptionPromotionalOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffercreaterequest/data/relationships
+type subscriptionPromotionalOfferCreateRequestRelationships struct {
+	Prices       pagedRelationshipDeclaration `json:"prices"`
+	Subscription relationshipDeclaration      `json:"subscription"`
+}
+
+// subscriptionPromotionalOfferUpdateRequest defines model for SubscriptionPromotionalOfferUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferupdaterequest/data
+type subscriptionPromotionalOfferUpdateRequest struct {
+	ID            string                                                 `json:"id"`
+	Relationships subscriptionPromotionalOfferUpdateRequestRelationships `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// subscriptionPromotionalOfferUpdateRequestRelationships are relationships for SubscriptionPromotionalOfferUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferupdaterequest/data/relationships
+type subscriptionPromotionalOfferUpdateRequestRelationships struct {
+	Prices pagedRelationshipDeclaration `json:"prices"`
+}
+
+// SubscriptionPromotionalOfferResponse defines model for SubscriptionPromotionalOfferResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferresponse
+type SubscriptionPromotionalOfferResponse struct {
+	Data     SubscriptionPromotionalOffer        `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionPromotionalOffersResponse defines model for SubscriptionPromotionalOffersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffersresponse
+type SubscriptionPromotionalOffersResponse struct {
+	Data     []SubscriptionPromotionalOffer      `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPrice defines model for SubscriptionPromotionalOfferPrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferprice
+type SubscriptionPromotionalOfferPrice struct {
+	ID            string                                          `json:"id"`
+	Links         ResourceLinks                                   `json:"links"`
+	Relationships *SubscriptionPromotionalOfferPriceRelationships `json:"relationships,omitempty"`
+	Type          string                                          `json:"type"`
+}
+
+// SubscriptionPromotionalOfferPriceRelationships defines model for SubscriptionPromotionalOfferPrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferprice/relationships
+type SubscriptionPromotionalOfferPriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPricesResponse defines model for SubscriptionPromotionalOfferPricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferpricesresponse
+type SubscriptionPromotionalOfferPricesResponse struct {
+	Data     []SubscriptionPromotionalOfferPrice `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// ListPromotionalOffersForSubscriptionQuery are query options for ListPromotionalOffersForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_promotional_offers_for_a_subscription
+type ListPromotionalOffersForSubscriptionQuery struct {
+	FieldsSubscriptionPromotionalOffers      []string `url:"fields[subscriptionPromotionalOffers],omitempty"`
+	FieldsSubscriptionPromotionalOfferPrices []string `url:"fields[subscriptionPromotionalOfferPrices],omitempty"`
+	FieldsSubscriptions                      []string `url:"fields[subscriptions],omitempty"`
+	FilterTerritory                          []string `url:"filter[territory],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	LimitPrices                              int      `url:"limit[prices],omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionPromotionalOfferQuery are query options for GetSubscriptionPromotionalOffer
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_promotional_offer_information
+type GetSubscriptionPromotionalOfferQuery struct {
+	FieldsSubscriptionPromotionalOffers      []string `url:"fields[subscriptionPromotionalOffers],omitempty"`
+	FieldsSubscriptionPromotionalOfferPrices []string `url:"fields[subscriptionPromotionalOfferPrices],omitempty"`
+	FieldsSubscriptions                      []string `url:"fields[subscriptions],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	LimitPrices                              int      `url:"limit[prices],omitempty"`
+}
+
+// ListPricesForSubscriptionPromotionalOfferQuery are query options for ListPricesForSubscriptionPromotionalOffer
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_a_promotional_offer
+type ListPricesForSubscriptionPromotionalOfferQuery struct {
+	FieldsSubscriptionPromotionalOfferPrices []string `url:"fields[subscriptionPromotionalOfferPrices],omitempty"`
+	FieldsSubscriptionPricePoints            []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories                        []string `url:"fields[territories],omitempty"`
+	FilterTerritory                          []string `url:"filter[territory],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// ListPromotionalOffersForSubscription lists the promotional offers of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_promotional_offers_for_a_subscription
+func (s *SubscriptionsService) ListPromotionalOffersForSubscription(ctx context.Context, id string, params *ListPromotionalOffersForSubscriptionQuery) (*SubscriptionPromotionalOffersResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/promotionalOffers", id)
+	res := new(SubscriptionPromotionalOffersResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionPromotionalOffer gets information about a specific promotional offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_promotional_offer_information
+func (s *SubscriptionsService) GetSubscriptionPromotionalOffer(ctx context.Context, id string, params *GetSubscriptionPromotionalOfferQuery) (*SubscriptionPromotionalOfferResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionPromotionalOffers/%s", id)
+	res := new(SubscriptionPromotionalOfferResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionPromotionalOffer creates a promotional offer for an auto-renewable subscription with a price in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_promotional_offer
+func (s *SubscriptionsService) CreateSubscriptionPromotionalOffer(ctx context.Context, attributes SubscriptionPromotionalOfferCreateRequestAttributes, subscriptionID string, prices []NewSubscriptionOfferPrice) (*SubscriptionPromotionalOfferResponse, *Response, error) {
+	newPrices, priceRelationships := newSubscriptionOfferPrices(prices, "subscriptionPromotionalOfferPrices")
+	req := subscriptionPromotionalOfferCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionPromotionalOfferCreateRequestRelationships{
+			Prices:       priceRelationships,
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionPromotionalOffers",
+	}
+	res := new(SubscriptionPromotionalOfferResponse)
+	resp, err := s.client.post(ctx, "subscriptionPromotionalOffers", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionPromotionalOffer replaces the prices of a promotional offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_promotional_offer
+func (s *SubscriptionsService) UpdateSubscriptionPromotionalOffer(ctx context.Context, id string, prices []NewSubscriptionOfferPrice) (*SubscriptionPromotionalOfferResponse, *Response, error) {
+	newPrices, priceRelationships := newSubscriptionOfferPrices(prices, "subscriptionPromotionalOfferPrices")
+	req := subscriptionPromotionalOfferUpdateRequest{
+		ID: id,
+		Relationships: subscriptionPromotionalOfferUpdateRequestRelationships{
+			Prices: priceRelationships,
+		},
+		Type: "subscriptionPromotionalOffers",
+	}
+	url := fmt.Sprintf("subscriptionPromotionalOffers/%s", id)
+	res := new(SubscriptionPromotionalOfferResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionPromotionalOffer deletes a promotional offer from an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_promotional_offer
+func (s *SubscriptionsService) DeleteSubscriptionPromotionalOffer(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionPromotionalOffers/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListPricesForSubscriptionPromotionalOffer lists the prices of a promotional offer in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_a_promotional_offer
+func (s *SubscriptionsService) ListPricesForSubscriptionPromotionalOffer(ctx context.Context, id string, params *ListPricesForSubscriptionPromotionalOfferQuery) (*SubscriptionPromotionalOfferPricesResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionPromotionalOffers/%s/prices", id)
+	res := new(SubscriptionPromotionalOfferPricesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,78 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListPromotionalOffersForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOffersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListPromotionalOffersForSubscription(ctx, "10", &ListPromotionalOffersForSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionPromotionalOffer(ctx, "10", &GetSubscriptionPromotionalOfferQuery{})
+	})
+}
+
+func TestCreateSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionPromotionalOffer(ctx, SubscriptionPromotionalOfferCreateRequestAttributes{
+			Duration:        SubscriptionOfferDurationOneMonth,
+			NumberOfPeriods: 1,
+			OfferMode:       SubscriptionOfferModePayUpFront,
+		}, "10", []NewSubscriptionOfferPrice{{TerritoryID: "USA", PricePointID: "10"}})
+	})
+}
+
+func TestUpdateSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscriptionPromotionalOffer(ctx, "10", []NewSubscriptionOfferPrice{{TerritoryID: "USA", PricePointID: "10"}})
+	})
+}
+
+func TestDeleteSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteSubscriptionPromotionalOffer(ctx, "10")
+	})
+}
+
+func TestListPricesForSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListPricesForSubscriptionPromotionalOffer(ctx, "10", &ListPricesForSubscriptionPromotionalOfferQuery{})
+	})
+}
@@ -0,0 +1,192 @@
+/*
+*
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionAppStoreReviewScreenshot defines model for SubscriptionAppStoreReviewScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshot
+type SubscriptionAppStoreReviewScreenshot struct {
+	Attributes    *SubscriptionAppStoreReviewScreenshotAttributes    `json:"attributes,omitempty"`
+	ID            string                                             `json:"id"`
+	Links         ResourceLinks                                      `json:"links"`
+	Relationships *SubscriptionAppStoreReviewScreenshotRelationships `json:"relationships,omitempty"`
+	Type          string                                             `json:"type"`
+}
+
+// SubscriptionAppStoreReviewScreenshotAttributes defines model for SubscriptionAppStoreReviewScreenshot.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshot/attributes
+type SubscriptionAppStoreReviewScreenshotAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	AssetToken         *string             `json:"assetToken,omitempty"`
+	AssetType          *string             `json:"assetType,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// SubscriptionAppStoreReviewScreenshotRelationships defines model for SubscriptionAppStoreReviewScreenshot.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshot/relationships
+type SubscriptionAppStoreReviewScreenshotRelationships struct {
+	Subscription *Relationship `json:"subscription,omitempty"`
+}
+
+// subscriptionAppStoreReviewScreenshotCreateRequest defines model for SubscriptionAppStoreReviewScreenshotCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshotcreaterequest/data
+type subscriptionAppStoreReviewScreenshotCreateRequest struct {
+	Attributes    subscriptionAppStoreReviewScreenshotCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionAppStoreReviewScreenshotCreateRequestRelationships `json:"relationships"`
+	Type          string                                                         `json:"type"`
+}
+
+// subscriptionAppStoreReviewScreenshotCreateRequestAttributes are attributes for SubscriptionAppStoreReviewScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshotcreaterequest/data/attributes
+type subscriptionAppStoreReviewScreenshotCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// subscriptionAppStoreReviewScreenshotCreateRequestRelationships are relationships for SubscriptionAppStoreReviewScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshotcreaterequest/data/relationships
+type subscriptionAppStoreReviewScreenshotCreateRequestRelationships struct {
+	Subscription relationshipDeclaration `json:"subscription"`
+}
+
+// subscriptionAppStoreReviewScreenshotUpdateRequest defines model for SubscriptionAppStoreReviewScreenshotUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshotupdaterequest/data
+type subscriptionAppStoreReviewScreenshotUpdateRequest struct {
+	Attributes *subscriptionAppStoreReviewScreenshotUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                       `json:"id"`
+	Type       string                                                       `json:"type"`
+}
+
+// subscriptionAppStoreReviewScreenshotUpdateRequestAttributes are attributes for SubscriptionAppStoreReviewScreenshotUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshotupdaterequest/data/attributes
+type subscriptionAppStoreReviewScreenshotUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// SubscriptionAppStoreReviewScreenshotResponse defines model for SubscriptionAppStoreReviewScreenshotResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionappstorereviewscreenshotresponse
+type SubscriptionAppStoreReviewScreenshotResponse struct {
+	Data     SubscriptionAppStoreReviewScreenshot `json:"data"`
+	Included []Subscription                       `json:"included,omitempty"`
+	Links    DocumentLinks                        `json:"links"`
+}
+
+// GetSubscriptionAppStoreReviewScreenshotQuery are query options for GetSubscriptionAppStoreReviewScreenshot
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_app_store_review_screenshot_information
+type GetSubscriptionAppStoreReviewScreenshotQuery struct {
+	FieldsSubscriptionAppStoreReviewScreenshots []string `url:"fields[subscriptionAppStoreReviewScreenshots],omitempty"`
+	FieldsSubscriptions                         []string `url:"fields[subscriptions],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+}
+
+// GetAppStoreReviewScreenshotForSubscription gets the review screenshot attached to an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_review_screenshot_for_a_subscription
+func (s *SubscriptionsService) GetAppStoreReviewScreenshotForSubscription(ctx context.Context, id string, params *GetSubscriptionAppStoreReviewScreenshotQuery) (*SubscriptionAppStoreReviewScreenshotResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/appStoreReviewScreenshot", id)
+	res := new(SubscriptionAppStoreReviewScreenshotResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetSubscriptionAppStoreReviewScreenshot gets information about a subscription review screenshot and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_subscription_app_store_review_screenshot_information
+func (s *SubscriptionsService) GetSubscriptionAppStoreReviewScreenshot(ctx context.Context, id string, params *GetSubscriptionAppStoreReviewScreenshotQuery) (*SubscriptionAppStoreReviewScreenshotResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptionAppStoreReviewScreenshots/%s", id)
+	res := new(SubscriptionAppStoreReviewScreenshotResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionAppStoreReviewScreenshot reserves a review screenshot for an auto-renewable subscription. Upload the file
+// with the returned upload operations, then commit it with CommitSubscriptionAppStoreReviewScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_app_store_review_screenshot
+func (s *SubscriptionsService) CreateSubscriptionAppStoreReviewScreenshot(ctx context.Context, fileName string, fileSize int64, subscriptionID string) (*SubscriptionAppStoreReviewScreenshotResponse, *Response, error) {
+	req := subscriptionAppStoreReviewScreenshotCreateRequest{
+		Attributes: subscriptionAppStoreReviewScreenshotCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: subscriptionAppStoreReviewScreenshotCreateRequestRelationships{
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionAppStoreReviewScreenshots",
+	}
+	res := new(SubscriptionAppStoreReviewScreenshotResponse)
+	resp, err := s.client.post(ctx, "subscriptionAppStoreReviewScreenshots", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitSubscriptionAppStoreReviewScreenshot commits a subscription review screenshot after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_subscription_app_store_review_screenshot
+func (s *SubscriptionsService) CommitSubscriptionAppStoreReviewScreenshot(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*SubscriptionAppStoreReviewScreenshotResponse, *Response, error) {
+	req := subscriptionAppStoreReviewScreenshotUpdateRequest{
+		ID:   id,
+		Type: "subscriptionAppStoreReviewScreenshots",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &subscriptionAppStoreReviewScreenshotUpdateRequestAttributes{
+			SourceFileChecksum: sourceFileChecksum,
+			Uploaded:           uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionAppStoreReviewScreenshots/%s", id)
+	res := new(SubscriptionAppStoreReviewScreenshotResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionAppStoreReviewScreenshot deletes the review screenshot of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_subscription_app_store_review_screenshot
+func (s *SubscriptionsService) DeleteSubscriptionAppStoreReviewScreenshot(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionAppStoreReviewScreenshots/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
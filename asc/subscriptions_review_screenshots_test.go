@@ -0,0 +1,66 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetAppStoreReviewScreenshotForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionAppStoreReviewScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetAppStoreReviewScreenshotForSubscription(ctx, "10", &GetSubscriptionAppStoreReviewScreenshotQuery{})
+	})
+}
+
+func TestGetSubscriptionAppStoreReviewScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionAppStoreReviewScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscriptionAppStoreReviewScreenshot(ctx, "10", &GetSubscriptionAppStoreReviewScreenshotQuery{})
+	})
+}
+
+func TestCreateSubscriptionAppStoreReviewScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionAppStoreReviewScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscriptionAppStoreReviewScreenshot(ctx, "", 0, "")
+	})
+}
+
+func TestCommitSubscriptionAppStoreReviewScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionAppStoreReviewScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CommitSubscriptionAppStoreReviewScreenshot(ctx, "10", Bool(true), String("10"))
+	})
+}
+
+func TestDeleteSubscriptionAppStoreReviewScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteSubscriptionAppStoreReviewScreenshot(ctx, "10")
+	})
+}
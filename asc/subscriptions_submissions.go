@@ -0,0 +1,137 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+)
+
+// SubscriptionSubmission defines model for SubscriptionSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmission
+type SubscriptionSubmission struct {
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *SubscriptionSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// SubscriptionSubmissionRelationships defines model for SubscriptionSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmission/relationships
+type SubscriptionSubmissionRelationships struct {
+	Subscription *Relationship `json:"subscription,omitempty"`
+}
+
+// subscriptionSubmissionCreateRequest defines model for SubscriptionSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmissioncreaterequest/data
+type subscriptionSubmissionCreateRequest struct {
+	Relationships subscriptionSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                           `json:"type"`
+}
+
+// subscriptionSubmissionCreateRequestRelationships are relationships for SubscriptionSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmissioncreaterequest/data/relationships
+type subscriptionSubmissionCreateRequestRelationships struct {
+	Subscription relationshipDeclaration `json:"subscription"`
+}
+
+// SubscriptionSubmissionResponse defines model for SubscriptionSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmissionresponse
+type SubscriptionSubmissionResponse struct {
+	Data  SubscriptionSubmission `json:"data"`
+	Links DocumentLinks          `json:"links"`
+}
+
+// SubscriptionGroupSubmission defines model for SubscriptionGroupSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsubmission
+type SubscriptionGroupSubmission struct {
+	ID            string                                    `json:"id"`
+	Links         ResourceLinks                             `json:"links"`
+	Relationships *SubscriptionGroupSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                                    `json:"type"`
+}
+
+// SubscriptionGroupSubmissionRelationships defines model for SubscriptionGroupSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsubmission/relationships
+type SubscriptionGroupSubmissionRelationships struct {
+	SubscriptionGroup *Relationship `json:"subscriptionGroup,omitempty"`
+}
+
+// subscriptionGroupSubmissionCreateRequest defines model for SubscriptionGroupSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsubmissioncreaterequest/data
+type subscriptionGroupSubmissionCreateRequest struct {
+	Relationships subscriptionGroupSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                                `json:"type"`
+}
+
+// subscriptionGroupSubmissionCreateRequestRelationships are relationships for SubscriptionGroupSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsubmissioncreaterequest/data/relationships
+type subscriptionGroupSubmissionCreateRequestRelationships struct {
+	SubscriptionGroup relationshipDeclaration `json:"subscriptionGroup"`
+}
+
+// SubscriptionGroupSubmissionResponse defines model for SubscriptionGroupSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsubmissionresponse
+type SubscriptionGroupSubmissionResponse struct {
+	Data  SubscriptionGroupSubmission `json:"data"`
+	Links DocumentLinks               `json:"links"`
+}
+
+// CreateSubscriptionSubmission submits an auto-renewable subscription to App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_submission
+func (s *SubscriptionsService) CreateSubscriptionSubmission(ctx context.Context, subscriptionID string) (*SubscriptionSubmissionResponse, *Response, error) {
+	req := subscriptionSubmissionCreateRequest{
+		Relationships: subscriptionSubmissionCreateRequestRelationships{
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionSubmissions",
+	}
+	res := new(SubscriptionSubmissionResponse)
+	resp, err := s.client.post(ctx, "subscriptionSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateSubscriptionGroupSubmission submits every auto-renewable subscription in a subscription group to App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_subscription_group_submission
+func (s *SubscriptionsService) CreateSubscriptionGroupSubmission(ctx context.Context, subscriptionGroupID string) (*SubscriptionGroupSubmissionResponse, *Response, error) {
+	req := subscriptionGroupSubmissionCreateRequest{
+		Relationships: subscriptionGroupSubmissionCreateRequestRelationships{
+			SubscriptionGroup: *newRelationshipDeclaration(&subscriptionGroupID, "subscriptionGroups"),
+		},
+		Type: "subscriptionGroupSubmissions",
+	}
+	res := new(SubscriptionGroupSubmissionResponse)
+	resp, err := s.client.post(ctx, "subscriptionGroupSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
@@ -0,0 +1,106 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSubscriptionsForGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListSubscriptionsForGroup(ctx, "10", &ListSubscriptionsForGroupQuery{})
+	})
+}
+
+func TestGetSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetSubscription(ctx, "10", &GetSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[
+		{"type":"subscriptionGroups"},{"type":"subscriptionLocalizations"},
+		{"type":"subscriptionAppStoreReviewScreenshots"},{"type":"subscriptionAvailabilities"},
+		{"type":"subscriptionPrices"},{"type":"subscriptionIntroductoryOffers"},
+		{"type":"subscriptionPromotionalOffers"},{"type":"subscriptionOfferCodes"},
+		{"type":"winBackOffers"}
+		]}`, func(ctx context.Context, client *Client) {
+		subscription, _, err := client.Subscriptions.GetSubscription(ctx, "10", &GetSubscriptionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, subscription.Included)
+
+		assert.NotNil(t, subscription.Included[0].SubscriptionGroup())
+		assert.NotNil(t, subscription.Included[1].SubscriptionLocalization())
+		assert.NotNil(t, subscription.Included[2].SubscriptionAppStoreReviewScreenshot())
+		assert.NotNil(t, subscription.Included[3].SubscriptionAvailability())
+		assert.NotNil(t, subscription.Included[4].SubscriptionPrice())
+		assert.NotNil(t, subscription.Included[5].SubscriptionIntroductoryOffer())
+		assert.NotNil(t, subscription.Included[6].SubscriptionPromotionalOffer())
+		assert.NotNil(t, subscription.Included[7].SubscriptionOfferCode())
+		assert.NotNil(t, subscription.Included[8].WinBackOffer())
+
+		assert.Nil(t, subscription.Included[0].SubscriptionLocalization())
+		assert.Nil(t, subscription.Included[0].SubscriptionAppStoreReviewScreenshot())
+		assert.Nil(t, subscription.Included[0].SubscriptionAvailability())
+		assert.Nil(t, subscription.Included[0].SubscriptionPrice())
+		assert.Nil(t, subscription.Included[0].SubscriptionIntroductoryOffer())
+		assert.Nil(t, subscription.Included[0].SubscriptionPromotionalOffer())
+		assert.Nil(t, subscription.Included[0].SubscriptionOfferCode())
+		assert.Nil(t, subscription.Included[0].WinBackOffer())
+		assert.Nil(t, subscription.Included[1].SubscriptionGroup())
+	})
+}
+
+func TestCreateSubscription(t *testing.T) {
+	t.Parallel()
+
+	period := SubscriptionPeriodOneMonth
+
+	testEndpointWithResponse(t, "{}", &SubscriptionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateSubscription(ctx, SubscriptionCreateRequestAttributes{GroupLevel: Int(1), SubscriptionPeriod: &period}, "10")
+	})
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateSubscription(ctx, "10", &SubscriptionUpdateRequestAttributes{FamilySharable: Bool(true)})
+	})
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteSubscription(ctx, "10")
+	})
+}
@@ -0,0 +1,310 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// WinBackOfferPriority defines model for WinBackOfferPriority.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffer/attributes
+type WinBackOfferPriority string
+
+const (
+	// WinBackOfferPriorityHigh is a win-back offer priority for High.
+	WinBackOfferPriorityHigh WinBackOfferPriority = "HIGH"
+	// WinBackOfferPriorityNormal is a win-back offer priority for Normal.
+	WinBackOfferPriorityNormal WinBackOfferPriority = "NORMAL"
+)
+
+// WinBackOfferPromotionIntent defines model for WinBackOfferPromotionIntent.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffer/attributes
+type WinBackOfferPromotionIntent string
+
+const (
+	// WinBackOfferPromotionIntentNotPromoted is a win-back offer promotion intent for NotPromoted.
+	WinBackOfferPromotionIntentNotPromoted WinBackOfferPromotionIntent = "NOT_PROMOTED"
+	// WinBackOfferPromotionIntentUseAutoGeneratedAssets is a win-back offer promotion intent for UseAutoGeneratedAssets.
+	WinBackOfferPromotionIntentUseAutoGeneratedAssets WinBackOfferPromotionIntent = "USE_AUTO_GENERATED_ASSETS"
+)
+
+// IntegerRange defines model for IntegerRange.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/integerrange
+type IntegerRange struct {
+	Maximum *int `json:"maximum,omitempty"`
+	Minimum *int `json:"minimum,omitempty"`
+}
+
+// WinBackOffer defines model for WinBackOffer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffer
+type WinBackOffer struct {
+	Attributes    *WinBackOfferAttributes    `json:"attributes,omitempty"`
+	ID            string                     `json:"id"`
+	Links         ResourceLinks              `json:"links"`
+	Relationships *WinBackOfferRelationships `json:"relationships,omitempty"`
+	Type          string                     `json:"type"`
+}
+
+// WinBackOfferAttributes defines model for WinBackOffer.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffer/attributes
+type WinBackOfferAttributes struct {
+	CustomerEligibilityPaidSubscriptionDurationInMonths *int                         `json:"customerEligibilityPaidSubscriptionDurationInMonths,omitempty"`
+	CustomerEligibilityTimeSinceLastSubscribedInMonths  *IntegerRange                `json:"customerEligibilityTimeSinceLastSubscribedInMonths,omitempty"`
+	CustomerEligibilityWaitBetweenOffersInMonths        *int                         `json:"customerEligibilityWaitBetweenOffersInMonths,omitempty"`
+	Duration                                            *SubscriptionOfferDuration   `json:"duration,omitempty"`
+	EndDate                                             *Date                        `json:"endDate,omitempty"`
+	OfferID                                             *string                      `json:"offerId,omitempty"`
+	OfferMode                                           *SubscriptionOfferMode       `json:"offerMode,omitempty"`
+	PeriodCount                                         *int                         `json:"periodCount,omitempty"`
+	Priority                                            *WinBackOfferPriority        `json:"priority,omitempty"`
+	PromotionIntent                                     *WinBackOfferPromotionIntent `json:"promotionIntent,omitempty"`
+	ReferenceName                                       *string                      `json:"referenceName,omitempty"`
+	StartDate                                           *Date                        `json:"startDate,omitempty"`
+}
+
+// WinBackOfferRelationships defines model for WinBackOffer.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffer/relationships
+type WinBackOfferRelationships struct {
+	Prices *PagedRelationship `json:"prices,omitempty"`
+}
+
+// winBackOfferCreateRequest defines model for WinBackOfferCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffercreaterequest/data
+type winBackOfferCreateRequest struct {
+	Attributes    WinBackOfferCreateRequestAttributes    `json:"attributes"`
+	Relationships winBackOfferCreateRequestRelationships `json:"relationships"`
+	Type          string                                 `json:"type"`
+}
+
+// WinBackOfferCreateRequestAttributes are attributes for WinBackOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffercreaterequest/data/attributes
+type WinBackOfferCreateRequestAttributes struct {
+	CustomerEligibilityPaidSubscriptionDurationInMonths int                          `json:"customerEligibilityPaidSubscriptionDurationInMonths"`
+	CustomerEligibilityTimeSinceLastSubscribedInMonths  IntegerRange                 `json:"customerEligibilityTimeSinceLastSubscribedInMonths"`
+	CustomerEligibilityWaitBetweenOffersInMonths        *int                         `json:"customerEligibilityWaitBetweenOffersInMonths,omitempty"`
+	Duration                                            SubscriptionOfferDuration    `json:"duration"`
+	EndDate                                             *Date                        `json:"endDate,omitempty"`
+	OfferID                                             string                       `json:"offerId"`
+	OfferMode                                           SubscriptionOfferMode        `json:"offerMode"`
+	PeriodCount                                         int                          `json:"periodCount"`
+	Priority                                            WinBackOfferPriority         `json:"priority"`
+	PromotionIntent                                     *WinBackOfferPromotionIntent `json:"promotionIntent,omitempty"`
+	ReferenceName                                       string                       `json:"referenceName"`
+	StartDate                                           Date                         `json:"startDate"`
+}
+
+// winBackOfferCreateRequestRelationships are relationships for WinBackOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffercreaterequest/data/relationships
+type winBackOfferCreateRequestRelationships struct {
+	Prices       pagedRelationshipDeclaration `json:"prices"`
+	Subscription relationshipDeclaration      `json:"subscription"`
+}
+
+// winBackOfferUpdateRequest defines model for WinBackOfferUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackofferupdaterequest/data
+type winBackOfferUpdateRequest struct {
+	Attributes *WinBackOfferUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                               `json:"id"`
+	Type       string                               `json:"type"`
+}
+
+// WinBackOfferUpdateRequestAttributes are attributes for WinBackOfferUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackofferupdaterequest/data/attributes
+type WinBackOfferUpdateRequestAttributes struct {
+	CustomerEligibilityPaidSubscriptionDurationInMonths *int                         `json:"customerEligibilityPaidSubscriptionDurationInMonths,omitempty"`
+	CustomerEligibilityTimeSinceLastSubscribedInMonths  *IntegerRange                `json:"customerEligibilityTimeSinceLastSubscribedInMonths,omitempty"`
+	CustomerEligibilityWaitBetweenOffersInMonths        *int                         `json:"customerEligibilityWaitBetweenOffersInMonths,omitempty"`
+	EndDate                                             *Date                        `json:"endDate,omitempty"`
+	Priority                                            *WinBackOfferPriority        `json:"priority,omitempty"`
+	PromotionIntent                                     *WinBackOfferPromotionIntent `json:"promotionIntent,omitempty"`
+	StartDate                                           *Date                        `json:"startDate,omitempty"`
+}
+
+// WinBackOfferResponse defines model for WinBackOfferResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackofferresponse
+type WinBackOfferResponse struct {
+	Data     WinBackOffer                        `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// WinBackOffersResponse defines model for WinBackOffersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackoffersresponse
+type WinBackOffersResponse struct {
+	Data     []WinBackOffer                      `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// WinBackOfferPrice defines model for WinBackOfferPrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackofferprice
+type WinBackOfferPrice struct {
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *WinBackOfferPriceRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// WinBackOfferPriceRelationships defines model for WinBackOfferPrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackofferprice/relationships
+type WinBackOfferPriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// WinBackOfferPricesResponse defines model for WinBackOfferPricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/winbackofferpricesresponse
+type WinBackOfferPricesResponse struct {
+	Data     []WinBackOfferPrice                 `json:"data"`
+	Included []SubscriptionOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// ListWinBackOffersForSubscriptionQuery are query options for ListWinBackOffersForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-subscriptions-_id_-winbackoffers
+type ListWinBackOffersForSubscriptionQuery struct {
+	FieldsWinBackOffers      []string `url:"fields[winBackOffers],omitempty"`
+	FieldsWinBackOfferPrices []string `url:"fields[winBackOfferPrices],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	Limit                    int      `url:"limit,omitempty"`
+	LimitPrices              int      `url:"limit[prices],omitempty"`
+	Cursor                   string   `url:"cursor,omitempty"`
+}
+
+// GetWinBackOfferQuery are query options for GetWinBackOffer
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-winbackoffers-_id_
+type GetWinBackOfferQuery struct {
+	FieldsWinBackOffers      []string `url:"fields[winBackOffers],omitempty"`
+	FieldsWinBackOfferPrices []string `url:"fields[winBackOfferPrices],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	LimitPrices              int      `url:"limit[prices],omitempty"`
+}
+
+// ListPricesForWinBackOfferQuery are query options for ListPricesForWinBackOffer
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-winbackoffers-_id_-prices
+type ListPricesForWinBackOfferQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FieldsWinBackOfferPrices      []string `url:"fields[winBackOfferPrices],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// ListWinBackOffersForSubscription lists the win-back offers of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-subscriptions-_id_-winbackoffers
+func (s *SubscriptionsService) ListWinBackOffersForSubscription(ctx context.Context, id string, params *ListWinBackOffersForSubscriptionQuery) (*WinBackOffersResponse, *Response, error) {
+	url := fmt.Sprintf("subscriptions/%s/winBackOffers", id)
+	res := new(WinBackOffersResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetWinBackOffer gets information about a specific win-back offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-winbackoffers-_id_
+func (s *SubscriptionsService) GetWinBackOffer(ctx context.Context, id string, params *GetWinBackOfferQuery) (*WinBackOfferResponse, *Response, error) {
+	url := fmt.Sprintf("winBackOffers/%s", id)
+	res := new(WinBackOfferResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateWinBackOffer creates a win-back offer for lapsed subscribers of an auto-renewable subscription with a price in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-winbackoffers
+func (s *SubscriptionsService) CreateWinBackOffer(ctx context.Context, attributes WinBackOfferCreateRequestAttributes, subscriptionID string, prices []NewSubscriptionOfferPrice) (*WinBackOfferResponse, *Response, error) {
+	newPrices, priceRelationships := newSubscriptionOfferPrices(prices, "winBackOfferPrices")
+	req := winBackOfferCreateRequest{
+		Attributes: attributes,
+		Relationships: winBackOfferCreateRequestRelationships{
+			Prices:       priceRelationships,
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "winBackOffers",
+	}
+	res := new(WinBackOfferResponse)
+	resp, err := s.client.post(ctx, "winBackOffers", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// UpdateWinBackOffer modifies the schedule, eligibility, or priority of a win-back offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-winbackoffers-_id_
+func (s *SubscriptionsService) UpdateWinBackOffer(ctx context.Context, id string, attributes *WinBackOfferUpdateRequestAttributes) (*WinBackOfferResponse, *Response, error) {
+	req := winBackOfferUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "winBackOffers",
+	}
+	url := fmt.Sprintf("winBackOffers/%s", id)
+	res := new(WinBackOfferResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteWinBackOffer deletes a win-back offer from an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-winbackoffers-_id_
+func (s *SubscriptionsService) DeleteWinBackOffer(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("winBackOffers/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListPricesForWinBackOffer lists the prices of a win-back offer in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-winbackoffers-_id_-prices
+func (s *SubscriptionsService) ListPricesForWinBackOffer(ctx context.Context, id string, params *ListPricesForWinBackOfferQuery) (*WinBackOfferPricesResponse, *Response, error) {
+	url := fmt.Sprintf("winBackOffers/%s/prices", id)
+	res := new(WinBackOfferPricesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
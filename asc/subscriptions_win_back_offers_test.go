@@ -0,0 +1,85 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestListWinBackOffersForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOffersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListWinBackOffersForSubscription(ctx, "10", &ListWinBackOffersForSubscriptionQuery{})
+	})
+}
+
+func TestGetWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.GetWinBackOffer(ctx, "10", &GetWinBackOfferQuery{})
+	})
+}
+
+func TestCreateWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.CreateWinBackOffer(ctx, WinBackOfferCreateRequestAttributes{
+			CustomerEligibilityPaidSubscriptionDurationInMonths: 6,
+			CustomerEligibilityTimeSinceLastSubscribedInMonths:  IntegerRange{Minimum: Int(1), Maximum: Int(12)},
+			Duration:      SubscriptionOfferDurationOneMonth,
+			OfferID:       "winback",
+			OfferMode:     SubscriptionOfferModePayAsYouGo,
+			PeriodCount:   1,
+			Priority:      WinBackOfferPriorityHigh,
+			ReferenceName: "Winback",
+			StartDate:     Date{time.Now()},
+		}, "10", []NewSubscriptionOfferPrice{{TerritoryID: "USA", PricePointID: "10"}})
+	})
+}
+
+func TestUpdateWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.UpdateWinBackOffer(ctx, "10", &WinBackOfferUpdateRequestAttributes{EndDate: &Date{time.Now()}})
+	})
+}
+
+func TestDeleteWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Subscriptions.DeleteWinBackOffer(ctx, "10")
+	})
+}
+
+func TestListPricesForWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Subscriptions.ListPricesForWinBackOffer(ctx, "10", &ListPricesForWinBackOfferQuery{})
+	})
+}
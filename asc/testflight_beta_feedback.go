@@ -0,0 +1,356 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrMissingScreenshotURL happens when DownloadBetaFeedbackScreenshot is given an image without a URL.
+var ErrMissingScreenshotURL = errors.New("beta feedback screenshot has no url")
+
+// ErrMissingCrashLogText happens when a crash log is returned without its text.
+var ErrMissingCrashLogText = errors.New("beta crash log has no log text")
+
+// BetaFeedbackConnectionType defines model for BetaFeedbackConnectionType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackconnectiontype
+type BetaFeedbackConnectionType string
+
+const (
+	// BetaFeedbackConnectionTypeCellular is a connection type for Cellular.
+	BetaFeedbackConnectionTypeCellular BetaFeedbackConnectionType = "MOBILE_DATA"
+	// BetaFeedbackConnectionTypeNone is a connection type for None.
+	BetaFeedbackConnectionTypeNone BetaFeedbackConnectionType = "NONE"
+	// BetaFeedbackConnectionTypeUnknown is a connection type for Unknown.
+	BetaFeedbackConnectionTypeUnknown BetaFeedbackConnectionType = "UNKNOWN"
+	// BetaFeedbackConnectionTypeWiFi is a connection type for WiFi.
+	BetaFeedbackConnectionTypeWiFi BetaFeedbackConnectionType = "WIFI"
+	// BetaFeedbackConnectionTypeWired is a connection type for Wired.
+	BetaFeedbackConnectionTypeWired BetaFeedbackConnectionType = "WIRE"
+)
+
+// BetaFeedbackScreenshotImage defines model for BetaFeedbackScreenshotImage.
+//
+// The URL of a screenshot image expires at ExpirationDate, after which the submission has to be read again.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackscreenshotimage
+type BetaFeedbackScreenshotImage struct {
+	ExpirationDate *DateTime `json:"expirationDate,omitempty"`
+	Height         *int      `json:"height,omitempty"`
+	URL            *string   `json:"url,omitempty"`
+	Width          *int      `json:"width,omitempty"`
+}
+
+// BetaFeedbackSubmissionAttributes are the attributes that screenshot and crash feedback submissions
+// have in common, describing the tester's device at the time the feedback was sent.
+type BetaFeedbackSubmissionAttributes struct {
+	AppPlatform             *Platform                   `json:"appPlatform,omitempty"`
+	AppUptimeInMilliseconds *int64                      `json:"appUptimeInMilliseconds,omitempty"`
+	Architecture            *string                     `json:"architecture,omitempty"`
+	BatteryPercentage       *int                        `json:"batteryPercentage,omitempty"`
+	BuildBundleID           *string                     `json:"buildBundleId,omitempty"`
+	Comment                 *string                     `json:"comment,omitempty"`
+	ConnectionType          *BetaFeedbackConnectionType `json:"connectionType,omitempty"`
+	CreatedDate             *DateTime                   `json:"createdDate,omitempty"`
+	DeviceFamily            *DeviceFamily               `json:"deviceFamily,omitempty"`
+	DeviceModel             *string                     `json:"deviceModel,omitempty"`
+	DevicePlatform          *Platform                   `json:"devicePlatform,omitempty"`
+	DiskBytesAvailable      *int64                      `json:"diskBytesAvailable,omitempty"`
+	DiskBytesTotal          *int64                      `json:"diskBytesTotal,omitempty"`
+	Email                   *string                     `json:"email,omitempty"`
+	Locale                  *string                     `json:"locale,omitempty"`
+	OSVersion               *string                     `json:"osVersion,omitempty"`
+	PairedAppleWatch        *string                     `json:"pairedAppleWatch,omitempty"`
+	ScreenHeightInPoints    *int                        `json:"screenHeightInPoints,omitempty"`
+	ScreenWidthInPoints     *int                        `json:"screenWidthInPoints,omitempty"`
+	TimeZone                *string                     `json:"timeZone,omitempty"`
+}
+
+// BetaFeedbackScreenshotSubmission defines model for BetaFeedbackScreenshotSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackscreenshotsubmission
+type BetaFeedbackScreenshotSubmission struct {
+	Attributes    *BetaFeedbackScreenshotSubmissionAttributes `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Links         ResourceLinks                               `json:"links"`
+	Relationships *BetaFeedbackSubmissionRelationships        `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// BetaFeedbackScreenshotSubmissionAttributes defines model for BetaFeedbackScreenshotSubmission.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackscreenshotsubmission/attributes
+type BetaFeedbackScreenshotSubmissionAttributes struct {
+	BetaFeedbackSubmissionAttributes
+	Screenshots []BetaFeedbackScreenshotImage `json:"screenshots,omitempty"`
+}
+
+// BetaFeedbackCrashSubmission defines model for BetaFeedbackCrashSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackcrashsubmission
+type BetaFeedbackCrashSubmission struct {
+	Attributes    *BetaFeedbackSubmissionAttributes    `json:"attributes,omitempty"`
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *BetaFeedbackSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// BetaFeedbackSubmissionRelationships defines model for BetaFeedbackScreenshotSubmission.Relationships
+// and BetaFeedbackCrashSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackscreenshotsubmission/relationships
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackcrashsubmission/relationships
+type BetaFeedbackSubmissionRelationships struct {
+	Build    *Relationship `json:"build,omitempty"`
+	CrashLog *Relationship `json:"crashLog,omitempty"`
+	Tester   *Relationship `json:"tester,omitempty"`
+}
+
+// BetaFeedbackScreenshotSubmissionResponse defines model for BetaFeedbackScreenshotSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackscreenshotsubmissionresponse
+type BetaFeedbackScreenshotSubmissionResponse struct {
+	Data     BetaFeedbackScreenshotSubmission         `json:"data"`
+	Included []BetaFeedbackSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                            `json:"links"`
+}
+
+// BetaFeedbackScreenshotSubmissionsResponse defines model for BetaFeedbackScreenshotSubmissionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackscreenshotsubmissionsresponse
+type BetaFeedbackScreenshotSubmissionsResponse struct {
+	Data     []BetaFeedbackScreenshotSubmission       `json:"data"`
+	Included []BetaFeedbackSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                       `json:"links"`
+	Meta     *PagingInformation                       `json:"meta,omitempty"`
+}
+
+// BetaFeedbackCrashSubmissionResponse defines model for BetaFeedbackCrashSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackcrashsubmissionresponse
+type BetaFeedbackCrashSubmissionResponse struct {
+	Data     BetaFeedbackCrashSubmission              `json:"data"`
+	Included []BetaFeedbackSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                            `json:"links"`
+}
+
+// BetaFeedbackCrashSubmissionsResponse defines model for BetaFeedbackCrashSubmissionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betafeedbackcrashsubmissionsresponse
+type BetaFeedbackCrashSubmissionsResponse struct {
+	Data     []BetaFeedbackCrashSubmission            `json:"data"`
+	Included []BetaFeedbackSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                       `json:"links"`
+	Meta     *PagingInformation                       `json:"meta,omitempty"`
+}
+
+// BetaFeedbackSubmissionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a screenshot or crash feedback submission response.
+type BetaFeedbackSubmissionResponseIncluded included
+
+// BetaCrashLog defines model for BetaCrashLog.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betacrashlog
+type BetaCrashLog struct {
+	Attributes *BetaCrashLogAttributes `json:"attributes,omitempty"`
+	ID         string                  `json:"id"`
+	Links      ResourceLinks           `json:"links"`
+	Type       string                  `json:"type"`
+}
+
+// BetaCrashLogAttributes defines model for BetaCrashLog.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betacrashlog/attributes
+type BetaCrashLogAttributes struct {
+	LogText *string `json:"logText,omitempty"`
+}
+
+// BetaCrashLogResponse defines model for BetaCrashLogResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betacrashlogresponse
+type BetaCrashLogResponse struct {
+	Data  BetaCrashLog  `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// ListBetaFeedbackSubmissionsForAppQuery are query options for ListBetaFeedbackScreenshotSubmissionsForApp
+// and ListBetaFeedbackCrashSubmissionsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_beta_feedback_screenshot_submissions_for_an_app
+// https://developer.apple.com/documentation/appstoreconnectapi/list_beta_feedback_crash_submissions_for_an_app
+type ListBetaFeedbackSubmissionsForAppQuery struct {
+	FieldsBetaFeedbackCrashSubmissions      []string `url:"fields[betaFeedbackCrashSubmissions],omitempty"`
+	FieldsBetaFeedbackScreenshotSubmissions []string `url:"fields[betaFeedbackScreenshotSubmissions],omitempty"`
+	FilterAppPlatform                       []string `url:"filter[appPlatform],omitempty"`
+	FilterBuild                             []string `url:"filter[build],omitempty"`
+	FilterBuildPreReleaseVersion            []string `url:"filter[build.preReleaseVersion],omitempty"`
+	FilterDeviceModel                       []string `url:"filter[deviceModel],omitempty"`
+	FilterDevicePlatform                    []string `url:"filter[devicePlatform],omitempty"`
+	FilterOSVersion                         []string `url:"filter[osVersion],omitempty"`
+	FilterTester                            []string `url:"filter[tester],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	Sort                                    []string `url:"sort,omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// GetBetaFeedbackSubmissionQuery are query options for GetBetaFeedbackScreenshotSubmission
+// and GetBetaFeedbackCrashSubmission
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_beta_feedback_screenshot_submission_information
+// https://developer.apple.com/documentation/appstoreconnectapi/read_beta_feedback_crash_submission_information
+type GetBetaFeedbackSubmissionQuery struct {
+	FieldsBetaFeedbackCrashSubmissions      []string `url:"fields[betaFeedbackCrashSubmissions],omitempty"`
+	FieldsBetaFeedbackScreenshotSubmissions []string `url:"fields[betaFeedbackScreenshotSubmissions],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+}
+
+// ListBetaFeedbackScreenshotSubmissionsForApp lists the screenshot feedback that testers sent for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_beta_feedback_screenshot_submissions_for_an_app
+func (s *TestflightService) ListBetaFeedbackScreenshotSubmissionsForApp(ctx context.Context, id string, params *ListBetaFeedbackSubmissionsForAppQuery) (*BetaFeedbackScreenshotSubmissionsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/betaFeedbackScreenshotSubmissions", id)
+	res := new(BetaFeedbackScreenshotSubmissionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetBetaFeedbackScreenshotSubmission gets a specific screenshot feedback submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_beta_feedback_screenshot_submission_information
+func (s *TestflightService) GetBetaFeedbackScreenshotSubmission(ctx context.Context, id string, params *GetBetaFeedbackSubmissionQuery) (*BetaFeedbackScreenshotSubmissionResponse, *Response, error) {
+	url := fmt.Sprintf("betaFeedbackScreenshotSubmissions/%s", id)
+	res := new(BetaFeedbackScreenshotSubmissionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// DeleteBetaFeedbackScreenshotSubmission deletes a screenshot feedback submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_beta_feedback_screenshot_submission
+func (s *TestflightService) DeleteBetaFeedbackScreenshotSubmission(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("betaFeedbackScreenshotSubmissions/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// DownloadBetaFeedbackScreenshot downloads one of the images of a screenshot feedback submission and writes it to the given writer.
+func (s *TestflightService) DownloadBetaFeedbackScreenshot(ctx context.Context, image *BetaFeedbackScreenshotImage, w io.Writer) (*Response, error) {
+	if image == nil || image.URL == nil {
+		return nil, ErrMissingScreenshotURL
+	}
+
+	resp, err := s.client.stream(ctx, *image.URL, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	defer closeDesc(resp.Body)
+
+	_, err = io.Copy(w, resp.Body)
+
+	return resp, err
+}
+
+// ListBetaFeedbackCrashSubmissionsForApp lists the crash feedback that testers sent for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_beta_feedback_crash_submissions_for_an_app
+func (s *TestflightService) ListBetaFeedbackCrashSubmissionsForApp(ctx context.Context, id string, params *ListBetaFeedbackSubmissionsForAppQuery) (*BetaFeedbackCrashSubmissionsResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/betaFeedbackCrashSubmissions", id)
+	res := new(BetaFeedbackCrashSubmissionsResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetBetaFeedbackCrashSubmission gets a specific crash feedback submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_beta_feedback_crash_submission_information
+func (s *TestflightService) GetBetaFeedbackCrashSubmission(ctx context.Context, id string, params *GetBetaFeedbackSubmissionQuery) (*BetaFeedbackCrashSubmissionResponse, *Response, error) {
+	url := fmt.Sprintf("betaFeedbackCrashSubmissions/%s", id)
+	res := new(BetaFeedbackCrashSubmissionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// DeleteBetaFeedbackCrashSubmission deletes a crash feedback submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_beta_feedback_crash_submission
+func (s *TestflightService) DeleteBetaFeedbackCrashSubmission(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("betaFeedbackCrashSubmissions/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetCrashLogForBetaFeedbackCrashSubmission gets the crash log of a crash feedback submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_crash_log_for_a_beta_feedback_crash_submission
+func (s *TestflightService) GetCrashLogForBetaFeedbackCrashSubmission(ctx context.Context, id string) (*BetaCrashLogResponse, *Response, error) {
+	url := fmt.Sprintf("betaFeedbackCrashSubmissions/%s/crashLog", id)
+	res := new(BetaCrashLogResponse)
+	resp, err := s.client.get(ctx, url, nil, res)
+
+	return res, resp, err
+}
+
+// DownloadCrashLogForBetaFeedbackCrashSubmission writes the text of the crash log of a crash feedback
+// submission to the given writer.
+func (s *TestflightService) DownloadCrashLogForBetaFeedbackCrashSubmission(ctx context.Context, id string, w io.Writer) (*Response, error) {
+	crashLog, resp, err := s.GetCrashLogForBetaFeedbackCrashSubmission(ctx, id)
+	if err != nil {
+		return resp, err
+	}
+
+	if crashLog.Data.Attributes == nil || crashLog.Data.Attributes.LogText == nil {
+		return resp, ErrMissingCrashLogText
+	}
+
+	_, err = io.WriteString(w, *crashLog.Data.Attributes.LogText)
+
+	return resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in BetaFeedbackSubmissionResponseIncluded.
+func (i *BetaFeedbackSubmissionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// BetaTester returns the BetaTester stored within, if one is present.
+func (i *BetaFeedbackSubmissionResponseIncluded) BetaTester() *BetaTester {
+	return extractIncludedBetaTester(i.inner)
+}
+
+// Build returns the Build stored within, if one is present.
+func (i *BetaFeedbackSubmissionResponseIncluded) Build() *Build {
+	return extractIncludedBuild(i.inner)
+}
@@ -0,0 +1,155 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc // nolint: dupl
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBetaFeedbackScreenshotSubmissionsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaFeedbackScreenshotSubmissionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.ListBetaFeedbackScreenshotSubmissionsForApp(ctx, "10", &ListBetaFeedbackSubmissionsForAppQuery{})
+	})
+}
+
+func TestGetBetaFeedbackScreenshotSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaFeedbackScreenshotSubmissionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetBetaFeedbackScreenshotSubmission(ctx, "10", &GetBetaFeedbackSubmissionQuery{})
+	})
+}
+
+func TestDeleteBetaFeedbackScreenshotSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.TestFlight.DeleteBetaFeedbackScreenshotSubmission(ctx, "10")
+	})
+}
+
+func TestListBetaFeedbackCrashSubmissionsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaFeedbackCrashSubmissionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.ListBetaFeedbackCrashSubmissionsForApp(ctx, "10", &ListBetaFeedbackSubmissionsForAppQuery{})
+	})
+}
+
+func TestGetBetaFeedbackCrashSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaFeedbackCrashSubmissionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetBetaFeedbackCrashSubmission(ctx, "10", &GetBetaFeedbackSubmissionQuery{})
+	})
+}
+
+func TestDeleteBetaFeedbackCrashSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.TestFlight.DeleteBetaFeedbackCrashSubmission(ctx, "10")
+	})
+}
+
+func TestGetCrashLogForBetaFeedbackCrashSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaCrashLogResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetCrashLogForBetaFeedbackCrashSubmission(ctx, "10")
+	})
+}
+
+func TestGetBetaFeedbackScreenshotSubmissionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"betaTesters"},{"type":"builds"}]}`, func(ctx context.Context, client *Client) {
+		submission, _, err := client.TestFlight.GetBetaFeedbackScreenshotSubmission(ctx, "10", &GetBetaFeedbackSubmissionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, submission.Included)
+
+		assert.NotNil(t, submission.Included[0].BetaTester())
+		assert.NotNil(t, submission.Included[1].Build())
+
+		assert.Nil(t, submission.Included[0].Build())
+		assert.Nil(t, submission.Included[1].BetaTester())
+	})
+}
+
+func TestDownloadBetaFeedbackScreenshot(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("PNG", http.StatusOK, false)
+	defer server.Close()
+
+	image := &BetaFeedbackScreenshotImage{
+		URL: String(server.URL + "/screenshot.png"),
+	}
+
+	var buf bytes.Buffer
+	resp, err := client.TestFlight.DownloadBetaFeedbackScreenshot(context.Background(), image, &buf)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "PNG\n", buf.String())
+}
+
+func TestDownloadBetaFeedbackScreenshotMissingURL(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	resp, err := client.TestFlight.DownloadBetaFeedbackScreenshot(context.Background(), &BetaFeedbackScreenshotImage{}, &bytes.Buffer{})
+
+	assert.ErrorIs(t, err, ErrMissingScreenshotURL)
+	assert.Nil(t, resp)
+}
+
+func TestDownloadCrashLogForBetaFeedbackCrashSubmission(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"10","type":"betaCrashLogs","attributes":{"logText":"Incident Identifier: 1"}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := client.TestFlight.DownloadCrashLogForBetaFeedbackCrashSubmission(context.Background(), "10", &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Incident Identifier: 1", buf.String())
+}
+
+func TestDownloadCrashLogForBetaFeedbackCrashSubmissionMissingText(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"10","type":"betaCrashLogs"}}`, http.StatusOK, false)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := client.TestFlight.DownloadCrashLogForBetaFeedbackCrashSubmission(context.Background(), "10", &buf)
+
+	assert.ErrorIs(t, err, ErrMissingCrashLogText)
+}
@@ -22,9 +22,19 @@ package asc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// maxPublicLinkLimit is the largest number of testers Apple allows to join a beta group through its public link.
+const maxPublicLinkLimit = 10000
+
+// ErrInvalidPublicLinkLimit happens when a public link limit is outside of the range App Store Connect accepts.
+var ErrInvalidPublicLinkLimit = errors.New("public link limit must be between 1 and 10000")
+
+// ErrPublicLinkNotEnabled happens when rotating the public link of a beta group that has none.
+var ErrPublicLinkNotEnabled = errors.New("beta group does not have a public link enabled")
+
 // BetaGroup defines model for BetaGroup.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betagroup
@@ -55,9 +65,10 @@ type BetaGroupAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betagroup/relationships
 type BetaGroupRelationships struct {
-	App         *Relationship      `json:"app,omitempty"`
-	BetaTesters *PagedRelationship `json:"betaTesters,omitempty"`
-	Builds      *PagedRelationship `json:"builds,omitempty"`
+	App                     *Relationship      `json:"app,omitempty"`
+	BetaRecruitmentCriteria *Relationship      `json:"betaRecruitmentCriteria,omitempty"`
+	BetaTesters             *PagedRelationship `json:"betaTesters,omitempty"`
+	Builds                  *PagedRelationship `json:"builds,omitempty"`
 }
 
 // BetaGroupResponse defines model for BetaGroupResponse.
@@ -178,13 +189,14 @@ type ListBetaGroupsQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_group_information
 type GetBetaGroupQuery struct {
-	FieldsApps        []string `url:"fields[apps],omitempty"`
-	FieldsBetaGroups  []string `url:"fields[betaGroups],omitempty"`
-	FieldsBetaTesters []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds      []string `url:"fields[builds],omitempty"`
-	Include           []string `url:"include,omitempty"`
-	LimitBuilds       int      `url:"limit[builds],omitempty"`
-	LimitBetaTesters  int      `url:"limit[betaTesters],omitempty"`
+	FieldsApps                    []string `url:"fields[apps],omitempty"`
+	FieldsBetaGroups              []string `url:"fields[betaGroups],omitempty"`
+	FieldsBetaRecruitmentCriteria []string `url:"fields[betaRecruitmentCriteria],omitempty"`
+	FieldsBetaTesters             []string `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds                  []string `url:"fields[builds],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	LimitBuilds                   int      `url:"limit[builds],omitempty"`
+	LimitBetaTesters              int      `url:"limit[betaTesters],omitempty"`
 }
 
 // GetAppForBetaGroupQuery defines model for GetAppForBetaGroup
@@ -281,6 +293,70 @@ func (s *TestflightService) UpdateBetaGroup(ctx context.Context, id string, attr
 	return res, resp, err
 }
 
+// EnableBetaGroupPublicLink turns on the TestFlight public link of a beta group. A nil limit lets any number
+// of testers join, otherwise the limit must be between 1 and 10000.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_beta_group
+func (s *TestflightService) EnableBetaGroupPublicLink(ctx context.Context, id string, limit *int) (*BetaGroupResponse, *Response, error) {
+	if limit != nil && (*limit < 1 || *limit > maxPublicLinkLimit) {
+		return nil, nil, ErrInvalidPublicLinkLimit
+	}
+
+	return s.UpdateBetaGroup(ctx, id, &BetaGroupUpdateRequestAttributes{
+		PublicLinkEnabled:      Bool(true),
+		PublicLinkLimit:        limit,
+		PublicLinkLimitEnabled: Bool(limit != nil),
+	})
+}
+
+// DisableBetaGroupPublicLink turns off the TestFlight public link of a beta group. Testers that already
+// joined through the link stay in the group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_beta_group
+func (s *TestflightService) DisableBetaGroupPublicLink(ctx context.Context, id string) (*BetaGroupResponse, *Response, error) {
+	return s.UpdateBetaGroup(ctx, id, &BetaGroupUpdateRequestAttributes{
+		PublicLinkEnabled: Bool(false),
+	})
+}
+
+// SetBetaGroupFeedbackEnabled controls whether testers in a beta group can send screenshot and crash feedback.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_beta_group
+func (s *TestflightService) SetBetaGroupFeedbackEnabled(ctx context.Context, id string, enabled bool) (*BetaGroupResponse, *Response, error) {
+	return s.UpdateBetaGroup(ctx, id, &BetaGroupUpdateRequestAttributes{
+		FeedbackEnabled: Bool(enabled),
+	})
+}
+
+// RotateBetaGroupPublicLink replaces the public link of a beta group with a new one, invalidating the old link.
+//
+// App Store Connect generates a new link whenever the public link is turned back on, so the link is disabled
+// and then re-enabled with the group's existing limit settings.
+func (s *TestflightService) RotateBetaGroupPublicLink(ctx context.Context, id string) (*BetaGroupResponse, *Response, error) {
+	group, resp, err := s.GetBetaGroup(ctx, id, &GetBetaGroupQuery{
+		FieldsBetaGroups: []string{"publicLinkEnabled", "publicLinkLimit", "publicLinkLimitEnabled"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	attributes := group.Data.Attributes
+	if attributes == nil || attributes.PublicLinkEnabled == nil || !*attributes.PublicLinkEnabled {
+		return nil, resp, ErrPublicLinkNotEnabled
+	}
+
+	_, resp, err = s.DisableBetaGroupPublicLink(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.UpdateBetaGroup(ctx, id, &BetaGroupUpdateRequestAttributes{
+		PublicLinkEnabled:      Bool(true),
+		PublicLinkLimit:        attributes.PublicLinkLimit,
+		PublicLinkLimitEnabled: attributes.PublicLinkLimitEnabled,
+	})
+}
+
 // DeleteBetaGroup deletes a beta group and remove beta tester access to associated builds.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/delete_a_beta_group
@@ -431,6 +507,11 @@ func (i *BetaGroupResponseIncluded) App() *App {
 	return extractIncludedApp(i.inner)
 }
 
+// BetaRecruitmentCriterion returns the BetaRecruitmentCriterion stored within, if one is present.
+func (i *BetaGroupResponseIncluded) BetaRecruitmentCriterion() *BetaRecruitmentCriterion {
+	return extractIncludedBetaRecruitmentCriterion(i.inner)
+}
+
 // Build returns the Build stored within, if one is present.
 func (i *BetaGroupResponseIncluded) Build() *Build {
 	return extractIncludedBuild(i.inner)
@@ -22,6 +22,7 @@ package asc
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,6 +44,82 @@ func TestUpdateBetaGroup(t *testing.T) {
 	})
 }
 
+func TestEnableBetaGroupPublicLink(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.EnableBetaGroupPublicLink(ctx, "10", Int(100))
+	})
+}
+
+func TestEnableBetaGroupPublicLinkInvalidLimit(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+
+	_, _, err := client.TestFlight.EnableBetaGroupPublicLink(context.Background(), "10", Int(0))
+	assert.ErrorIs(t, err, ErrInvalidPublicLinkLimit)
+
+	_, _, err = client.TestFlight.EnableBetaGroupPublicLink(context.Background(), "10", Int(10001))
+	assert.ErrorIs(t, err, ErrInvalidPublicLinkLimit)
+}
+
+func TestDisableBetaGroupPublicLink(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.DisableBetaGroupPublicLink(ctx, "10")
+	})
+}
+
+func TestSetBetaGroupFeedbackEnabled(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.SetBetaGroupFeedbackEnabled(ctx, "10", true)
+	})
+}
+
+func TestRotateBetaGroupPublicLink(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"GET /v1/betaGroups/10":   respondWith(http.StatusOK, `{"data":{"id":"10","type":"betaGroups","attributes":{"publicLinkEnabled":true,"publicLinkLimit":50,"publicLinkLimitEnabled":true}}}`),
+		"PATCH /v1/betaGroups/10": respondWith(http.StatusOK, `{"data":{"id":"10","type":"betaGroups","attributes":{"publicLink":"https://testflight.apple.com/join/new"}}}`),
+	})
+	defer server.Close()
+
+	group, _, err := client.TestFlight.RotateBetaGroupPublicLink(context.Background(), "10")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://testflight.apple.com/join/new", *group.Data.Attributes.PublicLink)
+
+	var updates []BetaGroupUpdateRequestAttributes
+
+	for _, req := range server.requests("PATCH /v1/betaGroups/10") {
+		var body struct {
+			Data betaGroupUpdateRequest `json:"data"`
+		}
+
+		assert.NoError(t, req.decode(&body))
+		updates = append(updates, *body.Data.Attributes)
+	}
+
+	assert.Equal(t, []BetaGroupUpdateRequestAttributes{
+		{PublicLinkEnabled: Bool(false)},
+		{PublicLinkEnabled: Bool(true), PublicLinkLimit: Int(50), PublicLinkLimitEnabled: Bool(true)},
+	}, updates)
+}
+
+func TestRotateBetaGroupPublicLinkNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":{"id":"10","type":"betaGroups","attributes":{"publicLinkEnabled":false}}}`, func(ctx context.Context, client *Client) {
+		_, _, err := client.TestFlight.RotateBetaGroupPublicLink(ctx, "10")
+		assert.ErrorIs(t, err, ErrPublicLinkNotEnabled)
+	})
+}
+
 func TestDeleteBetaGroup(t *testing.T) {
 	t.Parallel()
 
@@ -70,7 +147,7 @@ func TestGetBetaGroup(t *testing.T) {
 func TestGetBetaGroupIncludeds(t *testing.T) {
 	t.Parallel()
 
-	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"builds"},{"type":"betaTesters"}]}`, func(ctx context.Context, client *Client) {
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"builds"},{"type":"betaTesters"},{"type":"betaRecruitmentCriteria"}]}`, func(ctx context.Context, client *Client) {
 		group, _, err := client.TestFlight.GetBetaGroup(ctx, "10", &GetBetaGroupQuery{})
 		assert.NoError(t, err)
 		assert.NotEmpty(t, group.Included)
@@ -78,9 +155,11 @@ func TestGetBetaGroupIncludeds(t *testing.T) {
 		assert.NotNil(t, group.Included[0].App())
 		assert.NotNil(t, group.Included[1].Build())
 		assert.NotNil(t, group.Included[2].BetaTester())
+		assert.NotNil(t, group.Included[3].BetaRecruitmentCriterion())
 
 		assert.Nil(t, group.Included[0].Build())
 		assert.Nil(t, group.Included[0].BetaTester())
+		assert.Nil(t, group.Included[0].BetaRecruitmentCriterion())
 	})
 }
 
@@ -0,0 +1,258 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// BetaRecruitmentCriterion defines model for BetaRecruitmentCriterion.
+//
+// Recruitment criteria restrict which devices can join a beta group through its public link.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterion
+type BetaRecruitmentCriterion struct {
+	Attributes *BetaRecruitmentCriterionAttributes `json:"attributes,omitempty"`
+	ID         string                              `json:"id"`
+	Links      ResourceLinks                       `json:"links"`
+	Type       string                              `json:"type"`
+}
+
+// BetaRecruitmentCriterionAttributes defines model for BetaRecruitmentCriterion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterion/attributes
+type BetaRecruitmentCriterionAttributes struct {
+	DeviceFamilyOSVersionFilters []DeviceFamilyOSVersionFilter `json:"deviceFamilyOsVersionFilters,omitempty"`
+	LastModifiedDate             *DateTime                     `json:"lastModifiedDate,omitempty"`
+}
+
+// DeviceFamilyOSVersionFilter defines model for DeviceFamilyOsVersionFilter.
+//
+// Leaving both OS bounds unset admits every OS version of the device family.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/devicefamilyosversionfilter
+type DeviceFamilyOSVersionFilter struct {
+	DeviceFamily       *DeviceFamily `json:"deviceFamily,omitempty"`
+	MaximumOSInclusive *string       `json:"maximumOsInclusive,omitempty"`
+	MinimumOSInclusive *string       `json:"minimumOsInclusive,omitempty"`
+}
+
+// BetaRecruitmentCriterionResponse defines model for BetaRecruitmentCriterionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionresponse
+type BetaRecruitmentCriterionResponse struct {
+	Data  BetaRecruitmentCriterion `json:"data"`
+	Links DocumentLinks            `json:"links"`
+}
+
+// betaRecruitmentCriterionCreateRequest defines model for BetaRecruitmentCriterionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterioncreaterequest/data
+type betaRecruitmentCriterionCreateRequest struct {
+	Attributes    betaRecruitmentCriterionCreateRequestAttributes    `json:"attributes"`
+	Relationships betaRecruitmentCriterionCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// betaRecruitmentCriterionCreateRequestAttributes are attributes for BetaRecruitmentCriterionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterioncreaterequest/data/attributes
+type betaRecruitmentCriterionCreateRequestAttributes struct {
+	DeviceFamilyOSVersionFilters []DeviceFamilyOSVersionFilter `json:"deviceFamilyOsVersionFilters"`
+}
+
+// betaRecruitmentCriterionCreateRequestRelationships are relationships for BetaRecruitmentCriterionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterioncreaterequest/data/relationships
+type betaRecruitmentCriterionCreateRequestRelationships struct {
+	BetaGroup relationshipDeclaration `json:"betaGroup"`
+}
+
+// betaRecruitmentCriterionUpdateRequest defines model for BetaRecruitmentCriterionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionupdaterequest/data
+type betaRecruitmentCriterionUpdateRequest struct {
+	Attributes betaRecruitmentCriterionUpdateRequestAttributes `json:"attributes"`
+	ID         string                                          `json:"id"`
+	Type       string                                          `json:"type"`
+}
+
+// betaRecruitmentCriterionUpdateRequestAttributes are attributes for BetaRecruitmentCriterionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionupdaterequest/data/attributes
+type betaRecruitmentCriterionUpdateRequestAttributes struct {
+	DeviceFamilyOSVersionFilters []DeviceFamilyOSVersionFilter `json:"deviceFamilyOsVersionFilters"`
+}
+
+// BetaRecruitmentCriterionOption defines model for BetaRecruitmentCriterionOption.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionoption
+type BetaRecruitmentCriterionOption struct {
+	Attributes *BetaRecruitmentCriterionOptionAttributes `json:"attributes,omitempty"`
+	ID         string                                    `json:"id"`
+	Links      ResourceLinks                             `json:"links"`
+	Type       string                                    `json:"type"`
+}
+
+// BetaRecruitmentCriterionOptionAttributes defines model for BetaRecruitmentCriterionOption.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionoption/attributes
+type BetaRecruitmentCriterionOptionAttributes struct {
+	DeviceFamilyOSVersions []DeviceFamilyOSVersions `json:"deviceFamilyOsVersions,omitempty"`
+}
+
+// DeviceFamilyOSVersions defines model for BetaRecruitmentCriterionOption.Attributes.DeviceFamilyOsVersions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionoption/attributes/devicefamilyosversions
+type DeviceFamilyOSVersions struct {
+	DeviceFamily *DeviceFamily `json:"deviceFamily,omitempty"`
+	OSVersions   []string      `json:"osVersions,omitempty"`
+}
+
+// BetaRecruitmentCriterionOptionsResponse defines model for BetaRecruitmentCriterionOptionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterionoptionsresponse
+type BetaRecruitmentCriterionOptionsResponse struct {
+	Data  []BetaRecruitmentCriterionOption `json:"data"`
+	Links PagedDocumentLinks               `json:"links"`
+	Meta  *PagingInformation               `json:"meta,omitempty"`
+}
+
+// BetaRecruitmentCriterionCompatibleBuildCheck defines model for BetaRecruitmentCriterionCompatibleBuildCheck.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterioncompatiblebuildcheck
+type BetaRecruitmentCriterionCompatibleBuildCheck struct {
+	Attributes *BetaRecruitmentCriterionCompatibleBuildCheckAttributes `json:"attributes,omitempty"`
+	ID         string                                                  `json:"id"`
+	Links      ResourceLinks                                           `json:"links"`
+	Type       string                                                  `json:"type"`
+}
+
+// BetaRecruitmentCriterionCompatibleBuildCheckAttributes defines model for BetaRecruitmentCriterionCompatibleBuildCheck.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterioncompatiblebuildcheck/attributes
+type BetaRecruitmentCriterionCompatibleBuildCheckAttributes struct {
+	HasCompatibleBuild *bool `json:"hasCompatibleBuild,omitempty"`
+}
+
+// BetaRecruitmentCriterionCompatibleBuildCheckResponse defines model for BetaRecruitmentCriterionCompatibleBuildCheckResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/betarecruitmentcriterioncompatiblebuildcheckresponse
+type BetaRecruitmentCriterionCompatibleBuildCheckResponse struct {
+	Data  BetaRecruitmentCriterionCompatibleBuildCheck `json:"data"`
+	Links DocumentLinks                                `json:"links"`
+}
+
+// ListBetaRecruitmentCriterionOptionsQuery are query options for ListBetaRecruitmentCriterionOptions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_beta_recruitment_criterion_options
+type ListBetaRecruitmentCriterionOptionsQuery struct {
+	FieldsBetaRecruitmentCriterionOptions []string `url:"fields[betaRecruitmentCriterionOptions],omitempty"`
+	Limit                                 int      `url:"limit,omitempty"`
+	Cursor                                string   `url:"cursor,omitempty"`
+}
+
+// GetBetaRecruitmentCriterionForBetaGroupQuery are query options for GetBetaRecruitmentCriterionForBetaGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_recruitment_criteria_of_a_beta_group
+type GetBetaRecruitmentCriterionForBetaGroupQuery struct {
+	FieldsBetaRecruitmentCriteria []string `url:"fields[betaRecruitmentCriteria],omitempty"`
+}
+
+// ListBetaRecruitmentCriterionOptions lists the device families and OS versions that recruitment criteria can be built from.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_beta_recruitment_criterion_options
+func (s *TestflightService) ListBetaRecruitmentCriterionOptions(ctx context.Context, params *ListBetaRecruitmentCriterionOptionsQuery) (*BetaRecruitmentCriterionOptionsResponse, *Response, error) {
+	res := new(BetaRecruitmentCriterionOptionsResponse)
+	resp, err := s.client.get(ctx, "betaRecruitmentCriterionOptions", params, res)
+
+	return res, resp, err
+}
+
+// GetBetaRecruitmentCriterionForBetaGroup gets the recruitment criteria of a beta group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_recruitment_criteria_of_a_beta_group
+func (s *TestflightService) GetBetaRecruitmentCriterionForBetaGroup(ctx context.Context, id string, params *GetBetaRecruitmentCriterionForBetaGroupQuery) (*BetaRecruitmentCriterionResponse, *Response, error) {
+	url := fmt.Sprintf("betaGroups/%s/betaRecruitmentCriteria", id)
+	res := new(BetaRecruitmentCriterionResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// CreateBetaRecruitmentCriterion sets the device families and OS versions that can join a beta group through its public link.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_beta_recruitment_criterion
+func (s *TestflightService) CreateBetaRecruitmentCriterion(ctx context.Context, filters []DeviceFamilyOSVersionFilter, betaGroupID string) (*BetaRecruitmentCriterionResponse, *Response, error) {
+	req := betaRecruitmentCriterionCreateRequest{
+		Attributes: betaRecruitmentCriterionCreateRequestAttributes{
+			DeviceFamilyOSVersionFilters: filters,
+		},
+		Relationships: betaRecruitmentCriterionCreateRequestRelationships{
+			BetaGroup: *newRelationshipDeclaration(&betaGroupID, "betaGroups"),
+		},
+		Type: "betaRecruitmentCriteria",
+	}
+	res := new(BetaRecruitmentCriterionResponse)
+	resp, err := s.client.post(ctx, "betaRecruitmentCriteria", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateBetaRecruitmentCriterion replaces the device family and OS version filters of a recruitment criterion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_beta_recruitment_criterion
+func (s *TestflightService) UpdateBetaRecruitmentCriterion(ctx context.Context, id string, filters []DeviceFamilyOSVersionFilter) (*BetaRecruitmentCriterionResponse, *Response, error) {
+	req := betaRecruitmentCriterionUpdateRequest{
+		Attributes: betaRecruitmentCriterionUpdateRequestAttributes{
+			DeviceFamilyOSVersionFilters: filters,
+		},
+		ID:   id,
+		Type: "betaRecruitmentCriteria",
+	}
+	url := fmt.Sprintf("betaRecruitmentCriteria/%s", id)
+	res := new(BetaRecruitmentCriterionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteBetaRecruitmentCriterion deletes a recruitment criterion, letting any device join the beta group through its public link.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_beta_recruitment_criterion
+func (s *TestflightService) DeleteBetaRecruitmentCriterion(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("betaRecruitmentCriteria/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetBetaRecruitmentCriterionCompatibleBuildCheckForBetaGroup checks whether a beta group has a build that
+// the devices admitted by its recruitment criteria can install.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_recruitment_criterion_compatible_build_check_of_a_beta_group
+func (s *TestflightService) GetBetaRecruitmentCriterionCompatibleBuildCheckForBetaGroup(ctx context.Context, id string) (*BetaRecruitmentCriterionCompatibleBuildCheckResponse, *Response, error) {
+	url := fmt.Sprintf("betaGroups/%s/betaRecruitmentCriterionCompatibleBuildCheck", id)
+	res := new(BetaRecruitmentCriterionCompatibleBuildCheckResponse)
+	resp, err := s.client.get(ctx, url, nil, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,316 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// BetaUsagePeriod defines the reporting periods available for TestFlight usage metrics.
+type BetaUsagePeriod string
+
+const (
+	// BetaUsagePeriodSevenDays is a usage period for the last 7 days.
+	BetaUsagePeriodSevenDays BetaUsagePeriod = "P7D"
+	// BetaUsagePeriodThirtyDays is a usage period for the last 30 days.
+	BetaUsagePeriodThirtyDays BetaUsagePeriod = "P30D"
+	// BetaUsagePeriodNinetyDays is a usage period for the last 90 days.
+	BetaUsagePeriodNinetyDays BetaUsagePeriod = "P90D"
+	// BetaUsagePeriodOneYear is a usage period for the last 365 days.
+	BetaUsagePeriodOneYear BetaUsagePeriod = "P365D"
+)
+
+// MetricDimension defines model for a dimension that metric data points were grouped by.
+type MetricDimension struct {
+	Data  *string               `json:"data,omitempty"`
+	Links *MetricDimensionLinks `json:"links,omitempty"`
+}
+
+// MetricDimensionLinks defines model for MetricDimension.Links
+type MetricDimensionLinks struct {
+	GroupBy *string `json:"groupBy,omitempty"`
+	Related *string `json:"related,omitempty"`
+}
+
+// BetaTesterUsageMetric defines model for one series of BetaTesterUsagesV1MetricResponse.Data
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appsbetatesterusagesv1metricresponse/data
+type BetaTesterUsageMetric struct {
+	DataPoints []BetaTesterUsageDataPoint       `json:"dataPoints,omitempty"`
+	Dimensions *BetaTesterUsageMetricDimensions `json:"dimensions,omitempty"`
+}
+
+// BetaTesterUsageMetricDimensions defines model for BetaTesterUsageMetric.Dimensions
+//
+// BetaTesters is set when the series is grouped by tester, and Apps is set when the usage of a
+// single tester is listed per app.
+type BetaTesterUsageMetricDimensions struct {
+	Apps        *MetricDimension `json:"apps,omitempty"`
+	BetaTesters *MetricDimension `json:"betaTesters,omitempty"`
+}
+
+// BetaTesterUsageDataPoint defines model for BetaTesterUsageMetric.DataPoints
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appsbetatesterusagesv1metricresponse/data/datapoints
+type BetaTesterUsageDataPoint struct {
+	End    *DateTime                  `json:"end,omitempty"`
+	Start  *DateTime                  `json:"start,omitempty"`
+	Values *BetaTesterUsageDataValues `json:"values,omitempty"`
+}
+
+// BetaTesterUsageDataValues defines model for BetaTesterUsageDataPoint.Values
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appsbetatesterusagesv1metricresponse/data/datapoints/values
+type BetaTesterUsageDataValues struct {
+	CrashCount    *int `json:"crashCount,omitempty"`
+	FeedbackCount *int `json:"feedbackCount,omitempty"`
+	SessionCount  *int `json:"sessionCount,omitempty"`
+}
+
+// BetaTesterUsagesResponse defines model for AppsBetaTesterUsagesV1MetricResponse,
+// BetaGroupsBetaTesterUsagesV1MetricResponse and BetaTesterUsagesV1MetricResponse.
+//
+// Included is only populated when the series are grouped by tester.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appsbetatesterusagesv1metricresponse
+// https://developer.apple.com/documentation/appstoreconnectapi/betagroupsbetatesterusagesv1metricresponse
+// https://developer.apple.com/documentation/appstoreconnectapi/betatesterusagesv1metricresponse
+type BetaTesterUsagesResponse struct {
+	Data     []BetaTesterUsageMetric `json:"data"`
+	Included []BetaTester            `json:"included,omitempty"`
+	Links    PagedDocumentLinks      `json:"links"`
+	Meta     *PagingInformation      `json:"meta,omitempty"`
+}
+
+// BetaBuildUsageMetric defines model for one series of BuildsBetaBuildUsagesV1MetricResponse.Data
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/buildsbetabuildusagesv1metricresponse/data
+type BetaBuildUsageMetric struct {
+	DataPoints []BetaBuildUsageDataPoint `json:"dataPoints,omitempty"`
+}
+
+// BetaBuildUsageDataPoint defines model for BetaBuildUsageMetric.DataPoints
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/buildsbetabuildusagesv1metricresponse/data/datapoints
+type BetaBuildUsageDataPoint struct {
+	End    *DateTime                 `json:"end,omitempty"`
+	Start  *DateTime                 `json:"start,omitempty"`
+	Values *BetaBuildUsageDataValues `json:"values,omitempty"`
+}
+
+// BetaBuildUsageDataValues defines model for BetaBuildUsageDataPoint.Values
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/buildsbetabuildusagesv1metricresponse/data/datapoints/values
+type BetaBuildUsageDataValues struct {
+	CrashCount    *int `json:"crashCount,omitempty"`
+	FeedbackCount *int `json:"feedbackCount,omitempty"`
+	InstallCount  *int `json:"installCount,omitempty"`
+	InviteCount   *int `json:"inviteCount,omitempty"`
+	SessionCount  *int `json:"sessionCount,omitempty"`
+}
+
+// BetaBuildUsagesResponse defines model for BuildsBetaBuildUsagesV1MetricResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/buildsbetabuildusagesv1metricresponse
+type BetaBuildUsagesResponse struct {
+	Data  []BetaBuildUsageMetric `json:"data"`
+	Links PagedDocumentLinks     `json:"links"`
+	Meta  *PagingInformation     `json:"meta,omitempty"`
+}
+
+// BetaTesterUsageTotals is the sum of the data points of a BetaTesterUsageMetric.
+type BetaTesterUsageTotals struct {
+	CrashCount    int
+	FeedbackCount int
+	SessionCount  int
+}
+
+// BetaBuildUsageTotals is the sum of the data points of a BetaBuildUsageMetric.
+type BetaBuildUsageTotals struct {
+	CrashCount    int
+	FeedbackCount int
+	InstallCount  int
+	InviteCount   int
+	SessionCount  int
+}
+
+// GetBetaTesterUsagesForAppQuery are query options for GetBetaTesterUsagesForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_beta_tester_usage_metrics_for_an_app
+type GetBetaTesterUsagesForAppQuery struct {
+	FilterBetaTesters []string        `url:"filter[betaTesters],omitempty"`
+	GroupBy           []string        `url:"groupBy,omitempty"`
+	Limit             int             `url:"limit,omitempty"`
+	Period            BetaUsagePeriod `url:"period,omitempty"`
+	Cursor            string          `url:"cursor,omitempty"`
+}
+
+// GetBetaTesterUsagesForBetaGroupQuery are query options for GetBetaTesterUsagesForBetaGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_beta_tester_usage_metrics_for_a_beta_group
+type GetBetaTesterUsagesForBetaGroupQuery struct {
+	FilterBetaTesters []string        `url:"filter[betaTesters],omitempty"`
+	GroupBy           []string        `url:"groupBy,omitempty"`
+	Limit             int             `url:"limit,omitempty"`
+	Period            BetaUsagePeriod `url:"period,omitempty"`
+	Cursor            string          `url:"cursor,omitempty"`
+}
+
+// GetUsagesForBetaTesterQuery are query options for GetUsagesForBetaTester
+//
+// FilterApps is required by the API.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_usage_metrics_for_a_beta_tester
+type GetUsagesForBetaTesterQuery struct {
+	FilterApps []string        `url:"filter[apps],omitempty"`
+	Limit      int             `url:"limit,omitempty"`
+	Period     BetaUsagePeriod `url:"period,omitempty"`
+	Cursor     string          `url:"cursor,omitempty"`
+}
+
+// GetBetaBuildUsagesForBuildQuery are query options for GetBetaBuildUsagesForBuild
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_beta_build_usage_metrics_for_a_build
+type GetBetaBuildUsagesForBuildQuery struct {
+	Limit  int    `url:"limit,omitempty"`
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// GetBetaTesterUsagesForApp gets the usage of an app's builds by its beta testers over a period.
+//
+// Set GroupBy to "betaTesters" to get one series per tester.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_beta_tester_usage_metrics_for_an_app
+func (s *TestflightService) GetBetaTesterUsagesForApp(ctx context.Context, id string, params *GetBetaTesterUsagesForAppQuery) (*BetaTesterUsagesResponse, *Response, error) {
+	url := fmt.Sprintf("apps/%s/metrics/betaTesterUsages", id)
+	res := new(BetaTesterUsagesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetBetaTesterUsagesForBetaGroup gets the usage of the testers in a beta group over a period.
+//
+// Set GroupBy to "betaTesters" to get one series per tester.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_beta_tester_usage_metrics_for_a_beta_group
+func (s *TestflightService) GetBetaTesterUsagesForBetaGroup(ctx context.Context, id string, params *GetBetaTesterUsagesForBetaGroupQuery) (*BetaTesterUsagesResponse, *Response, error) {
+	url := fmt.Sprintf("betaGroups/%s/metrics/betaTesterUsages", id)
+	res := new(BetaTesterUsagesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetUsagesForBetaTester gets the usage of a single beta tester over a period, with one series per app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_usage_metrics_for_a_beta_tester
+func (s *TestflightService) GetUsagesForBetaTester(ctx context.Context, id string, params *GetUsagesForBetaTesterQuery) (*BetaTesterUsagesResponse, *Response, error) {
+	url := fmt.Sprintf("betaTesters/%s/metrics/betaTesterUsages", id)
+	res := new(BetaTesterUsagesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetBetaBuildUsagesForBuild gets the installs, sessions, crashes and feedback of a build.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_beta_build_usage_metrics_for_a_build
+func (s *TestflightService) GetBetaBuildUsagesForBuild(ctx context.Context, id string, params *GetBetaBuildUsagesForBuildQuery) (*BetaBuildUsagesResponse, *Response, error) {
+	url := fmt.Sprintf("builds/%s/metrics/betaBuildUsages", id)
+	res := new(BetaBuildUsagesResponse)
+	resp, err := s.client.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// BetaTesterID returns the ID of the tester a series belongs to when the usages were grouped by tester.
+func (m BetaTesterUsageMetric) BetaTesterID() string {
+	if m.Dimensions == nil || m.Dimensions.BetaTesters == nil || m.Dimensions.BetaTesters.Data == nil {
+		return ""
+	}
+
+	return *m.Dimensions.BetaTesters.Data
+}
+
+// Totals sums the values of every data point in the series.
+func (m BetaTesterUsageMetric) Totals() BetaTesterUsageTotals {
+	var totals BetaTesterUsageTotals
+
+	for _, point := range m.DataPoints {
+		if point.Values == nil {
+			continue
+		}
+
+		totals.CrashCount += intValue(point.Values.CrashCount)
+		totals.FeedbackCount += intValue(point.Values.FeedbackCount)
+		totals.SessionCount += intValue(point.Values.SessionCount)
+	}
+
+	return totals
+}
+
+// Totals sums the values of every data point in the series.
+func (m BetaBuildUsageMetric) Totals() BetaBuildUsageTotals {
+	var totals BetaBuildUsageTotals
+
+	for _, point := range m.DataPoints {
+		if point.Values == nil {
+			continue
+		}
+
+		totals.CrashCount += intValue(point.Values.CrashCount)
+		totals.FeedbackCount += intValue(point.Values.FeedbackCount)
+		totals.InstallCount += intValue(point.Values.InstallCount)
+		totals.InviteCount += intValue(point.Values.InviteCount)
+		totals.SessionCount += intValue(point.Values.SessionCount)
+	}
+
+	return totals
+}
+
+// InactiveBetaTesterIDs returns the IDs of the testers in a response grouped by tester that had no sessions
+// in the requested period.
+func (r *BetaTesterUsagesResponse) InactiveBetaTesterIDs() []string {
+	ids := make([]string, 0)
+
+	for _, metric := range r.Data {
+		id := metric.BetaTesterID()
+		if id == "" {
+			continue
+		}
+
+		if metric.Totals().SessionCount == 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
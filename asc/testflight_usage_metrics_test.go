@@ -0,0 +1,99 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBetaTesterUsagesForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaTesterUsagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetBetaTesterUsagesForApp(ctx, "10", &GetBetaTesterUsagesForAppQuery{})
+	})
+}
+
+func TestGetBetaTesterUsagesForBetaGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaTesterUsagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetBetaTesterUsagesForBetaGroup(ctx, "10", &GetBetaTesterUsagesForBetaGroupQuery{})
+	})
+}
+
+func TestGetUsagesForBetaTester(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaTesterUsagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetUsagesForBetaTester(ctx, "10", &GetUsagesForBetaTesterQuery{})
+	})
+}
+
+func TestGetBetaBuildUsagesForBuild(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BetaBuildUsagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.TestFlight.GetBetaBuildUsagesForBuild(ctx, "10", &GetBetaBuildUsagesForBuildQuery{})
+	})
+}
+
+func TestBetaTesterUsagesTotals(t *testing.T) {
+	t.Parallel()
+
+	raw := `{"data":[` +
+		`{"dataPoints":[{"start":"2024-01-01T00:00:00Z","end":"2024-01-02T00:00:00Z","values":{"crashCount":1,"sessionCount":4,"feedbackCount":2}},{"values":{"sessionCount":3}}],"dimensions":{"betaTesters":{"data":"1"}}},` +
+		`{"dataPoints":[{"values":{"sessionCount":0}}],"dimensions":{"betaTesters":{"data":"2"}}},` +
+		`{"dataPoints":[],"dimensions":{"betaTesters":{"data":"3"}}}` +
+		`],"links":{"self":""}}`
+
+	client, server := newServer(raw, http.StatusOK, false)
+	defer server.Close()
+
+	usages, _, err := client.TestFlight.GetBetaTesterUsagesForApp(context.Background(), "10", &GetBetaTesterUsagesForAppQuery{
+		GroupBy: []string{"betaTesters"},
+		Period:  BetaUsagePeriodThirtyDays,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1", usages.Data[0].BetaTesterID())
+	assert.Equal(t, BetaTesterUsageTotals{CrashCount: 1, FeedbackCount: 2, SessionCount: 7}, usages.Data[0].Totals())
+	assert.Equal(t, []string{"2", "3"}, usages.InactiveBetaTesterIDs())
+	assert.Empty(t, BetaTesterUsageMetric{}.BetaTesterID())
+}
+
+func TestBetaBuildUsagesTotals(t *testing.T) {
+	t.Parallel()
+
+	raw := `{"data":[{"dataPoints":[{"values":{"crashCount":2,"installCount":10,"inviteCount":12,"sessionCount":30,"feedbackCount":1}},{"values":{"installCount":1,"sessionCount":5}},{}]}],"links":{"self":""}}`
+
+	client, server := newServer(raw, http.StatusOK, false)
+	defer server.Close()
+
+	usages, _, err := client.TestFlight.GetBetaBuildUsagesForBuild(context.Background(), "10", &GetBetaBuildUsagesForBuildQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, BetaBuildUsageTotals{CrashCount: 2, FeedbackCount: 1, InstallCount: 11, InviteCount: 12, SessionCount: 35}, usages.Data[0].Totals())
+}
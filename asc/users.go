@@ -29,6 +29,7 @@ import (
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/users
 // https://developer.apple.com/documentation/appstoreconnectapi/user_invitations
+// https://developer.apple.com/documentation/appstoreconnectapi/sandbox_testers
 type UsersService service
 
 // UserRole defines model for UserRole.
@@ -0,0 +1,186 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SandboxTesterSubscriptionRenewalRate defines model for SandboxTesterV2.Attributes.SubscriptionRenewalRate
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtesterv2/attributes
+type SandboxTesterSubscriptionRenewalRate string
+
+const (
+	// SandboxTesterSubscriptionRenewalRateEveryOneHour is a subscription renewal rate for MonthlyRenewalEveryOneHour.
+	SandboxTesterSubscriptionRenewalRateEveryOneHour SandboxTesterSubscriptionRenewalRate = "MONTHLY_RENEWAL_EVERY_ONE_HOUR"
+	// SandboxTesterSubscriptionRenewalRateEveryThirtyMinutes is a subscription renewal rate for MonthlyRenewalEveryThirtyMinutes.
+	SandboxTesterSubscriptionRenewalRateEveryThirtyMinutes SandboxTesterSubscriptionRenewalRate = "MONTHLY_RENEWAL_EVERY_THIRTY_MINUTES"
+	// SandboxTesterSubscriptionRenewalRateEveryFifteenMinutes is a subscription renewal rate for MonthlyRenewalEveryFifteenMinutes.
+	SandboxTesterSubscriptionRenewalRateEveryFifteenMinutes SandboxTesterSubscriptionRenewalRate = "MONTHLY_RENEWAL_EVERY_FIFTEEN_MINUTES"
+	// SandboxTesterSubscriptionRenewalRateEveryFiveMinutes is a subscription renewal rate for MonthlyRenewalEveryFiveMinutes.
+	SandboxTesterSubscriptionRenewalRateEveryFiveMinutes SandboxTesterSubscriptionRenewalRate = "MONTHLY_RENEWAL_EVERY_FIVE_MINUTES"
+	// SandboxTesterSubscriptionRenewalRateEveryThreeMinutes is a subscription renewal rate for MonthlyRenewalEveryThreeMinutes.
+	SandboxTesterSubscriptionRenewalRateEveryThreeMinutes SandboxTesterSubscriptionRenewalRate = "MONTHLY_RENEWAL_EVERY_THREE_MINUTES"
+)
+
+// SandboxTester defines model for SandboxTesterV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtesterv2
+type SandboxTester struct {
+	Attributes *SandboxTesterAttributes `json:"attributes,omitempty"`
+	ID         string                   `json:"id"`
+	Links      ResourceLinks            `json:"links"`
+	Type       string                   `json:"type"`
+}
+
+// SandboxTesterAttributes defines model for SandboxTesterV2.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtesterv2/attributes
+type SandboxTesterAttributes struct {
+	ACAccountName           *string                               `json:"acAccountName,omitempty"`
+	ApplePayCompatible      *bool                                 `json:"applePayCompatible,omitempty"`
+	FirstName               *string                               `json:"firstName,omitempty"`
+	InterruptPurchases      *bool                                 `json:"interruptPurchases,omitempty"`
+	LastName                *string                               `json:"lastName,omitempty"`
+	SubscriptionRenewalRate *SandboxTesterSubscriptionRenewalRate `json:"subscriptionRenewalRate,omitempty"`
+	Territory               *string                               `json:"territory,omitempty"`
+}
+
+// sandboxTesterUpdateRequest defines model for SandboxTesterV2UpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtesterv2updaterequest/data
+type sandboxTesterUpdateRequest struct {
+	Attributes *SandboxTesterUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                `json:"id"`
+	Type       string                                `json:"type"`
+}
+
+// SandboxTesterUpdateRequestAttributes are attributes for SandboxTesterV2UpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtesterv2updaterequest/data/attributes
+type SandboxTesterUpdateRequestAttributes struct {
+	InterruptPurchases      *bool                                 `json:"interruptPurchases,omitempty"`
+	SubscriptionRenewalRate *SandboxTesterSubscriptionRenewalRate `json:"subscriptionRenewalRate,omitempty"`
+	Territory               *string                               `json:"territory,omitempty"`
+}
+
+// SandboxTesterResponse defines model for SandboxTesterV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtesterv2response
+type SandboxTesterResponse struct {
+	Data  SandboxTester `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// SandboxTestersResponse defines model for SandboxTestersV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtestersv2response
+type SandboxTestersResponse struct {
+	Data  []SandboxTester    `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// SandboxTestersClearPurchaseHistoryRequest defines model for SandboxTestersClearPurchaseHistoryRequestV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtestersclearpurchasehistoryrequestv2
+type SandboxTestersClearPurchaseHistoryRequest struct {
+	ID    string        `json:"id"`
+	Links ResourceLinks `json:"links"`
+	Type  string        `json:"type"`
+}
+
+// sandboxTestersClearPurchaseHistoryRequestCreateRequest defines model for SandboxTestersClearPurchaseHistoryRequestV2CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtestersclearpurchasehistoryrequestv2createrequest/data
+type sandboxTestersClearPurchaseHistoryRequestCreateRequest struct {
+	Relationships sandboxTestersClearPurchaseHistoryRequestCreateRequestRelationships `json:"relationships"`
+	Type          string                                                              `json:"type"`
+}
+
+// sandboxTestersClearPurchaseHistoryRequestCreateRequestRelationships are relationships for SandboxTestersClearPurchaseHistoryRequestV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtestersclearpurchasehistoryrequestv2createrequest/data/relationships
+type sandboxTestersClearPurchaseHistoryRequestCreateRequestRelationships struct {
+	SandboxTesters pagedRelationshipDeclaration `json:"sandboxTesters"`
+}
+
+// SandboxTestersClearPurchaseHistoryRequestResponse defines model for SandboxTestersClearPurchaseHistoryRequestV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/sandboxtestersclearpurchasehistoryrequestv2response
+type SandboxTestersClearPurchaseHistoryRequestResponse struct {
+	Data  SandboxTestersClearPurchaseHistoryRequest `json:"data"`
+	Links DocumentLinks                             `json:"links"`
+}
+
+// ListSandboxTestersQuery are query options for ListSandboxTesters
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_sandbox_testers
+type ListSandboxTestersQuery struct {
+	FieldsSandboxTesters []string `url:"fields[sandboxTesters],omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// ListSandboxTesters lists the sandbox testers of your team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_sandbox_testers
+func (s *UsersService) ListSandboxTesters(ctx context.Context, params *ListSandboxTestersQuery) (*SandboxTestersResponse, *Response, error) {
+	res := new(SandboxTestersResponse)
+	resp, err := s.client.get(ctx, versionedPath("v2", "sandboxTesters"), params, res)
+
+	return res, resp, err
+}
+
+// UpdateSandboxTester changes the storefront territory of a sandbox tester, whether its purchases are
+// interrupted, and how quickly its subscriptions renew.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_sandbox_tester
+func (s *UsersService) UpdateSandboxTester(ctx context.Context, id string, attributes *SandboxTesterUpdateRequestAttributes) (*SandboxTesterResponse, *Response, error) {
+	req := sandboxTesterUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "sandboxTesters",
+	}
+	url := versionedPath("v2", fmt.Sprintf("sandboxTesters/%s", id))
+	res := new(SandboxTesterResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ClearPurchaseHistoryForSandboxTesters clears the in-app purchase history of one or more sandbox testers.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/clear_purchase_history_for_a_list_of_sandbox_testers
+func (s *UsersService) ClearPurchaseHistoryForSandboxTesters(ctx context.Context, sandboxTesterIDs []string) (*SandboxTestersClearPurchaseHistoryRequestResponse, *Response, error) {
+	req := sandboxTestersClearPurchaseHistoryRequestCreateRequest{
+		Relationships: sandboxTestersClearPurchaseHistoryRequestCreateRequestRelationships{
+			SandboxTesters: newPagedRelationshipDeclaration(sandboxTesterIDs, "sandboxTesters"),
+		},
+		Type: "sandboxTestersClearPurchaseHistoryRequest",
+	}
+	res := new(SandboxTestersClearPurchaseHistoryRequestResponse)
+	resp, err := s.client.post(ctx, versionedPath("v2", "sandboxTestersClearPurchaseHistoryRequest"), newRequestBody(req), res)
+
+	return res, resp, err
+}
@@ -0,0 +1,75 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSandboxTesters(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SandboxTestersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Users.ListSandboxTesters(ctx, &ListSandboxTestersQuery{})
+	})
+}
+
+func TestUpdateSandboxTester(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SandboxTesterResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Users.UpdateSandboxTester(ctx, "10", &SandboxTesterUpdateRequestAttributes{Territory: String("USA")})
+	})
+}
+
+func TestClearPurchaseHistoryForSandboxTesters(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SandboxTestersClearPurchaseHistoryRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Users.ClearPurchaseHistoryForSandboxTesters(ctx, []string{"10", "11"})
+	})
+}
+
+func TestSandboxTestersUseV2(t *testing.T) {
+	t.Parallel()
+
+	client, server := newRoutedServer(map[string]route{
+		"PATCH /v2/sandboxTesters/10":                        respondWith(http.StatusOK, "{}"),
+		"POST /v2/sandboxTestersClearPurchaseHistoryRequest": respondWith(http.StatusOK, "{}"),
+	})
+	defer server.Close()
+
+	rate := SandboxTesterSubscriptionRenewalRateEveryFiveMinutes
+	_, _, err := client.Users.UpdateSandboxTester(context.Background(), "10", &SandboxTesterUpdateRequestAttributes{
+		InterruptPurchases:      Bool(true),
+		SubscriptionRenewalRate: &rate,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, server.requests("PATCH /v2/sandboxTesters/10"), 1)
+
+	_, _, err = client.Users.ClearPurchaseHistoryForSandboxTesters(context.Background(), []string{"10"})
+	assert.NoError(t, err)
+	assert.Len(t, server.requests("POST /v2/sandboxTestersClearPurchaseHistoryRequest"), 1)
+}